@@ -16,17 +16,21 @@ import (
 	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 
 	_ "github.com/oursportsnation/korean-postalcode/docs/swagger" // Swagger docs
 )
 
 var (
-	port   = flag.String("port", "8080", "Server port")
-	host   = flag.String("host", "0.0.0.0", "Server host")
-	dsn    = flag.String("dsn", "", "Database DSN (overrides .env)")
-	envDir = flag.String("env", ".", "Directory containing .env file")
+	port      = flag.String("port", "8080", "Server port")
+	host      = flag.String("host", "0.0.0.0", "Server host")
+	dsn       = flag.String("dsn", "", "Database DSN: MySQL, sqlite:path or postgres://... (overrides .env)")
+	envDir    = flag.String("env", ".", "Directory containing .env file")
+	bundle    = flag.String("sqlite", "", "SQLite bundle file built by postalcode-bundle (serves read-only, ignores -dsn and .env)")
+	fullText  = flag.Bool("fulltext", false, "Use full-text search for the q parameter (MySQL FULLTEXT ngram index, in-process inverted index for SQLite)")
+	cacheSize = flag.Int("cache-size", 10000, "Max cached lookups (0 disables the in-process cache)")
+	cacheTTL  = flag.Duration("cache-ttl", time.Hour, "Cached lookup lifetime (cache is also cleared when the dataset version changes)")
+	redisURL  = flag.String("redis", "", "Shared cache for all replicas: redis://[:password@]host:port[/db] (requires -cache-size > 0)")
 )
 
 // @title Korean PostalCode API
@@ -49,56 +53,34 @@ var (
 func main() {
 	flag.Parse()
 
-	// Load configuration
-	var cfg *postalcode.Config
-	var err error
-
-	// Change to env directory if specified
-	if *envDir != "." {
-		if err := os.Chdir(*envDir); err != nil {
-			log.Printf("Warning: Failed to change to env directory %s: %v", *envDir, err)
-		}
-	}
-
-	cfg, err = postalcode.LoadConfig()
-	if err != nil {
-		log.Printf("Warning: Failed to load config from .env: %v", err)
-		log.Println("Using default configuration or command-line arguments")
-		cfg = &postalcode.Config{}
-	}
-
-	// Determine DSN to use
-	var dbDSN string
-	if *dsn != "" {
-		// Use command-line DSN if provided
-		dbDSN = *dsn
+	var db *gorm.DB
+	if *bundle != "" {
+		db = openBundle(*bundle)
 	} else {
-		// Use config DSN
-		dbDSN = cfg.Database.GetDSN()
+		db = openDatabase()
 	}
 
-	// Validate DSN
-	if dbDSN == "" {
-		log.Fatal("❌ Database DSN is required. Use -dsn flag or set in .env file")
-	}
-
-	// Connect to database
-	log.Println("📦 Connecting to database...")
-	db, err := gorm.Open(mysql.Open(dbDSN), &gorm.Config{})
-	if err != nil {
-		log.Fatalf("❌ Failed to connect to database: %v", err)
+	// Initialize service
+	var repoOpts []postalcodeapi.RepositoryOption
+	if *fullText {
+		repoOpts = append(repoOpts, postalcodeapi.WithFullTextSearch())
+		log.Println("🔎 Full-text search enabled for q parameter")
 	}
-	log.Println("✅ Database connected successfully")
-
-	// Auto migrate tables
-	log.Println("🔧 Running auto migrations...")
-	if err := db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}); err != nil {
-		log.Fatalf("❌ Failed to migrate database: %v", err)
+	repo := postalcodeapi.NewRepository(db, repoOpts...)
+	var cached postalcodeapi.CachedRepository
+	if *cacheSize > 0 {
+		cacheOpts := []postalcodeapi.CacheOption{postalcodeapi.WithCacheSize(*cacheSize), postalcodeapi.WithCacheTTL(*cacheTTL)}
+		if *redisURL != "" {
+			store := openRedis(*redisURL)
+			defer store.Close()
+			cacheOpts = append(cacheOpts, postalcodeapi.WithCacheStore(store))
+		}
+		cached = postalcodeapi.NewCachedRepository(repo, cacheOpts...)
+		repo = cached
+		log.Printf("🧠 Lookup cache enabled (%d entries, ttl %s)", *cacheSize, *cacheTTL)
+	} else if *redisURL != "" {
+		log.Fatal("❌ -redis requires the lookup cache (-cache-size > 0)")
 	}
-	log.Println("✅ Migrations completed")
-
-	// Initialize service
-	repo := postalcodeapi.NewRepository(db)
 	service := postalcodeapi.NewService(repo)
 
 	// Setup Gin router
@@ -111,12 +93,38 @@ func main() {
 	router.Use(corsMiddleware())
 
 	// Health check endpoint
+	// 현재 데이터셋 버전(마지막으로 성공한 import)과 캐시 통계를 함께 보고
 	router.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{
+		health := gin.H{
 			"status":  "ok",
 			"service": "korean-postalcode",
 			"version": "1.0.0",
-		})
+		}
+		if run, err := service.GetCurrentDataset(); err == nil {
+			health["dataset_version"] = run.DatasetVersion()
+			health["dataset_date"] = run.DatasetDate
+			health["imported_at"] = run.FinishedAt
+		}
+		if cached != nil {
+			stats := cached.Stats()
+			cacheHealth := gin.H{
+				"entries":       stats.Entries,
+				"capacity":      stats.Capacity,
+				"hits":          stats.Hits,
+				"misses":        stats.Misses,
+				"coalesced":     stats.Coalesced,
+				"hit_ratio":     stats.HitRatio(),
+				"evictions":     stats.Evictions,
+				"expired":       stats.Expired,
+				"invalidations": stats.Invalidations,
+			}
+			if *redisURL != "" {
+				cacheHealth["store_hits"] = stats.StoreHits
+				cacheHealth["store_errors"] = stats.StoreErrors
+			}
+			health["cache"] = cacheHealth
+		}
+		c.JSON(http.StatusOK, health)
 	})
 
 	// Swagger documentation
@@ -168,6 +176,99 @@ func main() {
 	log.Println("✅ Server exited gracefully")
 }
 
+// openDatabase connects to the database (-dsn or .env; MySQL, SQLite or PostgreSQL) and migrates the tables
+func openDatabase() *gorm.DB {
+	// Load configuration
+	var cfg *postalcode.Config
+	var err error
+
+	// Change to env directory if specified
+	if *envDir != "." {
+		if err := os.Chdir(*envDir); err != nil {
+			log.Printf("Warning: Failed to change to env directory %s: %v", *envDir, err)
+		}
+	}
+
+	cfg, err = postalcode.LoadConfig()
+	if err != nil {
+		log.Printf("Warning: Failed to load config from .env: %v", err)
+		log.Println("Using default configuration or command-line arguments")
+		cfg = &postalcode.Config{}
+	}
+
+	// Determine DSN to use
+	var dbDSN string
+	if *dsn != "" {
+		// Use command-line DSN if provided
+		dbDSN = *dsn
+	} else {
+		// Use config DSN
+		dbDSN = cfg.Database.GetDSN()
+	}
+
+	// Validate DSN
+	if dbDSN == "" {
+		log.Fatal("❌ Database DSN is required. Use -dsn flag or set in .env file")
+	}
+
+	// Connect to database
+	log.Println("📦 Connecting to database...")
+	db, err := postalcodeapi.OpenDatabase(dbDSN)
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	log.Println("✅ Database connected successfully")
+
+	// Versioned schema (postalcode-migrate up) must not be altered by AutoMigrate
+	managed, err := postalcodeapi.IsSchemaManaged(db)
+	if err != nil {
+		log.Fatalf("❌ Failed to check schema migrations: %v", err)
+	}
+	if managed {
+		log.Println("✅ Schema is managed by postalcode-migrate (auto migrations skipped)")
+		return db
+	}
+
+	// Auto migrate tables
+	log.Println("🔧 Running auto migrations...")
+	if err := db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.PostalCodeBuilding{},
+		&postalcode.PostalCodePOBox{}, &postalcode.PostalCodeBulkDelivery{}, &postalcode.ImportRun{}); err != nil {
+		log.Fatalf("❌ Failed to migrate database: %v", err)
+	}
+	if err := postalcodeapi.CreateSearchIndexes(db); err != nil {
+		log.Fatalf("❌ Failed to create search indexes: %v", err)
+	}
+	log.Println("✅ Migrations completed")
+
+	return db
+}
+
+// openBundle opens a SQLite bundle read-only. The bundle already has its schema, so no migrations are run.
+func openBundle(path string) *gorm.DB {
+	log.Printf("📦 Opening SQLite bundle %s (read-only)...", path)
+	db, info, err := postalcodeapi.OpenBundle(path)
+	if err != nil {
+		log.Fatalf("❌ Failed to open SQLite bundle: %v", err)
+	}
+	log.Printf("✅ Bundle opened (dataset %s, built %s, %d roads, %d lands)",
+		info.DatasetVersion, info.BuiltAt.Local().Format("2006-01-02 15:04:05"), info.RoadCount, info.LandCount)
+	return db
+}
+
+// openRedis connects to the shared cache. An unreachable server is logged but not fatal: lookups fall back to the database.
+func openRedis(rawURL string) *postalcodeapi.RedisCacheStore {
+	store, err := postalcodeapi.NewRedisCacheStore(rawURL)
+	if err != nil {
+		log.Fatalf("❌ Invalid -redis URL: %v", err)
+	}
+	if err := store.Ping(); err != nil {
+		log.Printf("⚠️  Shared cache is not reachable (lookups fall back to the database): %v", err)
+	} else {
+		log.Println("✅ Shared cache connected")
+	}
+	return store
+}
+
 // corsMiddleware adds CORS headers
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -198,6 +299,7 @@ func printStartupInfo(addr string) {
 	fmt.Printf("   GET  http://%s/api/v1/postal-codes/road/zipcode/{code}\n", addr)
 	fmt.Printf("   GET  http://%s/api/v1/postal-codes/road/prefix/{prefix}\n", addr)
 	fmt.Printf("   GET  http://%s/api/v1/postal-codes/road/search\n", addr)
+	fmt.Printf("   GET  http://%s/api/v1/postal-codes/road/coverage?road_name=...\n", addr)
 	fmt.Println()
 	fmt.Println("📍 지번주소 API Endpoints:")
 	fmt.Printf("   GET  http://%s/api/v1/postal-codes/land/zipcode/{code}\n", addr)
@@ -208,6 +310,7 @@ func printStartupInfo(addr string) {
 	fmt.Printf("   curl http://%s/api/v1/postal-codes/road/zipcode/01000\n", addr)
 	fmt.Printf("   curl http://%s/api/v1/postal-codes/road/prefix/010\n", addr)
 	fmt.Printf("   curl 'http://%s/api/v1/postal-codes/road/search?sido_name=서울&limit=10'\n", addr)
+	fmt.Printf("   curl 'http://%s/api/v1/postal-codes/road/search?q=강북구+삼양로'\n", addr)
 	fmt.Println()
 	fmt.Println("🔍 지번주소 Example Requests:")
 	fmt.Printf("   curl http://%s/api/v1/postal-codes/land/zipcode/25627\n", addr)
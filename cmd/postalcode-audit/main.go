@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
+)
+
+func main() {
+	// 커맨드 라인 플래그
+	dsn := flag.String("dsn", "", "DB DSN (MySQL, sqlite:파일, postgres://...; optional: 없으면 .env 파일 사용)")
+	dataType := flag.String("type", "all", "검사 대상: all (모두), road (도로명주소) 또는 land (지번주소)")
+	ruleList := flag.String("rules", "", "실행할 규칙 ID 목록 (쉼표 구분, 기본: 모든 규칙)")
+	maxIDs := flag.Int("max-ids", 100, "규칙별로 출력할 위반 행 ID 최대 개수 (0: 제한 없음)")
+	format := flag.String("format", "text", "출력 형식: text 또는 json")
+	outputPath := flag.String("output", "", "리포트 저장 경로 (기본: 표준출력)")
+	failOn := flag.String("fail-on", "none", "위반 시 종료 코드 1로 끝낼 심각도: none, error 또는 warning")
+	list := flag.Bool("list", false, "검사 규칙 목록만 출력")
+	flag.Parse()
+
+	if *list {
+		printRules()
+		return
+	}
+
+	if *dataType != "all" && *dataType != "road" && *dataType != "land" {
+		log.Fatal("\n❌ -type 은 'all', 'road' 또는 'land' 여야 합니다")
+	}
+
+	if *format != "text" && *format != "json" {
+		log.Fatal("\n❌ -format 은 'text' 또는 'json' 이어야 합니다")
+	}
+
+	if *failOn != "none" && *failOn != postalcode.AuditSeverityError && *failOn != postalcode.AuditSeverityWarning {
+		log.Fatal("\n❌ -fail-on 은 'none', 'error' 또는 'warning' 이어야 합니다")
+	}
+
+	auditOpts := []postalcodeapi.AuditOption{
+		postalcodeapi.WithAuditMaxRowIDs(*maxIDs),
+	}
+	switch *dataType {
+	case "road":
+		auditOpts = append(auditOpts, postalcodeapi.WithAuditTables(postalcodeapi.AuditTableRoad))
+	case "land":
+		auditOpts = append(auditOpts, postalcodeapi.WithAuditTables(postalcodeapi.AuditTableLand))
+	}
+	if *ruleList != "" {
+		var ids []string
+		for _, id := range strings.Split(*ruleList, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				ids = append(ids, strings.ToUpper(id))
+			}
+		}
+		auditOpts = append(auditOpts, postalcodeapi.WithAuditRules(ids...))
+	}
+
+	// 진행 메시지는 리포트와 섞이지 않도록 표준에러로 출력
+	logger := log.New(os.Stderr, "", 0)
+
+	// DSN 결정: 플래그 우선, 없으면 .env 파일
+	var finalDSN string
+	if *dsn != "" {
+		finalDSN = *dsn
+	} else {
+		// .env 파일에서 설정 로드
+		logger.Println("📄 .env 파일에서 설정 로드 중...")
+		cfg, err := postalcode.LoadConfig()
+		if err != nil {
+			log.Fatal("\n❌ .env 파일 로드 실패 및 -dsn 플래그 없음\n💡 해결방법:\n  1. -dsn 플래그 사용: -dsn=\"user:pass@tcp(host:port)/dbname\" (또는 sqlite:postalcode.db, postgres://...)\n  2. .env 파일 생성 (configs/.env.example 참고)")
+		}
+		finalDSN = cfg.Database.GetDSN()
+		logger.Printf("✅ .env 파일에서 로드 완료 (DB: %s)\n", cfg.Database.Name)
+	}
+
+	// 데이터베이스 연결
+	logger.Println("🔌 데이터베이스 연결 중...")
+	db, err := postalcodeapi.OpenDatabase(finalDSN)
+	if err != nil {
+		log.Fatalf("❌ 데이터베이스 연결 실패: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("❌ DB 인스턴스 가져오기 실패: %v", err)
+	}
+	defer sqlDB.Close()
+
+	logger.Println("✅ 데이터베이스 연결 성공")
+	logger.Println("🔍 데이터 품질 검사 중...")
+
+	repo := postalcodeapi.NewRepository(db)
+	service := postalcodeapi.NewService(repo)
+	report, err := postalcodeapi.NewAuditor(service, auditOpts...).Run()
+	if err != nil {
+		log.Fatalf("❌ 검사 실패: %v", err)
+	}
+
+	out := os.Stdout
+	if *outputPath != "" {
+		f, err := os.Create(*outputPath)
+		if err != nil {
+			log.Fatalf("❌ 리포트 파일 생성 실패: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			log.Fatalf("❌ 리포트 출력 실패: %v", err)
+		}
+	} else {
+		printReport(out, report)
+	}
+	if *outputPath != "" {
+		logger.Printf("💾 리포트 저장: %s\n", *outputPath)
+	}
+
+	if (*failOn == postalcode.AuditSeverityError && report.ErrorCount > 0) ||
+		(*failOn == postalcode.AuditSeverityWarning && report.ErrorCount+report.WarningCount > 0) {
+		sqlDB.Close()
+		os.Exit(1)
+	}
+}
+
+// printRules는 검사 규칙 목록을 출력합니다.
+func printRules() {
+	fmt.Println("📋 데이터 품질 검사 규칙")
+	fmt.Println("===================================")
+	for _, rule := range postalcodeapi.AuditRules() {
+		fmt.Printf("%s %-22s [%s] %s\n", severityIcon(rule.Severity), rule.ID, rule.Table, rule.Description)
+	}
+}
+
+// printReport는 검사 리포트를 텍스트로 출력합니다.
+func printReport(out *os.File, report *postalcode.AuditReport) {
+	fmt.Fprintln(out, "📊 데이터 품질 검사 결과")
+	fmt.Fprintln(out, "===================================")
+	fmt.Fprintf(out, "📋 검사 행 수: 도로명주소 %d건, 지번주소 %d건\n", report.RoadCount, report.LandCount)
+	fmt.Fprintf(out, "⏱️  소요 시간: %s\n", report.Duration)
+	fmt.Fprintln(out)
+
+	for _, finding := range report.Findings {
+		if finding.Count == 0 {
+			fmt.Fprintf(out, "✅ %s [%s]\n", finding.RuleID, finding.Table)
+			continue
+		}
+
+		fmt.Fprintf(out, "%s %s [%s] %d건 - %s\n", severityIcon(finding.Severity), finding.RuleID, finding.Table, finding.Count, finding.Description)
+		ids := make([]string, len(finding.RowIDs))
+		for i, id := range finding.RowIDs {
+			ids[i] = fmt.Sprint(id)
+		}
+		suffix := ""
+		if finding.Truncated {
+			suffix = fmt.Sprintf(" ... (외 %d건)", finding.Count-len(finding.RowIDs))
+		}
+		fmt.Fprintf(out, "    ID: %s%s\n", strings.Join(ids, ", "), suffix)
+	}
+
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "❌ 오류: %d건 / ⚠️  경고: %d건\n", report.ErrorCount, report.WarningCount)
+}
+
+// severityIcon은 심각도별 아이콘을 반환합니다.
+func severityIcon(severity string) string {
+	if severity == postalcode.AuditSeverityError {
+		return "❌"
+	}
+	return "⚠️ "
+}
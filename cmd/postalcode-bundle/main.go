@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
+)
+
+const usage = `사용법:
+  postalcode-bundle build -input 파일|ZIP|디렉토리|glob [-dataset-date YYYY-MM-DD] [-output postalcode.sqlite]
+  postalcode-bundle info  [-json] postalcode.sqlite
+
+명령어:
+  build  배포 파일로 스키마, 인덱스, 데이터셋 메타데이터를 모두 담은 SQLite 번들을 만듭니다
+  info   번들 파일의 데이터셋 메타데이터를 출력합니다
+
+만든 번들은 postalcode-api -sqlite postalcode.sqlite 로 DB 서버 없이 서비스할 수 있습니다.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "build":
+		runBuild(os.Args[2:])
+	case "info":
+		runInfo(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "❌ 알 수 없는 명령어: %s\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+// runBuild는 번들 파일을 만듭니다.
+func runBuild(args []string) {
+	fs := flag.NewFlagSet("build", flag.ExitOnError)
+	input := fs.String("input", "", "배포 파일 경로 (단일 파일, ZIP, 디렉토리 또는 glob) (required)")
+	encoding := fs.String("encoding", "auto", "파일 인코딩: auto (자동 감지), utf-8, cp949 (euc-kr)")
+	datasetDate := fs.String("dataset-date", "", "데이터셋 배포 기준일 (YYYY-MM-DD, 데이터셋 버전에 사용)")
+	batchSize := fs.Int("batch", 5000, "배치 크기")
+	outputPath := fs.String("output", "postalcode.sqlite", "저장할 번들 파일 경로")
+	fs.Parse(args)
+
+	if *input == "" {
+		log.Fatal("❌ -input 플래그로 배포 파일을 지정하세요")
+	}
+	if *datasetDate != "" {
+		if _, err := time.Parse("2006-01-02", *datasetDate); err != nil {
+			log.Fatal("❌ -dataset-date 는 YYYY-MM-DD 형식이어야 합니다")
+		}
+	}
+	enc, err := postalcodeapi.ParseEncoding(*encoding)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	startTime := time.Now()
+	fmt.Printf("📂 배포 파일 import 중: %s\n", *input)
+	info, err := postalcodeapi.BuildBundle(*outputPath, *input, *batchSize, nil,
+		postalcodeapi.WithEventHandler(postalcodeapi.NewConsoleEventHandler(os.Stdout)),
+		postalcodeapi.WithEncoding(enc),
+		postalcodeapi.WithDatasetDate(*datasetDate))
+	if err != nil {
+		log.Fatalf("❌ 번들 생성 실패: %v", err)
+	}
+
+	fmt.Printf("✅ 번들 생성 완료 (소요 시간: %s)\n", time.Since(startTime).Round(time.Millisecond))
+	printInfo(*outputPath, info)
+}
+
+// runInfo는 번들 파일 정보를 출력합니다.
+func runInfo(args []string) {
+	fs := flag.NewFlagSet("info", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "JSON으로 출력")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		log.Fatal("❌ 번들 파일 경로를 지정하세요: postalcode-bundle info postalcode.sqlite")
+	}
+	path := fs.Arg(0)
+
+	info, err := postalcodeapi.ReadBundleInfo(path)
+	if err != nil {
+		log.Fatalf("❌ 번들 읽기 실패: %v", err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(info); err != nil {
+			log.Fatalf("❌ 출력 실패: %v", err)
+		}
+		return
+	}
+
+	printInfo(path, info)
+}
+
+// printInfo는 번들 요약 정보를 출력합니다.
+func printInfo(path string, info *postalcode.BundleInfo) {
+	fmt.Printf("💾 파일: %s (%.1f MB, 형식 버전 %d)\n", path, float64(info.Size)/1024/1024, info.FormatVersion)
+	fmt.Printf("🕐 생성 시각: %s\n", info.BuiltAt.Local().Format("2006-01-02 15:04:05"))
+	fmt.Printf("📂 원본: %s\n", info.Source)
+	if info.DatasetVersion != "" {
+		fmt.Printf("🏷️  데이터셋 버전: %s\n", info.DatasetVersion)
+	}
+	fmt.Printf("📊 도로명주소 %d건, 지번주소 %d건, 건물 %d건, 사서함 %d건, 다량배달처 %d건\n",
+		info.RoadCount, info.LandCount, info.BuildingCount, info.POBoxCount, info.BulkDeliveryCount)
+}
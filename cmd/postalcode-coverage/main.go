@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
+)
+
+func main() {
+	// 커맨드 라인 플래그
+	dsn := flag.String("dsn", "", "DB DSN (MySQL, sqlite:파일, postgres://...; optional: 없으면 .env 파일 사용)")
+	sidoName := flag.String("sido", "", "시도명 (부분 매칭, 기본: 전체)")
+	sigunguName := flag.String("sigungu", "", "시군구명 (부분 매칭, 기본: 전체)")
+	roadName := flag.String("road", "", "도로명 (정확 매칭, 기본: 전체)")
+	all := flag.Bool("all", false, "겹침/빈 구간이 없는 도로도 출력")
+	format := flag.String("format", "text", "출력 형식: text 또는 json")
+	outputPath := flag.String("output", "", "리포트 저장 경로 (기본: 표준출력)")
+	failOnIssues := flag.Bool("fail-on-issues", false, "겹침 또는 빈 구간이 있으면 종료 코드 1로 종료")
+	flag.Parse()
+
+	if *format != "text" && *format != "json" {
+		log.Fatal("\n❌ -format 은 'text' 또는 'json' 이어야 합니다")
+	}
+
+	// 진행 메시지는 리포트와 섞이지 않도록 표준에러로 출력
+	logger := log.New(os.Stderr, "", 0)
+
+	// DSN 결정: 플래그 우선, 없으면 .env 파일
+	var finalDSN string
+	if *dsn != "" {
+		finalDSN = *dsn
+	} else {
+		// .env 파일에서 설정 로드
+		logger.Println("📄 .env 파일에서 설정 로드 중...")
+		cfg, err := postalcode.LoadConfig()
+		if err != nil {
+			log.Fatal("\n❌ .env 파일 로드 실패 및 -dsn 플래그 없음\n💡 해결방법:\n  1. -dsn 플래그 사용: -dsn=\"user:pass@tcp(host:port)/dbname\" (또는 sqlite:postalcode.db, postgres://...)\n  2. .env 파일 생성 (configs/.env.example 참고)")
+		}
+		finalDSN = cfg.Database.GetDSN()
+		logger.Printf("✅ .env 파일에서 로드 완료 (DB: %s)\n", cfg.Database.Name)
+	}
+
+	// 데이터베이스 연결
+	logger.Println("🔌 데이터베이스 연결 중...")
+	db, err := postalcodeapi.OpenDatabase(finalDSN)
+	if err != nil {
+		log.Fatalf("❌ 데이터베이스 연결 실패: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("❌ DB 인스턴스 가져오기 실패: %v", err)
+	}
+	defer sqlDB.Close()
+
+	logger.Println("✅ 데이터베이스 연결 성공")
+	logger.Println("🔍 건물번호 범위 분석 중...")
+
+	repo := postalcodeapi.NewRepository(db)
+	service := postalcodeapi.NewService(repo)
+	report, err := service.AnalyzeRoadCoverage(postalcode.CoverageParams{
+		SidoName:    *sidoName,
+		SigunguName: *sigunguName,
+		RoadName:    *roadName,
+	})
+	if err != nil {
+		log.Fatalf("❌ 분석 실패: %v", err)
+	}
+
+	// 기본적으로 문제가 있는 도로만 출력
+	if !*all {
+		roads := report.Roads[:0]
+		for _, road := range report.Roads {
+			if road.HasIssues() {
+				roads = append(roads, road)
+			}
+		}
+		report.Roads = roads
+	}
+
+	out := os.Stdout
+	if *outputPath != "" {
+		f, err := os.Create(*outputPath)
+		if err != nil {
+			log.Fatalf("❌ 리포트 파일 생성 실패: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			log.Fatalf("❌ 리포트 출력 실패: %v", err)
+		}
+	} else {
+		printReport(out, report)
+	}
+	if *outputPath != "" {
+		logger.Printf("💾 리포트 저장: %s\n", *outputPath)
+	}
+
+	if *failOnIssues && report.OverlapCount+report.GapCount > 0 {
+		sqlDB.Close()
+		os.Exit(1)
+	}
+}
+
+// printReport는 분석 리포트를 텍스트로 출력합니다.
+func printReport(out *os.File, report *postalcode.CoverageReport) {
+	fmt.Fprintln(out, "📊 건물번호 범위 분석 결과")
+	fmt.Fprintln(out, "===================================")
+	fmt.Fprintf(out, "📋 분석 대상: 도로 %d개, 범위 %d건\n", report.RoadCount, report.RangeCount)
+	fmt.Fprintf(out, "⏱️  소요 시간: %s\n", report.Duration)
+	fmt.Fprintln(out)
+
+	for _, road := range report.Roads {
+		name := fmt.Sprintf("%s %s %s", road.SidoName, road.SigunguName, road.RoadName)
+		if road.IsUnderground {
+			name += " (지하)"
+		}
+		icon := "✅"
+		if road.HasIssues() {
+			icon = "⚠️ "
+		}
+		fmt.Fprintf(out, "%s %s - 범위 %d건, 우편번호 %v\n", icon, name, road.RangeCount, road.ZipCodes)
+		for _, overlap := range road.Overlaps {
+			fmt.Fprintf(out, "    🔀 겹침 [%s] %d~%d: %s(#%d) ↔ %s(#%d)\n",
+				sideLabel(overlap.Side), overlap.StartMain, overlap.EndMain,
+				overlap.ZipCodes[0], overlap.RowIDs[0], overlap.ZipCodes[1], overlap.RowIDs[1])
+		}
+		for _, gap := range road.Gaps {
+			fmt.Fprintf(out, "    🕳️  빈 구간 [%s] %d~%d\n", sideLabel(gap.Side), gap.StartMain, gap.EndMain)
+		}
+	}
+
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "🔀 겹침: %d건 / 🕳️  빈 구간: %d건\n", report.OverlapCount, report.GapCount)
+}
+
+// sideLabel은 건물번호 구분의 한글 이름을 반환합니다.
+func sideLabel(side string) string {
+	if side == postalcode.CoverageSideOdd {
+		return "홀수"
+	}
+	return "짝수"
+}
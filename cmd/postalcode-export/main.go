@@ -0,0 +1,132 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
+)
+
+func main() {
+	// 커맨드 라인 플래그
+	dsn := flag.String("dsn", "", "DB DSN (MySQL, sqlite:파일, postgres://...; optional: 없으면 .env 파일 사용)")
+	dataType := flag.String("type", "road", "내보낼 데이터: road (도로명주소) 또는 land (지번주소)")
+	format := flag.String("format", postalcode.ExportFormatPipe, "출력 형식: pipe (배포 파일 형식, import 가능), csv, jsonl 또는 json (계층 구조)")
+	outputPath := flag.String("output", "", "저장 경로 (기본: 표준출력)")
+	batchSize := flag.Int("batch", 5000, "한 번에 읽을 행 수")
+
+	// 필터 (검색 API와 같은 조건: 우편번호/prefix는 정확히, 나머지는 부분 매칭)
+	zipCode := flag.String("zip", "", "우편번호 (5자리)")
+	zipPrefix := flag.String("prefix", "", "우편번호 앞 3자리")
+	sido := flag.String("sido", "", "시도명")
+	sigungu := flag.String("sigungu", "", "시군구명")
+	road := flag.String("road", "", "도로명 (road 전용)")
+	eupmyeondong := flag.String("eupmyeondong", "", "읍면동명 (land 전용)")
+	ri := flag.String("ri", "", "리명 (land 전용)")
+	flag.Parse()
+
+	if *dataType != "road" && *dataType != "land" {
+		log.Fatal("\n❌ -type 은 'road' 또는 'land' 여야 합니다")
+	}
+
+	switch *format {
+	case postalcode.ExportFormatPipe, postalcode.ExportFormatCSV, postalcode.ExportFormatJSONL, postalcode.ExportFormatJSON:
+	default:
+		log.Fatal("\n❌ -format 은 'pipe', 'csv', 'jsonl' 또는 'json' 이어야 합니다")
+	}
+
+	if *dataType == "road" && (*eupmyeondong != "" || *ri != "") {
+		log.Fatal("\n❌ -eupmyeondong, -ri 는 -type land 에서만 사용할 수 있습니다")
+	}
+	if *dataType == "land" && *road != "" {
+		log.Fatal("\n❌ -road 는 -type road 에서만 사용할 수 있습니다")
+	}
+
+	// 진행 메시지는 내보낸 데이터와 섞이지 않도록 표준에러로 출력
+	logger := log.New(os.Stderr, "", 0)
+
+	// DSN 결정: 플래그 우선, 없으면 .env 파일
+	var finalDSN string
+	if *dsn != "" {
+		finalDSN = *dsn
+	} else {
+		// .env 파일에서 설정 로드
+		logger.Println("📄 .env 파일에서 설정 로드 중...")
+		cfg, err := postalcode.LoadConfig()
+		if err != nil {
+			log.Fatal("\n❌ .env 파일 로드 실패 및 -dsn 플래그 없음\n💡 해결방법:\n  1. -dsn 플래그 사용: -dsn=\"user:pass@tcp(host:port)/dbname\" (또는 sqlite:postalcode.db, postgres://...)\n  2. .env 파일 생성 (configs/.env.example 참고)")
+		}
+		finalDSN = cfg.Database.GetDSN()
+		logger.Printf("✅ .env 파일에서 로드 완료 (DB: %s)\n", cfg.Database.Name)
+	}
+
+	// 데이터베이스 연결
+	logger.Println("🔌 데이터베이스 연결 중...")
+	db, err := postalcodeapi.OpenDatabase(finalDSN)
+	if err != nil {
+		log.Fatalf("❌ 데이터베이스 연결 실패: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("❌ DB 인스턴스 가져오기 실패: %v", err)
+	}
+	defer sqlDB.Close()
+
+	logger.Println("✅ 데이터베이스 연결 성공")
+
+	var out io.Writer = os.Stdout
+	if *outputPath != "" {
+		f, err := os.Create(*outputPath)
+		if err != nil {
+			log.Fatalf("❌ 출력 파일 생성 실패: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	repo := postalcodeapi.NewRepository(db)
+	service := postalcodeapi.NewService(repo)
+	exporter := postalcodeapi.NewExporter(service, postalcodeapi.WithExportBatchSize(*batchSize))
+
+	logger.Printf("📤 %s 내보내는 중... (형식: %s)\n", typeName(*dataType), *format)
+
+	var result *postalcode.ExportResult
+	if *dataType == "road" {
+		result, err = exporter.ExportRoads(out, *format, postalcode.SearchParams{
+			ZipCode:     *zipCode,
+			ZipPrefix:   *zipPrefix,
+			SidoName:    *sido,
+			SigunguName: *sigungu,
+			RoadName:    *road,
+		})
+	} else {
+		result, err = exporter.ExportLands(out, *format, postalcode.SearchParamsLand{
+			ZipCode:          *zipCode,
+			ZipPrefix:        *zipPrefix,
+			SidoName:         *sido,
+			SigunguName:      *sigungu,
+			EupmyeondongName: *eupmyeondong,
+			RiName:           *ri,
+		})
+	}
+	if err != nil {
+		log.Fatalf("❌ 내보내기 실패: %v", err)
+	}
+
+	logger.Printf("✅ 내보내기 완료: %d건 (소요 시간: %s)\n", result.RowCount, result.Duration)
+	if *outputPath != "" {
+		logger.Printf("💾 저장: %s\n", *outputPath)
+	}
+}
+
+// typeName은 데이터 타입의 표시 이름을 반환합니다.
+func typeName(dataType string) string {
+	if dataType == "land" {
+		return "지번주소"
+	}
+	return "도로명주소"
+}
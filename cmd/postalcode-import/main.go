@@ -1,23 +1,37 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	postalcode "github.com/oursportsnation/korean-postalcode"
 	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
 
 func main() {
 	// 커맨드 라인 플래그
-	dsn := flag.String("dsn", "", "MySQL DSN (optional: 없으면 .env 파일 사용)")
-	filePath := flag.String("file", "", "주소 데이터 파일 경로 (required)")
-	dataType := flag.String("type", "road", "데이터 타입: road (도로명주소) 또는 land (지번주소)")
+	dsn := flag.String("dsn", "", "DB DSN (MySQL, sqlite:파일, postgres://...; optional: 없으면 .env 파일 사용)")
+	filePath := flag.String("file", "", "주소 데이터 파일, ZIP 아카이브, 디렉토리, glob 패턴 또는 - (표준입력) (required)")
+	dataType := flag.String("type", "auto", "데이터 타입: auto (헤더로 자동 감지), road (도로명주소), land (지번주소), building (건물), pobox (사서함) 또는 bulk (다량배달처)")
 	batchSize := flag.Int("batch", 1000, "배치 처리 사이즈")
+	mode := flag.String("mode", "replace", "import 모드: replace (전체 교체), diff (변경분만 반영) 또는 append (기존 데이터 유지, upsert만)")
+	encodingName := flag.String("encoding", "auto", "파일 인코딩: auto (자동 감지), utf-8, cp949 (euc-kr)")
+	dryRun := flag.Bool("dry-run", false, "DB에 저장하지 않고 파일 검증만 수행")
+	reportPath := flag.String("report", "", "dry-run 검증 리포트(JSON) 저장 경로 (기본: 표준출력)")
+	mappingPath := flag.String("mapping", "", "사용자 정의 헤더 → 컬럼 매핑 파일(JSON) 경로")
+	operator := flag.String("operator", os.Getenv("USER"), "import 이력에 기록할 실행자 이름")
+	datasetDate := flag.String("dataset-date", "", "데이터셋 배포 기준일 (YYYY-MM-DD, import 이력에 기록)")
+	rejectsPath := flag.String("rejects", "", "거부 라인 기록 파일 경로 (데이터셋은 디렉토리)")
+	rejectsTable := flag.Bool("rejects-table", false, "거부 라인을 postal_code_import_rejects 테이블에도 기록")
+	resume := flag.Bool("resume", false, "중단된 replace 모드 import를 마지막으로 커밋된 배치 이후부터 재개")
+	force := flag.Bool("force", false, "diff 모드 안전 장치(유효 행 없음, 거부 1% 초과, 삭제 50% 초과)를 무시하고 반영")
+	migrate := flag.Bool("migrate", false, "import 전에 대상 테이블을 AutoMigrate로 만들고 검색 인덱스(PostgreSQL pg_trgm 등) 생성 (버전 마이그레이션으로 관리 중인 DB는 무시)")
 	flag.Parse()
 
 	if *filePath == "" {
@@ -25,6 +39,100 @@ func main() {
 		log.Fatal("\n❌ -file 은 필수입니다")
 	}
 
+	switch *dataType {
+	case "auto", "road", "land", "building", "pobox", "bulk":
+	default:
+		log.Fatal("\n❌ -type 은 'auto', 'road', 'land', 'building', 'pobox' 또는 'bulk' 여야 합니다")
+	}
+
+	if *mode != "replace" && *mode != "diff" && *mode != "append" {
+		log.Fatal("\n❌ -mode 는 'replace', 'diff' 또는 'append' 여야 합니다")
+	}
+
+	encoding, err := postalcodeapi.ParseEncoding(*encodingName)
+	if err != nil {
+		log.Fatalf("\n❌ -encoding 값이 올바르지 않습니다: %v", err)
+	}
+
+	if *datasetDate != "" {
+		if _, err := time.Parse("2006-01-02", *datasetDate); err != nil {
+			log.Fatal("\n❌ -dataset-date 는 YYYY-MM-DD 형식이어야 합니다")
+		}
+	}
+
+	// 진행 상황은 한 줄 진행 막대로, 라이브러리 이벤트(배치 실패, 라인 거부, 테이블 삭제 등)는 콘솔 메시지로 출력
+	bar := newProgressBar(os.Stdout)
+	events := bar.wrap(postalcodeapi.NewConsoleEventHandler(os.Stdout))
+	importerOpts := []postalcodeapi.ImporterOption{
+		postalcodeapi.WithEventHandler(events),
+		postalcodeapi.WithProgressHandler(bar.update),
+		postalcodeapi.WithEncoding(encoding),
+		postalcodeapi.WithOperator(*operator),
+		postalcodeapi.WithDatasetDate(*datasetDate),
+	}
+	if *resume {
+		importerOpts = append(importerOpts, postalcodeapi.WithResume())
+	}
+	if *force {
+		if *mode != "diff" {
+			log.Fatal("\n❌ -force 는 diff 모드에서만 사용할 수 있습니다")
+		}
+		importerOpts = append(importerOpts, postalcodeapi.WithForceDiff())
+	}
+	if *rejectsPath != "" {
+		importerOpts = append(importerOpts, postalcodeapi.WithRejectFile(*rejectsPath))
+	}
+	if *rejectsTable {
+		importerOpts = append(importerOpts, postalcodeapi.WithRejectTable())
+	}
+	if *mappingPath != "" {
+		mapping, err := postalcodeapi.LoadColumnMapping(*mappingPath)
+		if err != nil {
+			log.Fatalf("\n❌ 매핑 파일 로드 실패: %v", err)
+		}
+		importerOpts = append(importerOpts, postalcodeapi.WithColumnMapping(mapping))
+	}
+
+	// ZIP 아카이브, 디렉토리, glob 패턴은 여러 파일을 하나의 데이터셋으로 처리
+	dataset := postalcodeapi.IsDataset(*filePath)
+	if dataset && *mode != "replace" {
+		log.Fatalf("\n❌ %s 모드는 단일 파일만 지원합니다", *mode)
+	}
+
+	// 재개는 단일 파일 replace 모드만 지원 (체크포인트는 파일 하나의 행 순서 기준)
+	if *resume && (dataset || *mode != "replace" || *dryRun) {
+		log.Fatal("\n❌ -resume 은 단일 파일 replace 모드 import만 지원합니다")
+	}
+
+	// -file - 은 표준입력에서 읽음 (한 번만 읽을 수 있으므로 replace 모드만 지원)
+	stdin := *filePath == "-"
+	if stdin && (*mode != "replace" || *dryRun) {
+		log.Fatal("\n❌ 표준입력(-file -)은 replace 모드 import만 지원합니다")
+	}
+
+	// 단일 파일은 헤더로 타입 감지 (데이터셋은 파일별로 감지)
+	if *dataType == "auto" && !dataset {
+		if stdin {
+			log.Fatal("\n❌ 표준입력(-file -)은 -type 을 지정해야 합니다")
+		}
+		// 헤더 감지는 저장소를 사용하지 않으므로 Repository 없이 Service를 생성
+		detected, err := postalcodeapi.NewImporter(postalcodeapi.NewService(nil), importerOpts...).DetectDataType(*filePath)
+		if err != nil {
+			log.Fatalf("\n❌ 파일 형식 감지 실패: %v", err)
+		}
+		*dataType = detected
+	}
+
+	// 건물/사서함/다량배달처는 유니크 키 기준 upsert만 지원 (diff 비교 키 미지원)
+	if *mode == "diff" && *dataType != "road" && *dataType != "land" {
+		log.Fatalf("\n❌ %s(-type %s)은 diff 모드를 지원하지 않습니다. 변동분 파일은 -mode append 를 사용하세요", typeName(*dataType), *dataType)
+	}
+
+	if *dryRun {
+		runDryRun(*filePath, *dataType, *reportPath, dataset, importerOpts)
+		return
+	}
+
 	// DSN 결정: 플래그 우선, 없으면 .env 파일
 	var finalDSN string
 	if *dsn != "" {
@@ -34,31 +142,36 @@ func main() {
 		fmt.Println("📄 .env 파일에서 설정 로드 중...")
 		cfg, err := postalcode.LoadConfig()
 		if err != nil {
-			log.Fatal("\n❌ .env 파일 로드 실패 및 -dsn 플래그 없음\n💡 해결방법:\n  1. -dsn 플래그 사용: -dsn=\"user:pass@tcp(host:port)/dbname\"\n  2. .env 파일 생성 (configs/.env.example 참고)")
+			log.Fatal("\n❌ .env 파일 로드 실패 및 -dsn 플래그 없음\n💡 해결방법:\n  1. -dsn 플래그 사용: -dsn=\"user:pass@tcp(host:port)/dbname\" (또는 sqlite:postalcode.db, postgres://...)\n  2. .env 파일 생성 (configs/.env.example 참고)")
 		}
 		finalDSN = cfg.Database.GetDSN()
 		fmt.Printf("✅ .env 파일에서 로드 완료 (DB: %s)\n\n", cfg.Database.Name)
 	}
 
-	if *dataType != "road" && *dataType != "land" {
-		log.Fatal("\n❌ -type 은 'road' 또는 'land' 여야 합니다")
-	}
-
-	typeKorean := "도로명주소"
-	if *dataType == "land" {
-		typeKorean = "지번주소"
-	}
+	typeKorean := typeName(*dataType)
 
 	fmt.Println("📍 Postal Code Import Tool")
 	fmt.Println("===================================")
 	fmt.Printf("📂 파일: %s\n", *filePath)
-	fmt.Printf("📋 타입: %s (%s)\n", *dataType, typeKorean)
+	if dataset {
+		fmt.Println("📋 타입: 파일별 자동 감지 (데이터셋)")
+	} else {
+		fmt.Printf("📋 타입: %s (%s)\n", *dataType, typeKorean)
+	}
 	fmt.Printf("📦 배치 사이즈: %d\n", *batchSize)
+	fmt.Printf("🔀 모드: %s\n", *mode)
+	if *resume {
+		fmt.Println("⏩ 재개: 중단된 import 이어서 진행")
+	}
+	fmt.Printf("🔤 인코딩: %s\n", encoding)
+	if *datasetDate != "" {
+		fmt.Printf("🏷️  배포 기준일: %s\n", *datasetDate)
+	}
 	fmt.Println()
 
 	// 데이터베이스 연결
 	fmt.Println("🔌 데이터베이스 연결 중...")
-	db, err := gorm.Open(mysql.Open(finalDSN), &gorm.Config{})
+	db, err := postalcodeapi.OpenDatabase(finalDSN)
 	if err != nil {
 		log.Fatalf("❌ 데이터베이스 연결 실패: %v", err)
 	}
@@ -72,47 +185,105 @@ func main() {
 	fmt.Println("✅ 데이터베이스 연결 성공")
 	fmt.Println()
 
-	// 테이블 자동 생성 (필요한 경우)
+	// 테이블 확인 (-migrate이면 생성, 버전 마이그레이션으로 관리 중인 DB는 제외)
 	fmt.Println("🔧 테이블 확인 중...")
-	if *dataType == "road" {
-		if err := db.AutoMigrate(&postalcode.PostalCodeRoad{}); err != nil {
-			log.Fatalf("❌ 테이블 생성 실패: %v", err)
-		}
+	managed, err := postalcodeapi.IsSchemaManaged(db)
+	if err != nil {
+		log.Fatalf("❌ 마이그레이션 기록 확인 실패: %v", err)
+	}
+	if managed {
+		fmt.Println("✅ 테이블 준비 완료 (postalcode-migrate 버전 마이그레이션으로 관리)")
+		fmt.Println()
 	} else {
-		if err := db.AutoMigrate(&postalcode.PostalCodeLand{}); err != nil {
-			log.Fatalf("❌ 테이블 생성 실패: %v", err)
-		}
+		prepareTables(db, importModels(dataset, *dataType), *rejectsTable, *migrate)
 	}
-	fmt.Println("✅ 테이블 준비 완료")
-	fmt.Println()
 
 	// PostalCode Service & Importer 생성
 	repo := postalcodeapi.NewRepository(db)
-	service := postalcodeapi.NewService(repo)
-	importer := postalcodeapi.NewImporter(service)
+	service := postalcodeapi.NewService(repo, postalcodeapi.WithServiceEventHandler(events))
+	importer := postalcodeapi.NewImporter(service, importerOpts...)
 
 	// Import 시작
 	fmt.Println("🔄 데이터 가져오기 시작...")
 	startTime := time.Now()
 
-	// 진행 상황 콜백
-	progressFn := func(current, total int) {
-		fmt.Printf("✅ 처리됨: %d / %d건 (%.1f%%)\n", current, total, float64(current)/float64(total)*100)
+	if dataset {
+		fmt.Println("📍 데이터셋 import 중...")
+		result, err := importer.ImportDataset(*filePath, *batchSize, nil)
+		if err != nil {
+			log.Fatalf("❌ Import 실패: %v", err)
+		}
+		printDatasetResult(result, time.Since(startTime))
+		return
 	}
 
 	// Import 실행
 	var result *postalcode.ImportResult
 
 	var importErr error
-	if *dataType == "road" {
+	switch {
+	case stdin && *dataType == "road":
+		fmt.Println("📍 표준입력에서 도로명주소 데이터 import 중...")
+		result, importErr = importer.ImportFromReader(os.Stdin, 0, *batchSize, nil)
+	case stdin && *dataType == "building":
+		fmt.Println("📍 표준입력에서 건물 데이터 import 중...")
+		result, importErr = importer.ImportBuildingFromReader(os.Stdin, 0, *batchSize, nil)
+	case stdin && *dataType == "pobox":
+		fmt.Println("📍 표준입력에서 사서함 데이터 import 중...")
+		result, importErr = importer.ImportPOBoxFromReader(os.Stdin, 0, *batchSize, nil)
+	case stdin && *dataType == "bulk":
+		fmt.Println("📍 표준입력에서 다량배달처 데이터 import 중...")
+		result, importErr = importer.ImportBulkDeliveryFromReader(os.Stdin, 0, *batchSize, nil)
+	case stdin:
+		fmt.Println("📍 표준입력에서 지번주소 데이터 import 중...")
+		result, importErr = importer.ImportLandFromReader(os.Stdin, 0, *batchSize, nil)
+	case *dataType == "road" && *mode == "diff":
+		fmt.Println("📍 도로명주소 데이터 diff import 중...")
+		result, importErr = importer.DiffImportFromFile(*filePath, *batchSize, nil)
+	case *dataType == "road" && *mode == "append":
+		fmt.Println("📍 도로명주소 데이터 append import 중...")
+		result, importErr = importer.AppendFromFile(*filePath, *batchSize, nil)
+	case *dataType == "road":
 		fmt.Println("📍 도로명주소 데이터 import 중...")
-		result, importErr = importer.ImportFromFile(*filePath, *batchSize, progressFn)
-	} else {
+		result, importErr = importer.ImportFromFile(*filePath, *batchSize, nil)
+	case *dataType == "building" && *mode == "append":
+		fmt.Println("📍 건물 데이터 append import 중...")
+		result, importErr = importer.AppendBuildingFromFile(*filePath, *batchSize, nil)
+	case *dataType == "building":
+		fmt.Println("📍 건물 데이터 import 중...")
+		result, importErr = importer.ImportBuildingFromFile(*filePath, *batchSize, nil)
+	case *dataType == "pobox" && *mode == "append":
+		fmt.Println("📍 사서함 데이터 append import 중...")
+		result, importErr = importer.AppendPOBoxFromFile(*filePath, *batchSize, nil)
+	case *dataType == "pobox":
+		fmt.Println("📍 사서함 데이터 import 중...")
+		result, importErr = importer.ImportPOBoxFromFile(*filePath, *batchSize, nil)
+	case *dataType == "bulk" && *mode == "append":
+		fmt.Println("📍 다량배달처 데이터 append import 중...")
+		result, importErr = importer.AppendBulkDeliveryFromFile(*filePath, *batchSize, nil)
+	case *dataType == "bulk":
+		fmt.Println("📍 다량배달처 데이터 import 중...")
+		result, importErr = importer.ImportBulkDeliveryFromFile(*filePath, *batchSize, nil)
+	case *mode == "diff":
+		fmt.Println("📍 지번주소 데이터 diff import 중...")
+		result, importErr = importer.DiffImportLandFromFile(*filePath, *batchSize, nil)
+	case *mode == "append":
+		fmt.Println("📍 지번주소 데이터 append import 중...")
+		result, importErr = importer.AppendLandFromFile(*filePath, *batchSize, nil)
+	default:
 		fmt.Println("📍 지번주소 데이터 import 중...")
-		result, importErr = importer.ImportLandFromFile(*filePath, *batchSize, progressFn)
+		result, importErr = importer.ImportLandFromFile(*filePath, *batchSize, nil)
 	}
 
+	if errors.Is(importErr, postalcode.ErrUnsafeDiff) {
+		log.Fatalf("❌ Import 중단: %v\n   파일을 확인하고, 의도한 변경이면 -force 로 다시 실행하세요", importErr)
+	}
 	if importErr != nil {
+		if result != nil && result.Changeset != nil {
+			fmt.Println()
+			fmt.Println("⚠️  실패 전까지 반영된 변경분:")
+			printChangeset(result.Changeset)
+		}
 		log.Fatalf("❌ Import 실패: %v", importErr)
 	}
 
@@ -122,7 +293,221 @@ func main() {
 	fmt.Printf("📊 Import 완료!\n")
 	fmt.Printf("  - 타입: %s\n", typeKorean)
 	fmt.Printf("  - 성공: %d건\n", result.TotalCount)
+	if result.ResumedCount > 0 {
+		fmt.Printf("  - 재개로 건너뜀: %d건 (이전 실행에서 저장됨)\n", result.ResumedCount)
+	}
+	if result.DuplicateCount > 0 {
+		fmt.Printf("  - 중복 키로 합쳐짐: %d건 (같은 배치의 마지막 행만 저장)\n", result.DuplicateCount)
+	}
 	fmt.Printf("  - 실패: %d건\n", result.ErrorCount)
 	fmt.Printf("  - 소요 시간: %s\n", duration.Round(time.Second))
 	fmt.Println()
+
+	if result.Changeset != nil {
+		printChangeset(result.Changeset)
+	}
+}
+
+// printChangeset은 diff import의 변경 내역을 출력합니다.
+func printChangeset(cs *postalcode.ImportChangeset) {
+	fmt.Printf("🔀 변경 내역:\n")
+	fmt.Printf("  - 추가: %d건\n", cs.Added)
+	fmt.Printf("  - 변경: %d건\n", cs.Updated)
+	fmt.Printf("  - 삭제: %d건\n", cs.Removed)
+	fmt.Printf("  - 유지: %d건\n", cs.Unchanged)
+	printSamples("추가", cs.SampleAdded)
+	printSamples("변경", cs.SampleUpdated)
+	printSamples("삭제", cs.SampleRemoved)
+	fmt.Println()
+}
+
+// printSamples는 changeset 샘플 키를 출력합니다.
+func printSamples(label string, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	fmt.Printf("  📝 %s 샘플:\n", label)
+	for _, key := range keys {
+		fmt.Printf("    - %s\n", key)
+	}
+}
+
+// printDatasetResult는 데이터셋 import 결과를 파일별로 출력합니다.
+func printDatasetResult(result *postalcode.DatasetImportResult, duration time.Duration) {
+	fmt.Println()
+	fmt.Printf("📊 Import 완료!\n")
+	fmt.Printf("  - 파일: %d개\n", len(result.Files))
+	fmt.Printf("  - 도로명주소: %d건\n", result.RoadCount)
+	fmt.Printf("  - 지번주소: %d건\n", result.LandCount)
+	if result.BuildingCount > 0 {
+		fmt.Printf("  - 건물: %d건\n", result.BuildingCount)
+	}
+	if result.POBoxCount > 0 {
+		fmt.Printf("  - 사서함: %d건\n", result.POBoxCount)
+	}
+	if result.BulkCount > 0 {
+		fmt.Printf("  - 다량배달처: %d건\n", result.BulkCount)
+	}
+	fmt.Printf("  - 실패: %d건\n", result.ErrorCount)
+	fmt.Printf("  - 소요 시간: %s\n", duration.Round(time.Second))
+	fmt.Println()
+
+	fmt.Printf("📄 파일별 결과:\n")
+	for _, file := range result.Files {
+		fmt.Printf("  - %s [%s, %s] 성공 %d건, 실패 %d건\n",
+			file.FileName, typeName(file.DataType), file.Encoding, file.TotalCount, file.ErrorCount)
+	}
+	fmt.Println()
+}
+
+// typeName은 데이터 타입의 한글 이름을 반환합니다.
+func typeName(dataType string) string {
+	switch dataType {
+	case "land":
+		return "지번주소"
+	case "building":
+		return "건물"
+	case "pobox":
+		return "사서함"
+	case "bulk":
+		return "다량배달처"
+	}
+	return "도로명주소"
+}
+
+// runDryRun은 DB 연결 없이 파일을 검증하고 JSON 리포트를 출력합니다.
+// 리포트를 표준출력으로 내보낼 때는 진행 메시지를 표준에러로 출력합니다.
+// 거부된 라인이 있으면 종료 코드 1로 종료합니다.
+// 데이터셋이면 파일별 리포트 배열을 출력합니다.
+func runDryRun(filePath, dataType, reportPath string, dataset bool, opts []postalcodeapi.ImporterOption) {
+	logf := func(format string, args ...interface{}) {
+		if reportPath == "" {
+			fmt.Fprintf(os.Stderr, format, args...)
+		} else {
+			fmt.Printf(format, args...)
+		}
+	}
+
+	if dataset {
+		logf("🔍 Dry-run: 데이터셋 검증 중... (%s)\n", filePath)
+	} else {
+		logf("🔍 Dry-run: %s 파일 검증 중... (%s)\n", typeName(dataType), filePath)
+	}
+
+	// 리포트를 표준출력으로 내보낼 때는 이벤트 메시지도 표준에러로 출력
+	if reportPath == "" {
+		opts = append(opts, postalcodeapi.WithEventHandler(postalcodeapi.NewConsoleEventHandler(os.Stderr)))
+	}
+
+	// 검증은 저장소를 사용하지 않으므로 Repository 없이 Service를 생성
+	importer := postalcodeapi.NewImporter(postalcodeapi.NewService(nil), opts...)
+
+	var reports []*postalcode.ValidationReport
+	var payload interface{}
+	switch {
+	case dataset:
+		var err error
+		if reports, err = importer.ValidateDataset(filePath); err != nil {
+			log.Fatalf("❌ 검증 실패: %v", err)
+		}
+		payload = reports
+	default:
+		var report *postalcode.ValidationReport
+		var err error
+		switch dataType {
+		case "road":
+			report, err = importer.Validate(filePath)
+		case "building":
+			report, err = importer.ValidateBuilding(filePath)
+		case "pobox":
+			report, err = importer.ValidatePOBox(filePath)
+		case "bulk":
+			report, err = importer.ValidateBulkDelivery(filePath)
+		default:
+			report, err = importer.ValidateLand(filePath)
+		}
+		if err != nil {
+			log.Fatalf("❌ 검증 실패: %v", err)
+		}
+		reports = []*postalcode.ValidationReport{report}
+		payload = report
+	}
+
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		log.Fatalf("❌ 리포트 생성 실패: %v", err)
+	}
+
+	if reportPath == "" {
+		fmt.Println(string(data))
+	} else {
+		if err := os.WriteFile(reportPath, append(data, '\n'), 0o644); err != nil {
+			log.Fatalf("❌ 리포트 저장 실패: %v", err)
+		}
+		logf("📝 리포트 저장: %s\n", reportPath)
+	}
+
+	rejected := 0
+	for _, report := range reports {
+		logf("📊 %s [%s, %s]: 전체 %d건, 정상 %d건, 거부 %d건, 중복 키 %d건\n",
+			report.FilePath, typeName(report.DataType), report.Encoding,
+			report.TotalLines, report.ValidCount, report.RejectedCount, report.DuplicateKeys)
+		rejected += report.RejectedCount
+	}
+
+	if rejected > 0 {
+		os.Exit(1)
+	}
+}
+
+// importModels는 import 대상 데이터 타입의 테이블 모델입니다.
+func importModels(dataset bool, dataType string) []interface{} {
+	switch {
+	case dataset:
+		return []interface{}{&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.PostalCodeBuilding{},
+			&postalcode.PostalCodePOBox{}, &postalcode.PostalCodeBulkDelivery{}}
+	case dataType == "pobox":
+		return []interface{}{&postalcode.PostalCodePOBox{}}
+	case dataType == "bulk":
+		return []interface{}{&postalcode.PostalCodeBulkDelivery{}}
+	case dataType == "building":
+		return []interface{}{&postalcode.PostalCodeBuilding{}}
+	case dataType == "road":
+		return []interface{}{&postalcode.PostalCodeRoad{}}
+	default:
+		return []interface{}{&postalcode.PostalCodeLand{}}
+	}
+}
+
+// prepareTables는 -migrate가 지정된 경우 import 대상 테이블을 AutoMigrate로 만들고 검색 인덱스를 생성합니다.
+// 지정되지 않았으면 DDL을 실행하지 않고 대상 테이블이 있는지만 확인합니다. (import 이력 테이블은 없어도 됨)
+func prepareTables(db *gorm.DB, models []interface{}, rejectsTable, migrate bool) {
+	if rejectsTable {
+		models = append(models, &postalcode.ImportReject{})
+	}
+
+	if !migrate {
+		for _, model := range models {
+			if !db.Migrator().HasTable(model) {
+				stmt := &gorm.Statement{DB: db}
+				if err := stmt.Parse(model); err != nil {
+					log.Fatalf("❌ 테이블 확인 실패: %v", err)
+				}
+				log.Fatalf("❌ %s 테이블이 없습니다. postalcode-migrate -cmd up으로 스키마를 만들거나 -migrate 플래그로 다시 실행하세요.", stmt.Schema.Table)
+			}
+		}
+		fmt.Println("✅ 테이블 확인 완료")
+		fmt.Println()
+		return
+	}
+
+	models = append(models, &postalcode.ImportRun{})
+	if err := db.AutoMigrate(models...); err != nil {
+		log.Fatalf("❌ 테이블 생성 실패: %v", err)
+	}
+	if err := postalcodeapi.CreateSearchIndexes(db); err != nil {
+		log.Fatalf("❌ 검색 인덱스 생성 실패: %v", err)
+	}
+	fmt.Println("✅ 테이블 준비 완료")
+	fmt.Println()
 }
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// progressBarWidth는 진행 막대의 칸 수입니다.
+const progressBarWidth = 20
+
+// progressBar는 import 진행 상황을 한 줄로 갱신하여 출력합니다.
+//
+// 터미널이 아니면(파일, 파이프) 줄을 덮어쓸 수 없으므로 단계가 바뀌거나 10% 단위로 진행될 때만 한 줄씩 출력합니다.
+type progressBar struct {
+	mu       sync.Mutex
+	w        io.Writer
+	tty      bool
+	line     string // 마지막으로 그린 줄 (이벤트 메시지 출력 뒤 다시 그리기용)
+	phase    postalcode.ImportPhase
+	lastStep int // 터미널이 아닐 때 마지막으로 출력한 10% 단위
+}
+
+// newProgressBar는 f에 출력하는 progressBar를 생성합니다.
+func newProgressBar(f *os.File) *progressBar {
+	tty := false
+	if info, err := f.Stat(); err == nil {
+		tty = info.Mode()&os.ModeCharDevice != 0
+	}
+	return &progressBar{w: f, tty: tty}
+}
+
+// update는 진행 상황을 출력합니다. (postalcode.ProgressHandler)
+func (b *progressBar) update(p postalcode.ImportProgress) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	line := renderProgress(p)
+	phaseChanged := p.Phase != b.phase
+	b.phase = p.Phase
+
+	if !b.tty {
+		step := int(p.Percent()) / 10
+		if phaseChanged || p.Phase == postalcode.ImportPhaseDone || step > b.lastStep {
+			fmt.Fprintln(b.w, line)
+		}
+		b.lastStep = step
+		return
+	}
+
+	fmt.Fprint(b.w, "\r\033[K"+line)
+	b.line = line
+	if p.Phase == postalcode.ImportPhaseDone {
+		fmt.Fprintln(b.w)
+		b.line = ""
+	}
+}
+
+// wrap은 이벤트 메시지가 진행 막대와 섞이지 않도록 막대를 지운 뒤 handler에 전달하고 다시 그리는 EventHandler를 반환합니다.
+// 읽기 진행 이벤트는 진행 막대가 대신 표시하므로 전달하지 않습니다.
+func (b *progressBar) wrap(handler postalcode.EventHandler) postalcode.EventHandler {
+	return postalcode.EventHandlerFunc(func(event postalcode.Event) {
+		if event.Type == postalcode.EventReadProgress {
+			return
+		}
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if b.line == "" {
+			handler.HandleEvent(event)
+			return
+		}
+		fmt.Fprint(b.w, "\r\033[K")
+		handler.HandleEvent(event)
+		fmt.Fprint(b.w, b.line)
+	})
+}
+
+// renderProgress는 진행 상황을 한 줄 문자열로 만듭니다.
+func renderProgress(p postalcode.ImportProgress) string {
+	var sb strings.Builder
+
+	switch p.Phase {
+	case postalcode.ImportPhaseRead:
+		sb.WriteString("📥 [읽기]" + renderBar(p.Percent()))
+		if p.BytesTotal > 0 {
+			fmt.Fprintf(&sb, " (%s / %s)", formatBytes(p.BytesRead), formatBytes(p.BytesTotal))
+		}
+		fmt.Fprintf(&sb, " %d건 읽음", p.RowsRead)
+	case postalcode.ImportPhaseTruncate:
+		sb.WriteString("🗑️  [삭제] 기존 데이터 삭제 중...")
+	case postalcode.ImportPhaseCompare:
+		sb.WriteString("🔍 [비교] 기존 테이블과 비교 중...")
+	case postalcode.ImportPhaseWrite:
+		fmt.Fprintf(&sb, "💾 [저장]%s %d / %d건", renderBar(p.Percent()), p.RowsWritten, p.RowsTotal)
+	case postalcode.ImportPhaseDone:
+		fmt.Fprintf(&sb, "✅ [완료]%s %d / %d건", renderBar(100), p.RowsWritten, p.RowsTotal)
+	}
+
+	if p.RowsRejected > 0 {
+		fmt.Fprintf(&sb, ", 거부 %d건", p.RowsRejected)
+	}
+	if p.RowsPerSecond > 0 && p.Phase != postalcode.ImportPhaseDone {
+		fmt.Fprintf(&sb, " | %.0f건/s", p.RowsPerSecond)
+	}
+	if p.ETA > 0 {
+		fmt.Fprintf(&sb, " | 남은 시간 %s", p.ETA.Round(time.Second))
+	}
+	if p.Phase == postalcode.ImportPhaseDone {
+		fmt.Fprintf(&sb, " | %s", p.Elapsed.Round(time.Millisecond))
+	}
+	return sb.String()
+}
+
+// renderBar는 앞에 공백을 붙인 진행률 막대를 만듭니다. 진행률을 모르면(-1) 빈 문자열을 반환합니다.
+func renderBar(percent float64) string {
+	if percent < 0 {
+		return ""
+	}
+	filled := int(percent / 100 * progressBarWidth)
+	if filled > progressBarWidth {
+		filled = progressBarWidth
+	}
+	return fmt.Sprintf(" [%s%s] %5.1f%%", strings.Repeat("█", filled), strings.Repeat("░", progressBarWidth-filled), percent)
+}
+
+// formatBytes는 바이트 수를 KB/MB 단위 문자열로 변환합니다.
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1fMB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1fKB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%dB", n)
+	}
+}
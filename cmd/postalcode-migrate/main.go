@@ -4,18 +4,54 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strconv"
+	"time"
 
 	postalcode "github.com/oursportsnation/korean-postalcode"
-	"gorm.io/driver/mysql"
+	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
 	"gorm.io/gorm"
 )
 
 func main() {
 	// 커맨드 라인 플래그
-	dsn := flag.String("dsn", "", "MySQL DSN (optional: 없으면 .env 파일 사용)")
-	command := flag.String("cmd", "up", "명령어: up (생성), down (삭제), fresh (재생성), status (상태 확인)")
+	dsn := flag.String("dsn", "", "DB DSN (MySQL, sqlite:파일, postgres://...; optional: 없으면 .env 파일 사용)")
+	command := flag.String("cmd", "up", "명령어: up (적용), down (되돌리기), fresh (재생성), status (상태 확인), verify (모델과 스키마 비교), create (새 마이그레이션), automigrate (GORM AutoMigrate), history (import 이력)")
+	steps := flag.Int("steps", 1, "down 명령어에서 되돌릴 마이그레이션 개수 (-cmd down 3 처럼 인자로도 지정 가능)")
+	name := flag.String("name", "", "create 명령어에서 만들 마이그레이션 이름 (소문자, 숫자, _)")
+	dir := flag.String("dir", "migrations", "create 명령어에서 마이그레이션 파일을 만들 디렉토리")
+	limit := flag.Int("limit", 20, "history 명령어에서 출력할 이력 개수 (최대 100)")
 	flag.Parse()
 
+	validCommands := map[string]bool{
+		"up":          true,
+		"down":        true,
+		"fresh":       true,
+		"status":      true,
+		"verify":      true,
+		"create":      true,
+		"automigrate": true,
+		"history":     true,
+	}
+
+	if !validCommands[*command] {
+		log.Fatal("\n❌ -cmd 는 'up', 'down', 'fresh', 'status', 'verify', 'create', 'automigrate', 'history' 중 하나여야 합니다")
+	}
+
+	// create는 DB 연결 없이 파일만 만듦
+	if *command == "create" {
+		runCreate(*dir, *name)
+		return
+	}
+
+	if *command == "down" && flag.NArg() > 0 {
+		n, err := strconv.Atoi(flag.Arg(0))
+		if err != nil || n <= 0 {
+			log.Fatalf("\n❌ down 개수는 1 이상의 정수여야 합니다: %s", flag.Arg(0))
+		}
+		*steps = n
+	}
+
 	// DSN 결정: 플래그 우선, 없으면 .env 파일
 	var finalDSN string
 	if *dsn != "" {
@@ -25,23 +61,12 @@ func main() {
 		fmt.Println("📄 .env 파일에서 설정 로드 중...")
 		cfg, err := postalcode.LoadConfig()
 		if err != nil {
-			log.Fatal("\n❌ .env 파일 로드 실패 및 -dsn 플래그 없음\n💡 해결방법:\n  1. -dsn 플래그 사용: -dsn=\"user:pass@tcp(host:port)/dbname\"\n  2. .env 파일 생성 (configs/.env.example 참고)")
+			log.Fatal("\n❌ .env 파일 로드 실패 및 -dsn 플래그 없음\n💡 해결방법:\n  1. -dsn 플래그 사용: -dsn=\"user:pass@tcp(host:port)/dbname\" (또는 sqlite:postalcode.db, postgres://...)\n  2. .env 파일 생성 (configs/.env.example 참고)")
 		}
 		finalDSN = cfg.Database.GetDSN()
 		fmt.Printf("✅ .env 파일에서 로드 완료 (DB: %s)\n", cfg.Database.Name)
 	}
 
-	validCommands := map[string]bool{
-		"up":     true,
-		"down":   true,
-		"fresh":  true,
-		"status": true,
-	}
-
-	if !validCommands[*command] {
-		log.Fatal("\n❌ -cmd 는 'up', 'down', 'fresh', 'status' 중 하나여야 합니다")
-	}
-
 	fmt.Println("📦 Postal Code Migration Tool")
 	fmt.Println("===================================")
 	fmt.Printf("🔧 명령어: %s\n", *command)
@@ -49,7 +74,7 @@ func main() {
 
 	// 데이터베이스 연결
 	fmt.Println("🔌 데이터베이스 연결 중...")
-	db, err := gorm.Open(mysql.Open(finalDSN), &gorm.Config{})
+	db, err := postalcodeapi.OpenDatabase(finalDSN)
 	if err != nil {
 		log.Fatalf("❌ 데이터베이스 연결 실패: %v", err)
 	}
@@ -68,17 +93,139 @@ func main() {
 	case "up":
 		runUp(db)
 	case "down":
-		runDown(db)
+		runDown(db, *steps)
 	case "fresh":
 		runFresh(db)
 	case "status":
 		runStatus(db)
+	case "verify":
+		runVerify(db)
+	case "automigrate":
+		runAutoMigrate(db)
+	case "history":
+		runHistory(db, *limit)
+	}
+}
+
+// newMigrator는 DB dialect의 버전 마이그레이션 Migrator를 생성합니다.
+func newMigrator(db *gorm.DB) postalcodeapi.Migrator {
+	m, err := postalcodeapi.NewMigrator(db)
+	if err != nil {
+		log.Fatalf("❌ 마이그레이션 로드 실패: %v", err)
 	}
+	return m
 }
 
-// runUp은 테이블을 생성합니다.
+// runUp은 적용하지 않은 버전 마이그레이션을 모두 적용합니다.
 func runUp(db *gorm.DB) {
-	fmt.Println("🔼 테이블 생성 중...")
+	fmt.Printf("🔼 마이그레이션 적용 중 (%s)...\n", db.Dialector.Name())
+	fmt.Println()
+
+	applied, err := newMigrator(db).Up()
+	for _, m := range applied {
+		fmt.Printf("  ✅ %04d_%s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		log.Fatalf("  ❌ %v", err)
+	}
+
+	fmt.Println()
+	if len(applied) == 0 {
+		fmt.Println("✅ 이미 최신 상태입니다")
+		fmt.Println()
+		return
+	}
+	fmt.Printf("🎉 마이그레이션 %d개 적용 완료!\n", len(applied))
+	fmt.Println()
+	fmt.Println("💡 다음 단계:")
+	fmt.Println("  1. 데이터 import: ./postalcode-import -dsn=\"...\" -file=\"data/postal_codes.txt\"")
+	fmt.Println("  2. 또는 Shell 스크립트: ./scripts/import.sh")
+	fmt.Println()
+}
+
+// runDown은 마지막으로 적용한 마이그레이션부터 steps개를 되돌립니다.
+func runDown(db *gorm.DB, steps int) {
+	fmt.Printf("🔽 마이그레이션 %d개 되돌리는 중...\n", steps)
+	fmt.Println()
+
+	reverted, err := newMigrator(db).Down(steps)
+	for _, m := range reverted {
+		fmt.Printf("  ↩️  %04d_%s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		log.Fatalf("  ❌ %v", err)
+	}
+
+	fmt.Println()
+	if len(reverted) == 0 {
+		fmt.Println("➖ 되돌릴 마이그레이션이 없습니다")
+	} else {
+		fmt.Printf("🎉 마이그레이션 %d개 되돌리기 완료!\n", len(reverted))
+	}
+	fmt.Println()
+}
+
+// runVerify는 모델 정의와 실제 DB 스키마의 차이를 출력합니다. 차이가 있으면 종료 코드 1로 끝납니다.
+func runVerify(db *gorm.DB) {
+	fmt.Println("🔍 모델과 DB 스키마 비교 중...")
+	fmt.Println()
+
+	drifts, err := postalcodeapi.VerifySchema(db)
+	if err != nil {
+		log.Fatalf("❌ 스키마 확인 실패: %v", err)
+	}
+
+	if len(drifts) == 0 {
+		fmt.Println("✅ 스키마가 모델과 일치합니다")
+		fmt.Println()
+		return
+	}
+
+	for _, drift := range drifts {
+		fmt.Printf("  ⚠️  %s [%s] %s\n", drift.Table, drift.Kind, drift.Detail)
+	}
+	fmt.Println()
+	fmt.Printf("❌ 스키마 차이 %d건 (마이그레이션을 추가하세요: ./postalcode-migrate -cmd create -name ...)\n", len(drifts))
+	os.Exit(1)
+}
+
+// runCreate는 모든 dialect 디렉토리에 다음 버전의 빈 마이그레이션 파일을 만듭니다.
+func runCreate(dir, name string) {
+	if name == "" {
+		log.Fatal("❌ -name 플래그로 마이그레이션 이름을 지정하세요 (예: -name add_road_name_index)")
+	}
+
+	paths, err := postalcodeapi.CreateMigration(dir, name)
+	if err != nil {
+		log.Fatalf("❌ 마이그레이션 생성 실패: %v", err)
+	}
+
+	fmt.Println("📝 마이그레이션 파일 생성:")
+	for _, path := range paths {
+		fmt.Printf("  ✅ %s\n", path)
+	}
+	fmt.Println()
+	fmt.Println("💡 각 dialect의 up/down SQL을 작성한 뒤 다시 빌드하면 -cmd up 으로 적용됩니다")
+	fmt.Println()
+}
+
+// searchIndexLabels는 AutoMigrate 뒤에 만드는 dialect별 검색 인덱스 이름입니다. (SQLite는 없음)
+var searchIndexLabels = map[string]string{
+	postalcode.DriverPostgres: "trigram 검색 인덱스",
+	postalcode.DriverMySQL:    "FULLTEXT(ngram) 검색 인덱스",
+}
+
+// runAutoMigrate는 GORM AutoMigrate로 테이블을 생성합니다. (버전 관리 없음)
+func runAutoMigrate(db *gorm.DB) {
+	managed, err := postalcodeapi.IsSchemaManaged(db)
+	if err != nil {
+		log.Fatalf("❌ 마이그레이션 기록 확인 실패: %v", err)
+	}
+	if managed {
+		log.Fatal("❌ 버전 마이그레이션으로 관리 중인 DB입니다. AutoMigrate 대신 -cmd up 을 사용하세요")
+	}
+
+	fmt.Println("🔼 테이블 생성 중 (AutoMigrate)...")
 	fmt.Println()
 
 	// 도로명주소 테이블
@@ -97,6 +244,56 @@ func runUp(db *gorm.DB) {
 	}
 	fmt.Println("✅")
 
+	// 건물 테이블
+	fmt.Print("  📋 postal_code_buildings 테이블... ")
+	if err := db.AutoMigrate(&postalcode.PostalCodeBuilding{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// 사서함 테이블
+	fmt.Print("  📋 postal_code_po_boxes 테이블... ")
+	if err := db.AutoMigrate(&postalcode.PostalCodePOBox{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// 다량배달처 테이블
+	fmt.Print("  📋 postal_code_bulk_deliveries 테이블... ")
+	if err := db.AutoMigrate(&postalcode.PostalCodeBulkDelivery{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// import 이력 테이블
+	fmt.Print("  📋 import_runs 테이블... ")
+	if err := db.AutoMigrate(&postalcode.ImportRun{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// import 거부 라인 격리 테이블
+	fmt.Print("  📋 postal_code_import_rejects 테이블... ")
+	if err := db.AutoMigrate(&postalcode.ImportReject{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// 검색 인덱스 (PostgreSQL pg_trgm, MySQL ngram FULLTEXT)
+	if label, ok := searchIndexLabels[db.Dialector.Name()]; ok {
+		fmt.Printf("  🔎 %s... ", label)
+		if err := postalcodeapi.CreateSearchIndexes(db); err != nil {
+			fmt.Println("❌")
+			log.Fatalf("    에러: %v", err)
+		}
+		fmt.Println("✅")
+	}
+
 	fmt.Println()
 	fmt.Println("🎉 마이그레이션 완료!")
 	fmt.Println()
@@ -106,11 +303,51 @@ func runUp(db *gorm.DB) {
 	fmt.Println()
 }
 
-// runDown은 테이블을 삭제합니다.
-func runDown(db *gorm.DB) {
+// dropTables는 모든 테이블과 마이그레이션 기록을 삭제합니다.
+func dropTables(db *gorm.DB) {
 	fmt.Println("🔽 테이블 삭제 중...")
 	fmt.Println()
 
+	// import 거부 라인 격리 테이블
+	fmt.Print("  📋 postal_code_import_rejects 테이블... ")
+	if err := db.Migrator().DropTable(&postalcode.ImportReject{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// import 이력 테이블
+	fmt.Print("  📋 import_runs 테이블... ")
+	if err := db.Migrator().DropTable(&postalcode.ImportRun{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// 다량배달처 테이블
+	fmt.Print("  📋 postal_code_bulk_deliveries 테이블... ")
+	if err := db.Migrator().DropTable(&postalcode.PostalCodeBulkDelivery{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// 사서함 테이블
+	fmt.Print("  📋 postal_code_po_boxes 테이블... ")
+	if err := db.Migrator().DropTable(&postalcode.PostalCodePOBox{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
+	// 건물 테이블
+	fmt.Print("  📋 postal_code_buildings 테이블... ")
+	if err := db.Migrator().DropTable(&postalcode.PostalCodeBuilding{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
 	// 지번주소 테이블 (외래키 고려하여 먼저 삭제)
 	fmt.Print("  📋 postal_code_lands 테이블... ")
 	if err := db.Migrator().DropTable(&postalcode.PostalCodeLand{}); err != nil {
@@ -127,23 +364,55 @@ func runDown(db *gorm.DB) {
 	}
 	fmt.Println("✅")
 
+	// 마이그레이션 기록 테이블
+	fmt.Print("  📋 schema_migrations 테이블... ")
+	if err := db.Migrator().DropTable(&postalcode.SchemaMigration{}); err != nil {
+		fmt.Println("❌")
+		log.Fatalf("    에러: %v", err)
+	}
+	fmt.Println("✅")
+
 	fmt.Println()
 	fmt.Println("🎉 테이블 삭제 완료!")
 	fmt.Println()
 }
 
-// runFresh는 테이블을 삭제하고 재생성합니다.
+// runFresh는 모든 테이블을 삭제하고 마이그레이션을 처음부터 적용합니다.
 func runFresh(db *gorm.DB) {
 	fmt.Println("🔄 테이블 재생성 중...")
 	fmt.Println()
 
-	runDown(db)
+	dropTables(db)
 	fmt.Println("---")
 	runUp(db)
 }
 
 // runStatus는 테이블 상태를 확인합니다.
 func runStatus(db *gorm.DB) {
+	fmt.Printf("📜 마이그레이션 상태 (%s)\n", db.Dialector.Name())
+	fmt.Println()
+
+	statuses, err := newMigrator(db).Status()
+	if err != nil {
+		log.Fatalf("❌ 마이그레이션 상태 확인 실패: %v", err)
+	}
+	pending := 0
+	for _, status := range statuses {
+		switch {
+		case status.Missing:
+			fmt.Printf("  ⚠️  %04d_%s (적용됨 %s, 파일 없음)\n", status.Version, status.Name, status.AppliedAt.Local().Format("2006-01-02 15:04:05"))
+		case status.Applied:
+			fmt.Printf("  ✅ %04d_%s (적용됨 %s)\n", status.Version, status.Name, status.AppliedAt.Local().Format("2006-01-02 15:04:05"))
+		default:
+			pending++
+			fmt.Printf("  ⏳ %04d_%s (대기)\n", status.Version, status.Name)
+		}
+	}
+	if pending > 0 {
+		fmt.Printf("  💡 대기 중인 마이그레이션 %d개: ./postalcode-migrate -dsn=\"...\" -cmd=up\n", pending)
+	}
+	fmt.Println()
+
 	fmt.Println("📊 테이블 상태 확인 중...")
 	fmt.Println()
 
@@ -171,9 +440,60 @@ func runStatus(db *gorm.DB) {
 		fmt.Println("❌ 없음")
 	}
 
+	// 건물 테이블 (선택: 건물명 검색용)
+	hasBuilding := db.Migrator().HasTable(&postalcode.PostalCodeBuilding{})
+	fmt.Print("  📋 postal_code_buildings: ")
+	if hasBuilding {
+		fmt.Print("✅ 존재")
+		var count int64
+		db.Model(&postalcode.PostalCodeBuilding{}).Count(&count)
+		fmt.Printf(" (%d건)\n", count)
+	} else {
+		fmt.Println("➖ 없음 (건물명 검색 미사용)")
+	}
+
+	// 사서함 테이블 (선택)
+	hasPOBox := db.Migrator().HasTable(&postalcode.PostalCodePOBox{})
+	fmt.Print("  📋 postal_code_po_boxes: ")
+	if hasPOBox {
+		fmt.Print("✅ 존재")
+		var count int64
+		db.Model(&postalcode.PostalCodePOBox{}).Count(&count)
+		fmt.Printf(" (%d건)\n", count)
+	} else {
+		fmt.Println("➖ 없음 (사서함 우편번호 미사용)")
+	}
+
+	// 다량배달처 테이블 (선택)
+	hasBulk := db.Migrator().HasTable(&postalcode.PostalCodeBulkDelivery{})
+	fmt.Print("  📋 postal_code_bulk_deliveries: ")
+	if hasBulk {
+		fmt.Print("✅ 존재")
+		var count int64
+		db.Model(&postalcode.PostalCodeBulkDelivery{}).Count(&count)
+		fmt.Printf(" (%d건)\n", count)
+	} else {
+		fmt.Println("➖ 없음 (다량배달처 우편번호 미사용)")
+	}
+
+	// import 이력 테이블 및 현재 데이터셋 버전
+	hasRuns := db.Migrator().HasTable(&postalcode.ImportRun{})
+	fmt.Print("  📋 import_runs: ")
+	if hasRuns {
+		fmt.Println("✅ 존재")
+		svc := postalcodeapi.NewService(postalcodeapi.NewRepository(db))
+		if run, err := svc.GetCurrentDataset(); err == nil {
+			fmt.Printf("  🏷️  현재 데이터셋: %s (%s, %s)\n", run.DatasetVersion(), run.FileName, run.FinishedAt.Format("2006-01-02 15:04:05"))
+		} else {
+			fmt.Println("  🏷️  현재 데이터셋: 없음 (성공한 import 없음)")
+		}
+	} else {
+		fmt.Println("❌ 없음")
+	}
+
 	fmt.Println()
 
-	if hasRoad && hasLand {
+	if hasRoad && hasLand && hasRuns {
 		fmt.Println("🎉 모든 테이블이 준비되었습니다!")
 	} else {
 		fmt.Println("⚠️  일부 테이블이 없습니다. 마이그레이션을 실행하세요:")
@@ -181,3 +501,69 @@ func runStatus(db *gorm.DB) {
 	}
 	fmt.Println()
 }
+
+// runHistory는 최근 import 실행 이력을 출력합니다.
+func runHistory(db *gorm.DB, limit int) {
+	if !db.Migrator().HasTable(&postalcode.ImportRun{}) {
+		fmt.Println("⚠️  import_runs 테이블이 없습니다. 마이그레이션을 실행하세요:")
+		fmt.Println("    ./postalcode-migrate -dsn=\"...\" -cmd=up")
+		fmt.Println()
+		return
+	}
+
+	svc := postalcodeapi.NewService(postalcodeapi.NewRepository(db))
+	runs, err := svc.ListImportRuns(limit)
+	if err != nil {
+		log.Fatalf("❌ import 이력 조회 실패: %v", err)
+	}
+
+	fmt.Println("📜 Import 이력 (최신순)")
+	fmt.Println()
+
+	if len(runs) == 0 {
+		fmt.Println("  (이력 없음)")
+		fmt.Println()
+		return
+	}
+
+	if current, err := svc.GetCurrentDataset(); err == nil {
+		fmt.Printf("🏷️  현재 데이터셋 버전: %s\n", current.DatasetVersion())
+		fmt.Println()
+	}
+
+	for _, run := range runs {
+		icon := "✅"
+		switch run.Outcome {
+		case postalcode.ImportOutcomeFailed:
+			icon = "❌"
+		case postalcode.ImportOutcomeRunning:
+			icon = "⏳"
+		}
+
+		fmt.Printf("%s #%d %s [%s/%s] %s\n", icon, run.ID, run.StartedAt.Format("2006-01-02 15:04:05"), run.DataType, run.Mode, run.Outcome)
+		fmt.Printf("    파일: %s\n", run.FileName)
+		if run.FileSHA256 != "" {
+			fmt.Printf("    SHA-256: %s\n", run.FileSHA256)
+		}
+		if run.DatasetDate != "" {
+			fmt.Printf("    배포 기준일: %s\n", run.DatasetDate)
+		}
+		if run.Operator != "" {
+			fmt.Printf("    실행자: %s\n", run.Operator)
+		}
+		if run.FinishedAt != nil {
+			fmt.Printf("    소요 시간: %s\n", run.FinishedAt.Sub(run.StartedAt).Round(time.Millisecond))
+		}
+		fmt.Printf("    추가: %d / 변경: %d / 삭제: %d / 거부: %d\n", run.InsertedCount, run.UpdatedCount, run.DeletedCount, run.RejectedCount)
+		if run.ResumedFromID != nil {
+			fmt.Printf("    재개: #%d 이어서 진행\n", *run.ResumedFromID)
+		}
+		if run.Resumable() {
+			fmt.Printf("    체크포인트: %d건 커밋됨 (-resume 으로 재개 가능)\n", run.CheckpointRows)
+		}
+		if run.ErrorMessage != "" {
+			fmt.Printf("    에러: %s\n", run.ErrorMessage)
+		}
+	}
+	fmt.Println()
+}
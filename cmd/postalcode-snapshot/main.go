@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
+)
+
+const usage = `사용법:
+  postalcode-snapshot build [-input 파일|ZIP|디렉토리|glob [-dataset-date YYYY-MM-DD]] [-dsn DSN] [-output postalcode.snap]
+  postalcode-snapshot info  [-json] postalcode.snap
+
+명령어:
+  build  배포 파일 또는 DB의 도로명주소/지번주소 데이터로 바이너리 스냅샷을 만듭니다
+  info   스냅샷 파일을 검증(형식 버전, checksum)하고 요약 정보를 출력합니다
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "build":
+		runBuild(os.Args[2:])
+	case "info":
+		runInfo(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "❌ 알 수 없는 명령어: %s\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+// runBuild는 스냅샷 파일을 만듭니다.
+func runBuild(args []string) {
+	fs := flag.NewFlagSet("build", flag.ExitOnError)
+	input := fs.String("input", "", "배포 파일 경로 (단일 파일, ZIP, 디렉토리 또는 glob; 없으면 DB에서 읽음)")
+	encoding := fs.String("encoding", "auto", "-input 파일 인코딩: auto (자동 감지), utf-8, cp949 (euc-kr)")
+	datasetDate := fs.String("dataset-date", "", "-input 데이터셋 배포 기준일 (YYYY-MM-DD, 데이터셋 버전에 사용)")
+	dsn := fs.String("dsn", "", "DB DSN (MySQL, sqlite:파일, postgres://...; optional: -input과 -dsn이 모두 없으면 .env 파일 사용)")
+	outputPath := fs.String("output", "postalcode.snap", "저장할 스냅샷 파일 경로")
+	fs.Parse(args)
+
+	startTime := time.Now()
+
+	var repo postalcodeapi.Repository
+	if *input != "" {
+		enc, err := postalcodeapi.ParseEncoding(*encoding)
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+
+		fmt.Printf("📂 배포 파일 읽는 중: %s\n", *input)
+		repo, err = postalcodeapi.NewMemoryRepositoryFromFile(*input,
+			postalcodeapi.WithEncoding(enc), postalcodeapi.WithDatasetDate(*datasetDate))
+		if err != nil {
+			log.Fatalf("❌ 배포 파일 읽기 실패: %v", err)
+		}
+	} else {
+		// DSN 결정: 플래그 우선, 없으면 .env 파일
+		finalDSN := *dsn
+		if finalDSN == "" {
+			fmt.Println("📄 .env 파일에서 설정 로드 중...")
+			cfg, err := postalcode.LoadConfig()
+			if err != nil {
+				log.Fatal("\n❌ .env 파일 로드 실패 및 -input, -dsn 플래그 없음\n💡 해결방법:\n  1. -input 플래그로 배포 파일 지정\n  2. -dsn 플래그 사용: -dsn=\"user:pass@tcp(host:port)/dbname\" (또는 sqlite:postalcode.db, postgres://...)\n  3. .env 파일 생성 (configs/.env.example 참고)")
+			}
+			finalDSN = cfg.Database.GetDSN()
+			fmt.Printf("✅ .env 파일에서 로드 완료 (DB: %s)\n", cfg.Database.Name)
+		}
+
+		fmt.Println("🔌 데이터베이스 연결 중...")
+		db, err := postalcodeapi.OpenDatabase(finalDSN)
+		if err != nil {
+			log.Fatalf("❌ 데이터베이스 연결 실패: %v", err)
+		}
+		sqlDB, err := db.DB()
+		if err != nil {
+			log.Fatalf("❌ DB 인스턴스 가져오기 실패: %v", err)
+		}
+		defer sqlDB.Close()
+		fmt.Println("✅ 데이터베이스 연결 성공")
+
+		repo = postalcodeapi.NewRepository(db)
+	}
+
+	fmt.Println("📦 스냅샷 생성 중...")
+	info, err := postalcodeapi.BuildSnapshot(*outputPath, postalcodeapi.NewService(repo))
+	if err != nil {
+		log.Fatalf("❌ 스냅샷 생성 실패: %v", err)
+	}
+
+	fmt.Printf("✅ 스냅샷 생성 완료 (소요 시간: %s)\n", time.Since(startTime).Round(time.Millisecond))
+	printInfo(*outputPath, info)
+}
+
+// runInfo는 스냅샷 파일 정보를 출력합니다.
+func runInfo(args []string) {
+	fs := flag.NewFlagSet("info", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "JSON으로 출력")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		log.Fatal("❌ 스냅샷 파일 경로를 지정하세요: postalcode-snapshot info postalcode.snap")
+	}
+	path := fs.Arg(0)
+
+	info, err := postalcodeapi.ReadSnapshotInfo(path)
+	if err != nil {
+		log.Fatalf("❌ 스냅샷 검증 실패: %v", err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(info); err != nil {
+			log.Fatalf("❌ 출력 실패: %v", err)
+		}
+		return
+	}
+
+	fmt.Println("✅ 스냅샷 검증 완료")
+	printInfo(path, info)
+}
+
+// printInfo는 스냅샷 요약 정보를 출력합니다.
+func printInfo(path string, info *postalcode.SnapshotInfo) {
+	fmt.Printf("💾 파일: %s (%.1f MB, 형식 버전 %d, checksum %s)\n", path, float64(info.Size)/1024/1024, info.FormatVersion, info.Checksum)
+	fmt.Printf("🕐 생성 시각: %s\n", info.BuiltAt.Local().Format("2006-01-02 15:04:05"))
+	if info.DatasetVersion != "" {
+		fmt.Printf("🏷️  데이터셋 버전: %s\n", info.DatasetVersion)
+	}
+	fmt.Printf("📊 도로명주소 %d건, 지번주소 %d건, 문자열 %d개\n", info.RoadCount, info.LandCount, info.StringCount)
+}
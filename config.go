@@ -2,6 +2,7 @@ package postalcode
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -15,14 +16,23 @@ type Config struct {
 	Import   ImportConfig
 }
 
+// Database drivers
+const (
+	DriverMySQL    = "mysql"
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
 // DatabaseConfig holds database connection settings
 type DatabaseConfig struct {
+	Driver   string // mysql (default), sqlite, postgres
 	Host     string
 	Port     int
 	User     string
 	Password string
-	Name     string
-	Charset  string
+	Name     string // database name, or file path for sqlite
+	Charset  string // mysql only
+	SSLMode  string // postgres only
 }
 
 // ImportConfig holds data import settings
@@ -56,7 +66,13 @@ func LoadConfig() (*Config, error) {
 	// Don't fail if .env not found - environment variables might be set externally
 	_ = envLoaded
 
-	dbPort, err := strconv.Atoi(getEnv("POSTALCODE_DB_PORT", "3306"))
+	driver := getEnv("POSTALCODE_DB_DRIVER", DriverMySQL)
+	defaultPort := "3306"
+	if driver == DriverPostgres {
+		defaultPort = "5432"
+	}
+
+	dbPort, err := strconv.Atoi(getEnv("POSTALCODE_DB_PORT", defaultPort))
 	if err != nil {
 		return nil, fmt.Errorf("invalid DB_PORT: %w", err)
 	}
@@ -73,12 +89,14 @@ func LoadConfig() (*Config, error) {
 
 	return &Config{
 		Database: DatabaseConfig{
+			Driver:   driver,
 			Host:     getEnv("POSTALCODE_DB_HOST", "localhost"),
 			Port:     dbPort,
 			User:     getEnv("POSTALCODE_DB_USER", "root"),
 			Password: getEnv("POSTALCODE_DB_PASSWORD", ""),
 			Name:     getEnv("POSTALCODE_DB_NAME", "postalcode"),
 			Charset:  getEnv("POSTALCODE_DB_CHARSET", "utf8mb4"),
+			SSLMode:  getEnv("POSTALCODE_DB_SSLMODE", "disable"),
 		},
 		Import: ImportConfig{
 			BatchSize:      batchSize,
@@ -87,8 +105,30 @@ func LoadConfig() (*Config, error) {
 	}, nil
 }
 
-// GetDSN returns MySQL DSN string
+// GetDSN returns the DSN for the configured driver.
+// MySQL DSNs are returned as-is for backward compatibility; other drivers are
+// driver-qualified ("sqlite:postalcode.db", "postgres://...") so that
+// pkg/postalcode.OpenDatabase can pick the driver from the DSN alone.
 func (c *DatabaseConfig) GetDSN() string {
+	switch c.Driver {
+	case DriverSQLite:
+		path := c.Name
+		if filepath.Ext(path) == "" {
+			path += ".db"
+		}
+		return DriverSQLite + ":" + path
+	case DriverPostgres:
+		u := url.URL{
+			Scheme: DriverPostgres,
+			User:   url.UserPassword(c.User, c.Password),
+			Host:   fmt.Sprintf("%s:%d", c.Host, c.Port),
+			Path:   "/" + c.Name,
+		}
+		if c.SSLMode != "" {
+			u.RawQuery = url.Values{"sslmode": {c.SSLMode}}.Encode()
+		}
+		return u.String()
+	}
 	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=%s&parseTime=True&loc=Local",
 		c.User,
 		c.Password,
@@ -22,6 +22,42 @@ var (
 	// ErrInvalidFileFormat is returned when file format is incorrect
 	ErrInvalidFileFormat = errors.New("invalid file format")
 
+	// ErrUnsupportedEncoding is returned when import file encoding is unknown or unsupported
+	ErrUnsupportedEncoding = errors.New("unsupported file encoding")
+
+	// ErrNoResumableImport is returned when there is no interrupted import to resume
+	ErrNoResumableImport = errors.New("no resumable import found")
+
+	// ErrResumeMismatch is returned when the resume file differs from the interrupted import
+	ErrResumeMismatch = errors.New("import file does not match the interrupted import")
+
+	// ErrUnsafeDiff is returned when a diff import is aborted by its safety limits (empty file, too many rejects or removals)
+	ErrUnsafeDiff = errors.New("diff import exceeds safety limits")
+
+	// ErrImportHistoryUnavailable is returned when the import_runs table does not exist (import history is skipped)
+	ErrImportHistoryUnavailable = errors.New("import history table does not exist")
+
+	// ErrUnknownAuditRule is returned when an audit rule ID is not defined
+	ErrUnknownAuditRule = errors.New("unknown audit rule")
+
+	// ErrUnknownExportFormat is returned when an export format is not supported
+	ErrUnknownExportFormat = errors.New("unknown export format")
+
+	// ErrInvalidSnapshot is returned when a snapshot file is malformed, truncated or fails its checksum
+	ErrInvalidSnapshot = errors.New("invalid snapshot file")
+
+	// ErrReadOnly is returned when a write is attempted on a read-only repository
+	ErrReadOnly = errors.New("repository is read-only")
+
+	// ErrInvalidBundle is returned when a file is not a SQLite bundle or was built with an unsupported format version
+	ErrInvalidBundle = errors.New("invalid sqlite bundle")
+
+	// ErrInvalidMigration is returned when a migration file name is malformed or its up/down pair is incomplete
+	ErrInvalidMigration = errors.New("invalid migration")
+
+	// ErrMigrationNotFound is returned when an applied migration has no migration file to roll it back
+	ErrMigrationNotFound = errors.New("migration file not found")
+
 	// ErrDatabaseConnection is returned when database connection fails
 	ErrDatabaseConnection = errors.New("database connection failed")
 
@@ -48,17 +84,29 @@ func NewValidationError(field, message string) error {
 }
 
 // ImportError represents an import operation error
+// Line is the physical line number in the source file (header is line 1).
+// Field and Value identify the offending column and its raw value when the
+// error is specific to a single field. Record is the rejected line as
+// pipe-delimited fields (decoded to UTF-8) when it could be read; it is
+// written to reject files rather than to validation reports.
 type ImportError struct {
-	Line    int
-	Message string
-	Err     error
+	Line    int    `json:"line"`
+	Field   string `json:"field,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Message string `json:"reason"`
+	Record  string `json:"-"`
+	Err     error  `json:"-"`
 }
 
 func (e *ImportError) Error() string {
+	msg := e.Message
+	if e.Field != "" {
+		msg = fmt.Sprintf("%s (%s=%q)", e.Message, e.Field, e.Value)
+	}
 	if e.Err != nil {
-		return fmt.Sprintf("import error at line %d: %s - %v", e.Line, e.Message, e.Err)
+		return fmt.Sprintf("import error at line %d: %s - %v", e.Line, msg, e.Err)
 	}
-	return fmt.Sprintf("import error at line %d: %s", e.Line, e.Message)
+	return fmt.Sprintf("import error at line %d: %s", e.Line, msg)
 }
 
 func (e *ImportError) Unwrap() error {
@@ -73,3 +121,13 @@ func NewImportError(line int, message string, err error) error {
 		Err:     err,
 	}
 }
+
+// NewFieldImportError creates a new import error for a single field
+func NewFieldImportError(line int, field, value, message string) error {
+	return &ImportError{
+		Line:    line,
+		Field:   field,
+		Value:   value,
+		Message: message,
+	}
+}
@@ -0,0 +1,59 @@
+package postalcode
+
+// ============================================================
+// 이벤트 (Events)
+// ============================================================
+
+// EventType은 라이브러리가 발생시키는 이벤트 종류입니다.
+type EventType string
+
+// 이벤트 종류
+const (
+	EventBatchStarted      EventType = "batch_started"      // 배치 저장 시작 (Start~End)
+	EventBatchCompleted    EventType = "batch_completed"    // 배치 저장 완료 (Start~End)
+	EventBatchFailed       EventType = "batch_failed"       // 배치 저장 실패 (Start~End, Err)
+	EventRowRejected       EventType = "row_rejected"       // 파싱/검증 실패로 행 거부 (Line, Field, Value, Message)
+	EventTruncateStarted   EventType = "truncate_started"   // 테이블 삭제 시작
+	EventTruncateCompleted EventType = "truncate_completed" // 테이블 삭제 완료
+	EventFileStarted       EventType = "file_started"       // 데이터셋의 파일 하나 저장 시작 (File)
+	EventReadProgress      EventType = "read_progress"      // 파일 읽기 진행 (Count/Total 바이트)
+	EventImportResumed     EventType = "import_resumed"     // 중단된 import 재개 (RunID, Count/Total 행)
+	EventRejectsWritten    EventType = "rejects_written"    // 거부 라인 파일 기록 완료 (File, Count)
+	EventWarning           EventType = "warning"            // import를 중단하지 않는 부가 작업 실패 (Message, Err)
+	EventImportFinished    EventType = "import_finished"    // import 실행 종료 (RunID, Err: 실패 원인, 성공하면 nil)
+)
+
+// Event는 Service와 Importer가 작업 중 발생시키는 이벤트입니다.
+// 종류에 따라 필요한 필드만 채워지며, 나머지는 zero value입니다.
+type Event struct {
+	Type     EventType
+	DataType string // "road", "land", "building", "pobox" 또는 "bulk"
+	File     string // 대상 파일 (데이터셋 파일, 거부 라인 파일)
+	Start    int    // 배치 시작 위치 (유효 행 기준, 포함)
+	End      int    // 배치 끝 위치 (미포함)
+	Line     int    // 거부된 라인 번호 (헤더가 1번 라인, 파일이 아닌 입력은 0)
+	Field    string // 거부 원인 필드
+	Value    string // 거부 원인 필드의 원본 값
+	Count    int    // 건수 (거부 라인 수, 읽은 바이트 수, 재개 시 건너뛴 행 수)
+	Total    int    // 전체 건수 (모르면 0)
+	RunID    uint   // import 실행 이력 ID
+	Message  string
+	Err      error
+}
+
+// EventHandler는 라이브러리 이벤트를 받는 인터페이스입니다.
+// 표준출력 대신 애플리케이션의 로거나 메트릭으로 이벤트를 전달할 때 구현합니다.
+type EventHandler interface {
+	HandleEvent(event Event)
+}
+
+// EventHandlerFunc는 함수를 EventHandler로 사용하기 위한 어댑터입니다.
+type EventHandlerFunc func(event Event)
+
+// HandleEvent는 f(event)를 호출합니다.
+func (f EventHandlerFunc) HandleEvent(event Event) {
+	f(event)
+}
+
+// NopEventHandler는 모든 이벤트를 무시하는 기본 EventHandler입니다.
+var NopEventHandler EventHandler = EventHandlerFunc(func(Event) {})
@@ -7,9 +7,6 @@ import (
 
 	postalcode "github.com/oursportsnation/korean-postalcode"
 	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
-
-	"gorm.io/driver/mysql"
-	"gorm.io/gorm"
 )
 
 func main() {
@@ -20,7 +17,7 @@ func main() {
 	}
 
 	// Connect to database
-	db, err := gorm.Open(mysql.Open(cfg.Database.GetDSN()), &gorm.Config{})
+	db, err := postalcodeapi.OpenDatabase(cfg.Database.GetDSN())
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
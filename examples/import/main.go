@@ -3,13 +3,11 @@ package main
 import (
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	postalcode "github.com/oursportsnation/korean-postalcode"
 	postalcodeapi "github.com/oursportsnation/korean-postalcode/pkg/postalcode"
-
-	"gorm.io/driver/mysql"
-	"gorm.io/gorm"
 )
 
 func main() {
@@ -21,21 +19,23 @@ func main() {
 
 	// Connect to database
 	fmt.Println("🔌 Connecting to database...")
-	db, err := gorm.Open(mysql.Open(cfg.Database.GetDSN()), &gorm.Config{})
+	db, err := postalcodeapi.OpenDatabase(cfg.Database.GetDSN())
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
 	// Auto migrate table
 	fmt.Println("🔧 Creating table if not exists...")
-	if err := db.AutoMigrate(&postalcode.PostalCodeRoad{}); err != nil {
+	if err := db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.ImportRun{}); err != nil {
 		log.Fatalf("Failed to migrate: %v", err)
 	}
 
 	// Initialize service and importer
 	repo := postalcodeapi.NewRepository(db)
-	service := postalcodeapi.NewService(repo)
-	importer := postalcodeapi.NewImporter(service)
+	// Print batch failures, rejected lines, etc. to the console
+	events := postalcodeapi.NewConsoleEventHandler(os.Stdout)
+	service := postalcodeapi.NewService(repo, postalcodeapi.WithServiceEventHandler(events))
+	importer := postalcodeapi.NewImporter(service, postalcodeapi.WithEventHandler(events))
 
 	// Import data
 	filePath := "../../docs/addresses/20251028_도로명범위.txt" // Adjust path as needed
@@ -0,0 +1,203 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+)
+
+// 기본 설정
+const (
+	defaultBatchSize = 5000
+	defaultMaxRowIDs = 100
+)
+
+// Auditor는 import된 테이블의 데이터 품질을 검사합니다.
+type Auditor interface {
+	// Run은 도로명주소/지번주소 테이블 전체를 순회하며 검사 규칙을 적용하고 리포트를 반환합니다.
+	// 위반이 있어도 에러를 반환하지 않으며, 결과는 리포트의 ErrorCount/WarningCount로 확인합니다.
+	Run() (*postalcode.AuditReport, error)
+}
+
+// auditor는 Auditor 인터페이스 구현입니다.
+type auditor struct {
+	service   service.Service
+	ruleIDs   []string
+	tables    []string
+	batchSize int
+	maxRowIDs int
+}
+
+// Option은 Auditor 생성 옵션입니다.
+type Option func(*auditor)
+
+// WithRules는 실행할 검사 규칙 ID를 지정합니다. (기본값: 모든 규칙)
+// 정의되지 않은 ID가 있으면 Run이 postalcode.ErrUnknownAuditRule을 반환합니다.
+func WithRules(ids ...string) Option {
+	return func(a *auditor) {
+		a.ruleIDs = ids
+	}
+}
+
+// WithTables는 검사할 테이블(TableRoad, TableLand)을 지정합니다. (기본값: 모두)
+func WithTables(tables ...string) Option {
+	return func(a *auditor) {
+		a.tables = tables
+	}
+}
+
+// WithBatchSize는 테이블을 순회할 때 한 번에 읽을 행 수를 지정합니다. (기본값: 5000)
+func WithBatchSize(size int) Option {
+	return func(a *auditor) {
+		if size > 0 {
+			a.batchSize = size
+		}
+	}
+}
+
+// WithMaxRowIDs는 규칙별로 리포트에 담을 위반 행 ID의 최대 개수를 지정합니다. (기본값: 100)
+// 0 이하이면 제한 없이 모든 ID를 담습니다.
+func WithMaxRowIDs(n int) Option {
+	return func(a *auditor) {
+		a.maxRowIDs = n
+	}
+}
+
+// New는 새로운 Auditor를 생성합니다.
+func New(svc service.Service, opts ...Option) Auditor {
+	a := &auditor{
+		service:   svc,
+		batchSize: defaultBatchSize,
+		maxRowIDs: defaultMaxRowIDs,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Run은 선택된 규칙으로 테이블을 검사합니다.
+func (a *auditor) Run() (*postalcode.AuditReport, error) {
+	startTime := time.Now()
+
+	selected, err := a.selectRules()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &postalcode.AuditReport{
+		Findings: make([]postalcode.AuditFinding, len(selected)),
+	}
+	var roadRules, landRules []int
+	for i, rule := range selected {
+		report.Findings[i] = postalcode.AuditFinding{
+			RuleID:      rule.ID,
+			Table:       rule.Table,
+			Severity:    rule.Severity,
+			Description: rule.Description,
+			RowIDs:      []uint{},
+		}
+		if rule.Table == TableRoad {
+			roadRules = append(roadRules, i)
+		} else {
+			landRules = append(landRules, i)
+		}
+	}
+
+	if len(roadRules) > 0 {
+		err := a.service.ScanRoads(postalcode.SearchParams{}, a.batchSize, func(roads []postalcode.PostalCodeRoad) error {
+			for i := range roads {
+				for _, idx := range roadRules {
+					if selected[idx].checkRoad(&roads[i]) {
+						a.record(&report.Findings[idx], roads[i].ID)
+					}
+				}
+			}
+			report.RoadCount += len(roads)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", TableRoad, err)
+		}
+	}
+
+	if len(landRules) > 0 {
+		err := a.service.ScanLands(postalcode.SearchParamsLand{}, a.batchSize, func(lands []postalcode.PostalCodeLand) error {
+			for i := range lands {
+				for _, idx := range landRules {
+					if selected[idx].checkLand(&lands[i]) {
+						a.record(&report.Findings[idx], lands[i].ID)
+					}
+				}
+			}
+			report.LandCount += len(lands)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", TableLand, err)
+		}
+	}
+
+	for _, finding := range report.Findings {
+		if finding.Severity == postalcode.AuditSeverityError {
+			report.ErrorCount += finding.Count
+		} else {
+			report.WarningCount += finding.Count
+		}
+	}
+
+	report.Duration = time.Since(startTime).String()
+	return report, nil
+}
+
+// selectRules는 옵션으로 지정한 규칙 ID와 테이블에 해당하는 규칙을 정의 순서대로 반환합니다.
+func (a *auditor) selectRules() ([]Rule, error) {
+	wanted := make(map[string]bool, len(a.ruleIDs))
+	for _, id := range a.ruleIDs {
+		if _, ok := findRule(id); !ok {
+			return nil, fmt.Errorf("%w: %s", postalcode.ErrUnknownAuditRule, id)
+		}
+		wanted[id] = true
+	}
+	tables := make(map[string]bool, len(a.tables))
+	for _, table := range a.tables {
+		if table != TableRoad && table != TableLand {
+			return nil, fmt.Errorf("unknown audit table: %s", table)
+		}
+		tables[table] = true
+	}
+
+	var selected []Rule
+	for _, rule := range rules {
+		if len(wanted) > 0 && !wanted[rule.ID] {
+			continue
+		}
+		if len(tables) > 0 && !tables[rule.Table] {
+			continue
+		}
+		selected = append(selected, rule)
+	}
+	return selected, nil
+}
+
+// record는 위반 행을 집계하고 최대 개수까지 ID를 기록합니다.
+func (a *auditor) record(finding *postalcode.AuditFinding, id uint) {
+	finding.Count++
+	if a.maxRowIDs > 0 && len(finding.RowIDs) >= a.maxRowIDs {
+		finding.Truncated = true
+		return
+	}
+	finding.RowIDs = append(finding.RowIDs, id)
+}
+
+// findRule은 ID로 규칙을 찾습니다.
+func findRule(id string) (Rule, bool) {
+	for _, rule := range rules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
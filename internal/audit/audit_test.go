@@ -0,0 +1,202 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/repository"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestAuditor(t *testing.T) (service.Service, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{})
+	require.NoError(t, err)
+
+	return service.New(repository.New(db)), db
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// validRoad는 모든 규칙을 통과하는 도로명주소 행입니다.
+func validRoad(zipCode, roadName string) postalcode.PostalCodeRoad {
+	return postalcode.PostalCodeRoad{
+		ZipCode:           zipCode,
+		ZipPrefix:         zipCode[:3],
+		SidoName:          "서울특별시",
+		SidoNameEn:        "Seoul",
+		SigunguName:       "강북구",
+		SigunguNameEn:     "Gangbuk-gu",
+		RoadName:          roadName,
+		RoadNameEn:        "Samyang-ro",
+		StartBuildingMain: 1,
+		StartBuildingSub:  intPtr(0),
+		EndBuildingMain:   intPtr(99),
+		EndBuildingSub:    intPtr(0),
+		RangeType:         postalcode.RangeTypeOdd,
+	}
+}
+
+// validLand는 모든 규칙을 통과하는 지번주소 행입니다.
+func validLand(zipCode, riName string) postalcode.PostalCodeLand {
+	return postalcode.PostalCodeLand{
+		ZipCode:            zipCode,
+		ZipPrefix:          zipCode[:3],
+		SidoName:           "강원특별자치도",
+		SidoNameEn:         "Gangwon-do",
+		SigunguName:        "강릉시",
+		SigunguNameEn:      "Gangneung-si",
+		EupmyeondongName:   "강동면",
+		EupmyeondongNameEn: "Gangdong-myeon",
+		RiName:             riName,
+		StartJibunMain:     2,
+		EndJibunMain:       intPtr(878),
+	}
+}
+
+func findingByID(t *testing.T, report *postalcode.AuditReport, id string) postalcode.AuditFinding {
+	for _, finding := range report.Findings {
+		if finding.RuleID == id {
+			return finding
+		}
+	}
+	t.Fatalf("finding %s not found", id)
+	return postalcode.AuditFinding{}
+}
+
+func TestAuditor_Run(t *testing.T) {
+	svc, db := setupTestAuditor(t)
+
+	roads := []postalcode.PostalCodeRoad{validRoad("01000", "삼양로")}
+
+	endBefore := validRoad("01001", "종료역전로")
+	endBefore.EndBuildingMain = intPtr(-1)
+	endBefore.RangeType = postalcode.RangeTypeAll
+	roads = append(roads, endBefore)
+
+	badType := validRoad("01002", "범위종류로")
+	badType.RangeType = 7
+	roads = append(roads, badType)
+
+	parity := validRoad("01003", "짝수로")
+	parity.RangeType = postalcode.RangeTypeEven
+	parity.StartBuildingMain = 2
+	parity.EndBuildingMain = intPtr(51)
+	roads = append(roads, parity)
+
+	prefix := validRoad("01004", "접두로")
+	prefix.ZipPrefix = "999"
+	prefix.RoadNameEn = ""
+	roads = append(roads, prefix)
+
+	single := validRoad("01005", "해당로")
+	single.RangeType = postalcode.RangeTypeSingle
+	single.StartBuildingMain = 0
+	roads = append(roads, single)
+
+	require.NoError(t, db.Create(&roads).Error)
+
+	lands := []postalcode.PostalCodeLand{validLand("25627", "모전리")}
+	landEnd := validLand("25628", "역전리")
+	landEnd.StartJibunSub = intPtr(5)
+	landEnd.EndJibunMain = intPtr(2)
+	landEnd.EndJibunSub = intPtr(3)
+	landEnd.SidoNameEn = ""
+	lands = append(lands, landEnd)
+	require.NoError(t, db.Create(&lands).Error)
+
+	report, err := New(svc, WithBatchSize(2)).Run()
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, report.RoadCount)
+	assert.Equal(t, 2, report.LandCount)
+	assert.Len(t, report.Findings, len(rules))
+
+	assert.Equal(t, []uint{roads[1].ID}, findingByID(t, report, "ROAD_END_BEFORE_START").RowIDs)
+	assert.Equal(t, []uint{roads[2].ID}, findingByID(t, report, "ROAD_RANGE_TYPE").RowIDs)
+	assert.Equal(t, []uint{roads[3].ID}, findingByID(t, report, "ROAD_RANGE_PARITY").RowIDs)
+	assert.Equal(t, []uint{roads[4].ID}, findingByID(t, report, "ROAD_ZIP_PREFIX").RowIDs)
+	assert.Equal(t, []uint{roads[4].ID}, findingByID(t, report, "ROAD_MISSING_EN").RowIDs)
+	assert.Equal(t, []uint{roads[5].ID}, findingByID(t, report, "ROAD_SINGLE_RANGE").RowIDs)
+	assert.Equal(t, []uint{roads[5].ID}, findingByID(t, report, "ROAD_START_NUMBER").RowIDs)
+	assert.Equal(t, 0, findingByID(t, report, "ROAD_ZIP_FORMAT").Count)
+	assert.Equal(t, []uint{}, findingByID(t, report, "ROAD_ZIP_FORMAT").RowIDs)
+
+	assert.Equal(t, []uint{lands[1].ID}, findingByID(t, report, "LAND_END_BEFORE_START").RowIDs)
+	assert.Equal(t, []uint{lands[1].ID}, findingByID(t, report, "LAND_MISSING_EN").RowIDs)
+
+	assert.Equal(t, 6, report.ErrorCount)
+	assert.Equal(t, 3, report.WarningCount)
+}
+
+func TestAuditor_Options(t *testing.T) {
+	svc, db := setupTestAuditor(t)
+
+	roads := make([]postalcode.PostalCodeRoad, 0, 5)
+	for i, name := range []string{"가로", "나로", "다로", "라로", "마로"} {
+		road := validRoad("0100"+string(rune('0'+i)), name)
+		road.ZipPrefix = "999"
+		roads = append(roads, road)
+	}
+	require.NoError(t, db.Create(&roads).Error)
+	require.NoError(t, db.Create(&[]postalcode.PostalCodeLand{validLand("25627", "모전리")}).Error)
+
+	t.Run("max row ids", func(t *testing.T) {
+		report, err := New(svc, WithRules("ROAD_ZIP_PREFIX"), WithMaxRowIDs(2)).Run()
+		require.NoError(t, err)
+		require.Len(t, report.Findings, 1)
+		assert.Equal(t, 5, report.Findings[0].Count)
+		assert.Equal(t, []uint{roads[0].ID, roads[1].ID}, report.Findings[0].RowIDs)
+		assert.True(t, report.Findings[0].Truncated)
+		assert.Equal(t, 0, report.LandCount) // 지번주소 규칙이 없으면 순회하지 않음
+	})
+
+	t.Run("tables", func(t *testing.T) {
+		report, err := New(svc, WithTables(TableLand)).Run()
+		require.NoError(t, err)
+		assert.Equal(t, 0, report.RoadCount)
+		assert.Equal(t, 1, report.LandCount)
+		assert.Equal(t, 0, report.ErrorCount)
+		for _, finding := range report.Findings {
+			assert.Equal(t, TableLand, finding.Table)
+		}
+	})
+
+	t.Run("unknown rule", func(t *testing.T) {
+		_, err := New(svc, WithRules("NO_SUCH_RULE")).Run()
+		assert.True(t, errors.Is(err, postalcode.ErrUnknownAuditRule))
+	})
+}
+
+func TestEndBeforeStart(t *testing.T) {
+	tests := []struct {
+		name      string
+		startMain int
+		startSub  *int
+		endMain   *int
+		endSub    *int
+		want      bool
+	}{
+		{"no end", 10, nil, nil, nil, false},
+		{"end greater", 10, nil, intPtr(20), nil, false},
+		{"end smaller", 10, nil, intPtr(9), nil, true},
+		{"same main, smaller sub", 10, intPtr(5), intPtr(10), intPtr(3), true},
+		{"same main, no end sub", 10, intPtr(5), intPtr(10), nil, false},
+		{"same number", 10, intPtr(5), intPtr(10), intPtr(5), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, endBeforeStart(tt.startMain, tt.startSub, tt.endMain, tt.endSub))
+		})
+	}
+}
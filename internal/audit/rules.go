@@ -0,0 +1,220 @@
+package audit
+
+import (
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// 검사 대상 테이블
+const (
+	TableRoad = "postal_code_roads"
+	TableLand = "postal_code_lands"
+)
+
+// Rule은 데이터 품질 검사 규칙입니다.
+// Table에 따라 checkRoad 또는 checkLand 중 하나만 설정되며, 행이 규칙을 위반하면 true를 반환합니다.
+type Rule struct {
+	ID          string
+	Table       string
+	Severity    string
+	Description string
+
+	checkRoad func(road *postalcode.PostalCodeRoad) bool
+	checkLand func(land *postalcode.PostalCodeLand) bool
+}
+
+// rules는 모든 검사 규칙입니다. (리포트 출력 순서)
+var rules = []Rule{
+	// 도로명주소
+	{
+		ID:          "ROAD_ZIP_FORMAT",
+		Table:       TableRoad,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "우편번호가 숫자 5자리가 아님",
+		checkRoad: func(road *postalcode.PostalCodeRoad) bool {
+			return !isZipCode(road.ZipCode)
+		},
+	},
+	{
+		ID:          "ROAD_ZIP_PREFIX",
+		Table:       TableRoad,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "zip_prefix가 우편번호 앞 3자리와 다름",
+		checkRoad: func(road *postalcode.PostalCodeRoad) bool {
+			return !prefixMatches(road.ZipCode, road.ZipPrefix)
+		},
+	},
+	{
+		ID:          "ROAD_RANGE_TYPE",
+		Table:       TableRoad,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "범위종류가 0(해당주소), 1(홀수), 2(짝수), 3(전체) 중 하나가 아님",
+		checkRoad: func(road *postalcode.PostalCodeRoad) bool {
+			return road.RangeType < postalcode.RangeTypeSingle || road.RangeType > postalcode.RangeTypeAll
+		},
+	},
+	{
+		ID:          "ROAD_START_NUMBER",
+		Table:       TableRoad,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "시작 건물번호 본번이 1 미만이거나 부번이 음수",
+		checkRoad: func(road *postalcode.PostalCodeRoad) bool {
+			return invalidStart(road.StartBuildingMain, road.StartBuildingSub, road.EndBuildingSub)
+		},
+	},
+	{
+		ID:          "ROAD_END_BEFORE_START",
+		Table:       TableRoad,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "종료 건물번호가 시작 건물번호보다 작음",
+		checkRoad: func(road *postalcode.PostalCodeRoad) bool {
+			return endBeforeStart(road.StartBuildingMain, road.StartBuildingSub, road.EndBuildingMain, road.EndBuildingSub)
+		},
+	},
+	{
+		ID:          "ROAD_RANGE_PARITY",
+		Table:       TableRoad,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "홀수/짝수 범위의 시작 또는 종료 건물번호 본번이 범위종류와 맞지 않음",
+		checkRoad: func(road *postalcode.PostalCodeRoad) bool {
+			var want int
+			switch road.RangeType {
+			case postalcode.RangeTypeOdd:
+				want = 1
+			case postalcode.RangeTypeEven:
+				want = 0
+			default:
+				return false
+			}
+			if road.StartBuildingMain%2 != want {
+				return true
+			}
+			return road.EndBuildingMain != nil && *road.EndBuildingMain%2 != want
+		},
+	},
+	{
+		ID:          "ROAD_SINGLE_RANGE",
+		Table:       TableRoad,
+		Severity:    postalcode.AuditSeverityWarning,
+		Description: "범위종류가 해당주소(0)인데 종료 건물번호가 시작 건물번호와 다름",
+		checkRoad: func(road *postalcode.PostalCodeRoad) bool {
+			if road.RangeType != postalcode.RangeTypeSingle || road.EndBuildingMain == nil {
+				return false
+			}
+			return *road.EndBuildingMain != road.StartBuildingMain || intValue(road.EndBuildingSub) != intValue(road.StartBuildingSub)
+		},
+	},
+	{
+		ID:          "ROAD_MISSING_EN",
+		Table:       TableRoad,
+		Severity:    postalcode.AuditSeverityWarning,
+		Description: "한글명이 있는 시도/시군구/읍면/도로명의 영문명이 비어 있음",
+		checkRoad: func(road *postalcode.PostalCodeRoad) bool {
+			return missingEnglish(road.SidoName, road.SidoNameEn) ||
+				missingEnglish(road.SigunguName, road.SigunguNameEn) ||
+				missingEnglish(road.EupmyeonName, road.EupmyeonNameEn) ||
+				missingEnglish(road.RoadName, road.RoadNameEn)
+		},
+	},
+
+	// 지번주소
+	{
+		ID:          "LAND_ZIP_FORMAT",
+		Table:       TableLand,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "우편번호가 숫자 5자리가 아님",
+		checkLand: func(land *postalcode.PostalCodeLand) bool {
+			return !isZipCode(land.ZipCode)
+		},
+	},
+	{
+		ID:          "LAND_ZIP_PREFIX",
+		Table:       TableLand,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "zip_prefix가 우편번호 앞 3자리와 다름",
+		checkLand: func(land *postalcode.PostalCodeLand) bool {
+			return !prefixMatches(land.ZipCode, land.ZipPrefix)
+		},
+	},
+	{
+		ID:          "LAND_START_NUMBER",
+		Table:       TableLand,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "시작 주번지가 1 미만이거나 부번지가 음수",
+		checkLand: func(land *postalcode.PostalCodeLand) bool {
+			return invalidStart(land.StartJibunMain, land.StartJibunSub, land.EndJibunSub)
+		},
+	},
+	{
+		ID:          "LAND_END_BEFORE_START",
+		Table:       TableLand,
+		Severity:    postalcode.AuditSeverityError,
+		Description: "종료 번지가 시작 번지보다 작음",
+		checkLand: func(land *postalcode.PostalCodeLand) bool {
+			return endBeforeStart(land.StartJibunMain, land.StartJibunSub, land.EndJibunMain, land.EndJibunSub)
+		},
+	},
+	{
+		ID:          "LAND_MISSING_EN",
+		Table:       TableLand,
+		Severity:    postalcode.AuditSeverityWarning,
+		Description: "한글명이 있는 시도/시군구/읍면동의 영문명이 비어 있음",
+		checkLand: func(land *postalcode.PostalCodeLand) bool {
+			return missingEnglish(land.SidoName, land.SidoNameEn) ||
+				missingEnglish(land.SigunguName, land.SigunguNameEn) ||
+				missingEnglish(land.EupmyeondongName, land.EupmyeondongNameEn)
+		},
+	},
+}
+
+// Rules는 모든 검사 규칙을 리포트 출력 순서로 반환합니다.
+func Rules() []Rule {
+	return append([]Rule(nil), rules...)
+}
+
+// isZipCode는 우편번호가 숫자 5자리인지 확인합니다.
+func isZipCode(zipCode string) bool {
+	if len(zipCode) != 5 {
+		return false
+	}
+	for i := 0; i < len(zipCode); i++ {
+		if zipCode[i] < '0' || zipCode[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// prefixMatches는 zipPrefix가 우편번호 앞 3자리와 같은지 확인합니다.
+func prefixMatches(zipCode, zipPrefix string) bool {
+	return len(zipCode) >= 3 && zipPrefix == zipCode[:3]
+}
+
+// invalidStart는 시작 본번이 1 미만이거나 부번이 음수인지 확인합니다.
+func invalidStart(startMain int, startSub, endSub *int) bool {
+	return startMain < 1 || intValue(startSub) < 0 || intValue(endSub) < 0
+}
+
+// endBeforeStart는 종료 번호(본번, 부번)가 시작 번호보다 작은지 확인합니다.
+// 종료 본번이 없으면 시작 번호 하나만 해당하므로 위반이 아닙니다.
+func endBeforeStart(startMain int, startSub, endMain, endSub *int) bool {
+	if endMain == nil {
+		return false
+	}
+	if *endMain != startMain {
+		return *endMain < startMain
+	}
+	return endSub != nil && *endSub < intValue(startSub)
+}
+
+// missingEnglish는 한글명이 있는데 영문명이 비어 있는지 확인합니다.
+func missingEnglish(name, nameEn string) bool {
+	return name != "" && nameEn == ""
+}
+
+// intValue는 nil이면 0을 반환합니다.
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
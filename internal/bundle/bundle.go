@@ -0,0 +1,212 @@
+// Package bundle은 배포용 SQLite 번들 파일을 만들고 엽니다.
+//
+// 번들은 우편번호 테이블의 스키마와 인덱스, 데이터, import 이력, 데이터셋 메타데이터
+// (postal_code_bundle_info 테이블)를 하나의 SQLite 파일에 담고 VACUUM으로 압축한 파일입니다.
+// DB 서버 없이 파일 하나만 배포하면 되며, 서비스에서는 읽기 전용으로 엽니다.
+package bundle
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/importer"
+	"github.com/oursportsnation/korean-postalcode/internal/repository"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// FormatVersion은 번들 형식 버전입니다. 테이블 구성이 호환되지 않게 바뀌면 올립니다.
+const FormatVersion = 1
+
+// tables는 번들에 포함하는 테이블 모델입니다.
+var tables = []interface{}{
+	&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.PostalCodeBuilding{},
+	&postalcode.PostalCodePOBox{}, &postalcode.PostalCodeBulkDelivery{}, &postalcode.ImportRun{},
+	&postalcode.BundleInfo{},
+}
+
+// Build는 input 배포 파일을 import하여 path에 SQLite 번들을 만듭니다.
+// input은 단일 파일(헤더로 종류 자동 감지) 또는 데이터셋(ZIP 아카이브, 디렉토리, glob 패턴)이며,
+// opts로 인코딩, 배포 기준일 등 Importer 옵션을 지정할 수 있습니다.
+// 같은 디렉토리의 임시 파일에 만든 뒤 이름을 바꾸므로, 서비스 중인 번들 파일을 안전하게 교체할 수 있습니다.
+func Build(path, input string, batchSize int, progressFn postalcode.ProgressFunc, opts ...importer.Option) (*postalcode.BundleInfo, error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bundle file: %w", err)
+	}
+	tmpPath := f.Name()
+	f.Close()
+	defer os.Remove(tmpPath)
+
+	info, err := build(tmpPath, input, batchSize, progressFn, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return nil, fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return info, nil
+}
+
+// build는 tmpPath의 빈 파일에 번들을 만듭니다.
+func build(tmpPath, input string, batchSize int, progressFn postalcode.ProgressFunc, opts []importer.Option) (*postalcode.BundleInfo, error) {
+	db, err := gorm.Open(sqlite.Open(tmpPath), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bundle file: %w", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	defer sqlDB.Close()
+
+	// PRAGMA는 연결 단위로 적용되므로 연결을 하나만 사용
+	sqlDB.SetMaxOpenConns(1)
+
+	// 빌드 중에는 실패하면 파일을 버리므로 저널과 fsync가 필요 없음
+	for _, pragma := range []string{"PRAGMA journal_mode = OFF", "PRAGMA synchronous = OFF"} {
+		if err := db.Exec(pragma).Error; err != nil {
+			return nil, fmt.Errorf("failed to configure bundle file: %w", err)
+		}
+	}
+
+	if err := db.AutoMigrate(tables...); err != nil {
+		return nil, fmt.Errorf("failed to create bundle schema: %w", err)
+	}
+
+	svc := service.New(repository.New(db))
+	if err := importSource(importer.New(svc, opts...), input, batchSize, progressFn); err != nil {
+		return nil, err
+	}
+
+	info := &postalcode.BundleInfo{
+		FormatVersion: FormatVersion,
+		BuiltAt:       time.Now().UTC().Truncate(time.Second),
+		Source:        filepath.Base(input),
+	}
+	if run, err := svc.GetCurrentDataset(); err == nil {
+		info.DatasetVersion = run.DatasetVersion()
+		info.DatasetDate = run.DatasetDate
+	} else if !errors.Is(err, postalcode.ErrNotFound) {
+		return nil, fmt.Errorf("failed to read current dataset: %w", err)
+	}
+
+	counts := []struct {
+		model interface{}
+		count *int64
+	}{
+		{&postalcode.PostalCodeRoad{}, &info.RoadCount},
+		{&postalcode.PostalCodeLand{}, &info.LandCount},
+		{&postalcode.PostalCodeBuilding{}, &info.BuildingCount},
+		{&postalcode.PostalCodePOBox{}, &info.POBoxCount},
+		{&postalcode.PostalCodeBulkDelivery{}, &info.BulkDeliveryCount},
+	}
+	for _, c := range counts {
+		if err := db.Model(c.model).Count(c.count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count rows: %w", err)
+		}
+	}
+
+	if err := db.Create(info).Error; err != nil {
+		return nil, fmt.Errorf("failed to write bundle info: %w", err)
+	}
+
+	// 조회 계획용 통계를 만들고, 배포 파일이 독립적이도록 rollback 저널 모드로 되돌린 뒤 압축
+	for _, stmt := range []string{"ANALYZE", "PRAGMA journal_mode = DELETE", "VACUUM"} {
+		if err := db.Exec(stmt).Error; err != nil {
+			return nil, fmt.Errorf("failed to finalize bundle (%s): %w", stmt, err)
+		}
+	}
+	if err := sqlDB.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close bundle file: %w", err)
+	}
+
+	if stat, err := os.Stat(tmpPath); err == nil {
+		info.Size = stat.Size()
+	}
+	return info, nil
+}
+
+// importSource는 input을 종류에 맞게 import합니다.
+func importSource(imp importer.Importer, input string, batchSize int, progressFn postalcode.ProgressFunc) error {
+	if importer.IsDataset(input) {
+		if _, err := imp.ImportDataset(input, batchSize, progressFn); err != nil {
+			return fmt.Errorf("failed to import dataset: %w", err)
+		}
+		return nil
+	}
+
+	dataType, err := imp.DetectDataType(input)
+	if err != nil {
+		return err
+	}
+
+	switch dataType {
+	case postalcode.ImportDataTypeRoad:
+		_, err = imp.ImportFromFile(input, batchSize, progressFn)
+	case postalcode.ImportDataTypeLand:
+		_, err = imp.ImportLandFromFile(input, batchSize, progressFn)
+	case postalcode.ImportDataTypeBuilding:
+		_, err = imp.ImportBuildingFromFile(input, batchSize, progressFn)
+	case postalcode.ImportDataTypePOBox:
+		_, err = imp.ImportPOBoxFromFile(input, batchSize, progressFn)
+	case postalcode.ImportDataTypeBulk:
+		_, err = imp.ImportBulkDeliveryFromFile(input, batchSize, progressFn)
+	default:
+		err = fmt.Errorf("unsupported data type: %s", dataType)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to import %s: %w", filepath.Base(input), err)
+	}
+	return nil
+}
+
+// Open은 번들 파일을 읽기 전용으로 엽니다.
+// 번들 메타데이터를 확인하여 번들이 아니거나 지원하지 않는 형식 버전이면 ErrInvalidBundle을 반환합니다.
+func Open(path string) (*gorm.DB, *postalcode.BundleInfo, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 파일은 이미 있으므로 연결(ping) 실패는 SQLite 파일이 아닌 경우
+	db, err := gorm.Open(sqlite.Open("file:"+path+"?mode=ro"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", postalcode.ErrInvalidBundle, err)
+	}
+
+	info, err := ReadInfo(db)
+	if err != nil {
+		if sqlDB, dbErr := db.DB(); dbErr == nil {
+			sqlDB.Close()
+		}
+		return nil, nil, err
+	}
+	info.Size = stat.Size()
+	return db, info, nil
+}
+
+// ReadInfo는 번들 DB의 메타데이터를 읽습니다.
+func ReadInfo(db *gorm.DB) (*postalcode.BundleInfo, error) {
+	if !db.Migrator().HasTable(&postalcode.BundleInfo{}) {
+		return nil, fmt.Errorf("%w: bundle info table not found", postalcode.ErrInvalidBundle)
+	}
+
+	var info postalcode.BundleInfo
+	if err := db.Order("id DESC").First(&info).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: bundle info not found", postalcode.ErrInvalidBundle)
+		}
+		return nil, fmt.Errorf("failed to read bundle info: %w", err)
+	}
+	if info.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d", postalcode.ErrInvalidBundle, info.FormatVersion)
+	}
+	return &info, nil
+}
@@ -0,0 +1,147 @@
+package bundle
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/importer"
+	"github.com/oursportsnation/korean-postalcode/internal/repository"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRoadFile = `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류
+01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로177길|Samyang-ro 177-gil|0|93|0|126|0|3
+06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|1|101|2|||0
+63000|제주특별자치도|Jeju-do|제주시|Jeju-si|애월읍|Aewol-eup|애월로|Aewol-ro|0|1||9||1
+`
+
+const testLandFile = `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면동명|읍면동명(영문)|리명|산여부|행정동명|지번본번(시작)|지번부번(시작)|지번본번(종료)|지번부번(종료)
+25627|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|모전리|0||1|0|100|0
+48000|부산광역시|Busan|중구|Jung-gu|중앙동|Jungang-dong||0||1|||
+`
+
+const testPOBoxFile = "우편번호|시도|시군구|읍면동|리|사서함명|시작번호(주)|시작번호(부)|끝번호(주)|끝번호(부)\n" +
+	"03154|서울특별시|종로구|세종로||광화문우체국사서함|1||100|\n"
+
+// writeDataset은 테스트용 데이터셋 디렉토리를 만듭니다.
+func writeDataset(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "road.txt"), []byte(testRoadFile), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "land.txt"), []byte(testLandFile), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pobox.txt"), []byte(testPOBoxFile), 0o644))
+	return dir
+}
+
+func TestBuild_Dataset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "postalcode.sqlite")
+
+	info, err := Build(path, writeDataset(t), 100, nil, importer.WithDatasetDate("2024-05-01"))
+	require.NoError(t, err)
+	assert.Equal(t, FormatVersion, info.FormatVersion)
+	assert.Equal(t, int64(3), info.RoadCount)
+	assert.Equal(t, int64(2), info.LandCount)
+	assert.Equal(t, int64(1), info.POBoxCount)
+	assert.Equal(t, int64(0), info.BuildingCount)
+	assert.Equal(t, "2024-05-01", info.DatasetDate)
+	assert.True(t, strings.HasPrefix(info.DatasetVersion, "2024-05-01+"))
+	assert.Greater(t, info.Size, int64(0))
+
+	// 임시 파일이 남지 않음
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	db, opened, err := Open(path)
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	assert.Equal(t, info.DatasetVersion, opened.DatasetVersion)
+	assert.Equal(t, info.RoadCount, opened.RoadCount)
+	assert.Equal(t, info.Size, opened.Size)
+
+	// 번들로 조회
+	svc := service.New(repository.New(db))
+	roads, err := svc.GetByZipCode("06000")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+	assert.Equal(t, "테헤란로", roads[0].RoadName)
+
+	lands, err := svc.GetLandByZipCode("25627")
+	require.NoError(t, err)
+	require.Len(t, lands, 1)
+
+	run, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, info.DatasetVersion, run.DatasetVersion())
+
+	// 읽기 전용
+	err = svc.BatchDelete([]uint{roads[0].ID})
+	assert.Error(t, err)
+	roads, err = svc.GetByZipCode("06000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 1)
+}
+
+func TestBuild_SingleFile(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "land.txt")
+	require.NoError(t, os.WriteFile(input, []byte(testLandFile), 0o644))
+	path := filepath.Join(dir, "postalcode.sqlite")
+
+	info, err := Build(path, input, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "land.txt", info.Source)
+	assert.Equal(t, int64(0), info.RoadCount)
+	assert.Equal(t, int64(2), info.LandCount)
+
+	// 다시 만들면 기존 번들을 교체
+	info, err = Build(path, input, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), info.LandCount)
+
+	db, _, err := Open(path)
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	var count int64
+	require.NoError(t, db.Model(&postalcode.BundleInfo{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestBuild_InvalidInput(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "unknown.txt")
+	require.NoError(t, os.WriteFile(input, []byte("a|b|c\n1|2|3\n"), 0o644))
+	path := filepath.Join(dir, "postalcode.sqlite")
+
+	_, err := Build(path, input, 100, nil)
+	require.Error(t, err)
+
+	// 실패하면 번들 파일을 만들지 않음
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestOpen_NotBundle(t *testing.T) {
+	dir := t.TempDir()
+
+	notSQLite := filepath.Join(dir, "text.sqlite")
+	require.NoError(t, os.WriteFile(notSQLite, []byte("not a database"), 0o644))
+	_, _, err := Open(notSQLite)
+	assert.True(t, errors.Is(err, postalcode.ErrInvalidBundle), "got %v", err)
+
+	_, _, err = Open(filepath.Join(dir, "missing.sqlite"))
+	assert.True(t, os.IsNotExist(err), "got %v", err)
+}
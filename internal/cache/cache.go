@@ -0,0 +1,743 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/repository"
+)
+
+// 기본 캐시 설정
+const (
+	DefaultSize                 = 10000       // 최대 항목 수 (조회 조건 하나가 항목 하나)
+	DefaultTTL                  = time.Hour   // 항목 보관 시간
+	DefaultVersionCheckInterval = time.Minute // 데이터셋 버전 확인 주기
+)
+
+// errLoadAborted는 같은 조회를 먼저 시작한 요청이 panic 등으로 결과 없이 끝났을 때 기다리던 요청에 반환됩니다.
+var errLoadAborted = errors.New("cache: load aborted")
+
+// Repository는 조회 결과를 캐시하는 Repository입니다.
+//
+// 우편번호/검색 조회 결과를 LRU 캐시에 보관하고, 같은 조회가 동시에 들어오면 DB 조회는 한 번만 합니다.
+// 프로세스 캐시는 다음 경우에 모두 비웁니다.
+//   - 이 Repository를 통해 주소 데이터를 쓴 경우 (Create, BatchCreate, Truncate 등)
+//   - import 실행이 끝난 경우 (UpdateImportRun 또는 EventImportFinished 이벤트)
+//   - 마지막으로 성공한 import 실행이 바뀐 경우 (다른 프로세스의 import, 주기적으로 확인)
+//
+// WithStore로 공유 저장소를 지정하면 프로세스 캐시에 없는 항목을 저장소에서 찾고, 원본에서 조회한 결과를 저장소에도 저장합니다.
+// 저장소 키에는 마지막으로 성공한 import 실행(데이터셋 버전, 데이터 타입, 실행 ID)이 들어가므로 같은 파일을 다시 import해도
+// 모든 프로세스가 새 키를 사용하고, 이전 실행의 항목은 TTL이 지나면 사라집니다.
+type Repository interface {
+	repository.Repository
+
+	// HandleEvent는 EventImportFinished 이벤트를 받으면 캐시를 비웁니다.
+	// 다른 Repository로 import하는 Importer의 이벤트 핸들러로 지정합니다.
+	HandleEvent(event postalcode.Event)
+
+	// Stats는 캐시 통계를 반환합니다.
+	Stats() postalcode.CacheStats
+
+	// Purge는 캐시를 모두 비웁니다.
+	Purge()
+}
+
+// Option은 캐싱 Repository 생성 옵션입니다.
+type Option func(*options)
+
+type options struct {
+	size                 int
+	ttl                  time.Duration
+	versionCheckInterval time.Duration
+	store                Store
+	now                  func() time.Time
+}
+
+// WithSize는 캐시에 보관할 최대 항목 수를 지정하는 옵션입니다. (기본 DefaultSize)
+func WithSize(size int) Option {
+	return func(o *options) {
+		if size > 0 {
+			o.size = size
+		}
+	}
+}
+
+// WithTTL은 캐시 항목 보관 시간을 지정하는 옵션입니다. (기본 DefaultTTL, 0이면 만료 없음)
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// WithVersionCheckInterval은 데이터셋 버전을 확인하는 주기를 지정하는 옵션입니다. (기본 DefaultVersionCheckInterval)
+// 조회 요청이 있을 때 주기가 지났으면 마지막으로 성공한 import를 조회하고, 버전이 바뀌었으면 캐시를 비웁니다.
+// 0이면 확인하지 않습니다.
+func WithVersionCheckInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.versionCheckInterval = interval
+	}
+}
+
+// WithStore는 여러 프로세스가 공유하는 캐시 저장소(RedisStore 등)를 지정하는 옵션입니다.
+// 저장소 항목도 WithTTL의 보관 시간이 지나면 만료됩니다. import 실행별로 키를 나누므로,
+// 버전 확인을 껐거나 import 이력(성공한 import_runs)이 없으면 저장소를 사용하지 않고 프로세스 캐시만 사용합니다.
+func WithStore(store Store) Option {
+	return func(o *options) {
+		o.store = store
+	}
+}
+
+// call은 진행 중인 조회입니다. 같은 키를 조회하는 요청은 이 조회의 결과를 기다립니다.
+type call struct {
+	wg    sync.WaitGroup
+	value interface{}
+	err   error
+}
+
+// cachedRepository는 Repository 구현입니다.
+type cachedRepository struct {
+	repo repository.Repository
+	opts options
+
+	mu         sync.Mutex
+	lru        *lru
+	calls      map[string]*call
+	generation uint64 // Purge마다 증가하며, 이전 세대에 시작한 조회 결과는 캐시하지 않음
+
+	hits          uint64
+	misses        uint64
+	coalesced     uint64
+	invalidations uint64
+	storeHits     uint64
+	storeErrors   uint64
+
+	version          string // 마지막으로 성공한 import의 데이터셋 버전 (Stats)
+	datasetKey       string // 공유 저장소 키에 넣는 데이터셋 식별자 (datasetKey 함수)
+	versionKnown     bool
+	versionCheckedAt time.Time
+	versionChecking  bool
+}
+
+// New는 repo의 조회 결과를 캐시하는 Repository를 생성합니다.
+func New(repo repository.Repository, opts ...Option) Repository {
+	o := options{
+		size:                 DefaultSize,
+		ttl:                  DefaultTTL,
+		versionCheckInterval: DefaultVersionCheckInterval,
+		now:                  time.Now,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &cachedRepository{
+		repo:  repo,
+		opts:  o,
+		lru:   newLRU(o.size, o.ttl),
+		calls: make(map[string]*call),
+	}
+}
+
+// ============================================================
+// 캐시 관리
+// ============================================================
+
+// load는 key의 캐시된 값을 반환합니다. 없으면 공유 저장소에서 찾고, 거기에도 없으면 fn으로 조회해 캐시합니다. (오류는 캐시하지 않음)
+// zero는 값의 zero value로, 저장소의 JSON을 같은 타입으로 읽는 데 사용합니다.
+// 같은 key를 조회 중인 요청이 있으면 새로 조회하지 않고 그 결과를 기다립니다.
+func (c *cachedRepository) load(key string, zero interface{}, fn func() (interface{}, error)) (interface{}, error) {
+	c.checkVersion()
+
+	c.mu.Lock()
+	if value, ok := c.lru.get(key, c.opts.now()); ok {
+		c.hits++
+		c.mu.Unlock()
+		return value, nil
+	}
+	if cl, ok := c.calls[key]; ok {
+		c.coalesced++
+		c.mu.Unlock()
+		cl.wg.Wait()
+		return cl.value, cl.err
+	}
+	cl := &call{err: errLoadAborted}
+	cl.wg.Add(1)
+	c.calls[key] = cl
+	c.misses++
+	generation := c.generation
+	storeKey := c.storeKey(key)
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		if c.calls[key] == cl {
+			delete(c.calls, key)
+		}
+		if cl.err == nil && generation == c.generation {
+			c.lru.add(key, cl.value, c.opts.now())
+		}
+		c.mu.Unlock()
+		cl.wg.Done()
+	}()
+
+	if value, ok := c.storeGet(storeKey, zero); ok {
+		cl.value, cl.err = value, nil
+		return cl.value, cl.err
+	}
+	cl.value, cl.err = fn()
+	if cl.err == nil {
+		c.storeSet(storeKey, cl.value)
+	}
+	return cl.value, cl.err
+}
+
+// storeKey는 key의 공유 저장소 키입니다. 새 import가 성공하면 키도 바뀝니다.
+// 마지막으로 성공한 import 실행을 알 수 없으면(import 이력이 없거나 버전 확인을 끈 경우) import해도 키가 바뀌지 않아
+// 다른 프로세스가 이전 항목을 계속 읽게 되므로, 빈 문자열을 반환하여 공유 저장소를 사용하지 않습니다.
+func (c *cachedRepository) storeKey(key string) string {
+	if c.datasetKey == "" {
+		return ""
+	}
+	return c.datasetKey + ":" + key
+}
+
+// storeGet은 공유 저장소에서 값을 읽습니다. 저장소나 키가 없거나 오류가 나면 없는 것으로 처리합니다.
+func (c *cachedRepository) storeGet(key string, zero interface{}) (interface{}, bool) {
+	if c.opts.store == nil || key == "" {
+		return nil, false
+	}
+	data, ok, err := c.opts.store.Get(key)
+	if err == nil && ok {
+		ptr := reflect.New(reflect.TypeOf(zero))
+		if err = json.Unmarshal(data, ptr.Interface()); err == nil {
+			c.countStore(&c.storeHits)
+			return ptr.Elem().Interface(), true
+		}
+	}
+	if err != nil {
+		c.countStore(&c.storeErrors)
+	}
+	return nil, false
+}
+
+// storeSet은 값을 공유 저장소에 저장합니다. 키가 없으면 저장하지 않으며, 실패해도 조회 결과에는 영향이 없습니다.
+func (c *cachedRepository) storeSet(key string, value interface{}) {
+	if c.opts.store == nil || key == "" {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = c.opts.store.Set(key, data, c.opts.ttl)
+	}
+	if err != nil {
+		c.countStore(&c.storeErrors)
+	}
+}
+
+func (c *cachedRepository) countStore(counter *uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*counter++
+}
+
+// checkVersion은 확인 주기가 지났으면 데이터셋 버전을 조회하고, 바뀌었으면 캐시를 비웁니다.
+// 다른 요청이 확인 중이면 기다리지 않고 넘어갑니다.
+func (c *cachedRepository) checkVersion() {
+	if c.opts.versionCheckInterval <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	now := c.opts.now()
+	if c.versionChecking || (!c.versionCheckedAt.IsZero() && now.Sub(c.versionCheckedAt) < c.opts.versionCheckInterval) {
+		c.mu.Unlock()
+		return
+	}
+	c.versionChecking = true
+	c.mu.Unlock()
+
+	run, err := c.latestRun()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.versionChecking = false
+	c.versionCheckedAt = now
+	if err != nil {
+		// 조회에 실패하면 이전 버전을 유지하고 확인 주기가 지난 뒤 다시 확인 (요청마다 조회하지 않음)
+		return
+	}
+	key := datasetKey(run)
+	if c.versionKnown && key != c.datasetKey {
+		c.purgeLocked()
+	}
+	c.version = ""
+	if run != nil {
+		c.version = run.DatasetVersion()
+	}
+	c.datasetKey = key
+	c.versionKnown = true
+}
+
+// latestRun은 마지막으로 성공한 import 실행을 반환합니다. import 이력이 없거나 import_runs 테이블이 없으면 nil입니다.
+func (c *cachedRepository) latestRun() (*postalcode.ImportRun, error) {
+	run, err := c.repo.FindLatestImportRun()
+	if errors.Is(err, postalcode.ErrNotFound) || errors.Is(err, postalcode.ErrImportHistoryUnavailable) {
+		return nil, nil
+	}
+	return run, err
+}
+
+// datasetKey는 import 실행의 데이터셋 식별자입니다. (예: "2024-05-01+9f86d081/road/7")
+// 데이터셋 버전은 배포 기준일과 파일 SHA-256으로만 정해지므로 같은 파일을 다시 import하거나 A→B→A로 되돌리면 같아집니다.
+// 그래서 import 실행마다 증가하는 실행 ID와 데이터 타입을 붙여, 이전 실행에서 저장한 공유 저장소 항목을 다시 읽지 않게 합니다.
+// run이 nil이면 빈 문자열입니다.
+func datasetKey(run *postalcode.ImportRun) string {
+	if run == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%d", run.DatasetVersion(), run.DataType, run.ID)
+}
+
+// Purge는 캐시를 모두 비웁니다.
+func (c *cachedRepository) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeLocked()
+}
+
+// purgeLocked는 캐시를 비웁니다. 진행 중인 조회는 결과를 캐시하지 않으며, 이후 요청은 새로 조회합니다.
+func (c *cachedRepository) purgeLocked() {
+	c.lru.purge()
+	c.calls = make(map[string]*call)
+	c.generation++
+	c.invalidations++
+}
+
+// importFinished는 캐시를 비우고, 다음 조회에서 확인 주기와 관계없이 데이터셋 버전을 다시 확인하게 합니다.
+func (c *cachedRepository) importFinished() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeLocked()
+	c.versionCheckedAt = time.Time{}
+}
+
+// HandleEvent는 EventImportFinished 이벤트를 받으면 캐시를 비웁니다.
+func (c *cachedRepository) HandleEvent(event postalcode.Event) {
+	if event.Type == postalcode.EventImportFinished {
+		c.importFinished()
+	}
+}
+
+// Stats는 캐시 통계를 반환합니다.
+func (c *cachedRepository) Stats() postalcode.CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return postalcode.CacheStats{
+		Hits:           c.hits,
+		Misses:         c.misses,
+		Coalesced:      c.coalesced,
+		Evictions:      c.lru.evictions,
+		Expired:        c.lru.expired,
+		Invalidations:  c.invalidations,
+		StoreHits:      c.storeHits,
+		StoreErrors:    c.storeErrors,
+		Entries:        c.lru.len(),
+		Capacity:       c.lru.capacity,
+		DatasetVersion: c.version,
+	}
+}
+
+// Close는 원본 Repository가 io.Closer이면 닫습니다. (스냅샷 Repository 등)
+func (c *cachedRepository) Close() error {
+	if closer, ok := c.repo.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// paramsKey는 검색 조건을 캐시 키로 만듭니다.
+func paramsKey(kind string, params interface{}) string {
+	data, err := json.Marshal(params)
+	if err != nil {
+		// 검색 조건은 문자열/숫자 필드만 있으므로 실패하지 않음
+		return fmt.Sprintf("%s:%#v", kind, params)
+	}
+	return kind + ":" + string(data)
+}
+
+// pageKey는 우편번호 앞 3자리 조회를 캐시 키로 만듭니다.
+func pageKey(kind, zipPrefix string, limit, offset int) string {
+	return fmt.Sprintf("%s:%s:%d:%d", kind, zipPrefix, limit, offset)
+}
+
+// ============================================================
+// 캐시 항목
+// ============================================================
+// 캐시된 목록은 호출자가 수정해도 캐시에 영향이 없도록 복사해서 반환합니다.
+// 공유 저장소(Store)에는 JSON으로 저장합니다.
+
+type roadPage struct {
+	Rows  []postalcode.PostalCodeRoad `json:"rows"`
+	Total int64                       `json:"total"`
+}
+
+func (p roadPage) copy() ([]postalcode.PostalCodeRoad, int64) {
+	if p.Rows == nil {
+		return nil, p.Total
+	}
+	rows := make([]postalcode.PostalCodeRoad, len(p.Rows))
+	copy(rows, p.Rows)
+	return rows, p.Total
+}
+
+type landPage struct {
+	Rows  []postalcode.PostalCodeLand `json:"rows"`
+	Total int64                       `json:"total"`
+}
+
+func (p landPage) copy() ([]postalcode.PostalCodeLand, int64) {
+	if p.Rows == nil {
+		return nil, p.Total
+	}
+	rows := make([]postalcode.PostalCodeLand, len(p.Rows))
+	copy(rows, p.Rows)
+	return rows, p.Total
+}
+
+type buildingPage struct {
+	Rows  []postalcode.PostalCodeBuilding `json:"rows"`
+	Total int64                           `json:"total"`
+}
+
+func (p buildingPage) copy() ([]postalcode.PostalCodeBuilding, int64) {
+	if p.Rows == nil {
+		return nil, p.Total
+	}
+	rows := make([]postalcode.PostalCodeBuilding, len(p.Rows))
+	copy(rows, p.Rows)
+	return rows, p.Total
+}
+
+type poBoxPage struct {
+	Rows  []postalcode.PostalCodePOBox `json:"rows"`
+	Total int64                        `json:"total"`
+}
+
+func (p poBoxPage) copy() ([]postalcode.PostalCodePOBox, int64) {
+	if p.Rows == nil {
+		return nil, p.Total
+	}
+	rows := make([]postalcode.PostalCodePOBox, len(p.Rows))
+	copy(rows, p.Rows)
+	return rows, p.Total
+}
+
+type bulkDeliveryPage struct {
+	Rows  []postalcode.PostalCodeBulkDelivery `json:"rows"`
+	Total int64                               `json:"total"`
+}
+
+func (p bulkDeliveryPage) copy() ([]postalcode.PostalCodeBulkDelivery, int64) {
+	if p.Rows == nil {
+		return nil, p.Total
+	}
+	rows := make([]postalcode.PostalCodeBulkDelivery, len(p.Rows))
+	copy(rows, p.Rows)
+	return rows, p.Total
+}
+
+// ============================================================
+// 도로명주소
+// ============================================================
+
+func (c *cachedRepository) FindByZipCode(zipCode string) ([]postalcode.PostalCodeRoad, error) {
+	value, err := c.load("road:zip:"+zipCode, roadPage{}, func() (interface{}, error) {
+		rows, err := c.repo.FindByZipCode(zipCode)
+		return roadPage{Rows: rows}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, _ := value.(roadPage).copy()
+	return rows, nil
+}
+
+func (c *cachedRepository) FindByZipPrefix(zipPrefix string, limit, offset int) ([]postalcode.PostalCodeRoad, int64, error) {
+	value, err := c.load(pageKey("road:prefix", zipPrefix, limit, offset), roadPage{}, func() (interface{}, error) {
+		rows, total, err := c.repo.FindByZipPrefix(zipPrefix, limit, offset)
+		return roadPage{Rows: rows, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, total := value.(roadPage).copy()
+	return rows, total, nil
+}
+
+func (c *cachedRepository) Search(params postalcode.SearchParams) ([]postalcode.PostalCodeRoad, int64, error) {
+	value, err := c.load(paramsKey("road:search", params), roadPage{}, func() (interface{}, error) {
+		rows, total, err := c.repo.Search(params)
+		return roadPage{Rows: rows, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, total := value.(roadPage).copy()
+	return rows, total, nil
+}
+
+func (c *cachedRepository) Create(road *postalcode.PostalCodeRoad) error {
+	defer c.Purge()
+	return c.repo.Create(road)
+}
+
+func (c *cachedRepository) BatchCreate(roads []postalcode.PostalCodeRoad) error {
+	defer c.Purge()
+	return c.repo.BatchCreate(roads)
+}
+
+func (c *cachedRepository) Update(road *postalcode.PostalCodeRoad) error {
+	defer c.Purge()
+	return c.repo.Update(road)
+}
+
+func (c *cachedRepository) Delete(id uint) error {
+	defer c.Purge()
+	return c.repo.Delete(id)
+}
+
+func (c *cachedRepository) BatchDelete(ids []uint) error {
+	defer c.Purge()
+	return c.repo.BatchDelete(ids)
+}
+
+// ScanRoads는 전체 데이터 순회이므로 캐시하지 않습니다.
+func (c *cachedRepository) ScanRoads(params postalcode.SearchParams, batchSize int, fn func([]postalcode.PostalCodeRoad) error) error {
+	return c.repo.ScanRoads(params, batchSize, fn)
+}
+
+func (c *cachedRepository) TruncateRoad() error {
+	defer c.Purge()
+	return c.repo.TruncateRoad()
+}
+
+// ============================================================
+// 지번주소
+// ============================================================
+
+func (c *cachedRepository) FindLandByZipCode(zipCode string) ([]postalcode.PostalCodeLand, error) {
+	value, err := c.load("land:zip:"+zipCode, landPage{}, func() (interface{}, error) {
+		rows, err := c.repo.FindLandByZipCode(zipCode)
+		return landPage{Rows: rows}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, _ := value.(landPage).copy()
+	return rows, nil
+}
+
+func (c *cachedRepository) FindLandByZipPrefix(zipPrefix string, limit, offset int) ([]postalcode.PostalCodeLand, int64, error) {
+	value, err := c.load(pageKey("land:prefix", zipPrefix, limit, offset), landPage{}, func() (interface{}, error) {
+		rows, total, err := c.repo.FindLandByZipPrefix(zipPrefix, limit, offset)
+		return landPage{Rows: rows, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, total := value.(landPage).copy()
+	return rows, total, nil
+}
+
+func (c *cachedRepository) SearchLand(params postalcode.SearchParamsLand) ([]postalcode.PostalCodeLand, int64, error) {
+	value, err := c.load(paramsKey("land:search", params), landPage{}, func() (interface{}, error) {
+		rows, total, err := c.repo.SearchLand(params)
+		return landPage{Rows: rows, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, total := value.(landPage).copy()
+	return rows, total, nil
+}
+
+func (c *cachedRepository) CreateLand(land *postalcode.PostalCodeLand) error {
+	defer c.Purge()
+	return c.repo.CreateLand(land)
+}
+
+func (c *cachedRepository) BatchCreateLand(lands []postalcode.PostalCodeLand) error {
+	defer c.Purge()
+	return c.repo.BatchCreateLand(lands)
+}
+
+func (c *cachedRepository) UpdateLand(land *postalcode.PostalCodeLand) error {
+	defer c.Purge()
+	return c.repo.UpdateLand(land)
+}
+
+func (c *cachedRepository) DeleteLand(id uint) error {
+	defer c.Purge()
+	return c.repo.DeleteLand(id)
+}
+
+func (c *cachedRepository) BatchDeleteLand(ids []uint) error {
+	defer c.Purge()
+	return c.repo.BatchDeleteLand(ids)
+}
+
+// ScanLands는 전체 데이터 순회이므로 캐시하지 않습니다.
+func (c *cachedRepository) ScanLands(params postalcode.SearchParamsLand, batchSize int, fn func([]postalcode.PostalCodeLand) error) error {
+	return c.repo.ScanLands(params, batchSize, fn)
+}
+
+func (c *cachedRepository) TruncateLand() error {
+	defer c.Purge()
+	return c.repo.TruncateLand()
+}
+
+// ============================================================
+// 건물 / 사서함 / 다량배달처
+// ============================================================
+
+func (c *cachedRepository) SearchBuildings(params postalcode.SearchParamsBuilding) ([]postalcode.PostalCodeBuilding, int64, error) {
+	value, err := c.load(paramsKey("building:search", params), buildingPage{}, func() (interface{}, error) {
+		rows, total, err := c.repo.SearchBuildings(params)
+		return buildingPage{Rows: rows, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, total := value.(buildingPage).copy()
+	return rows, total, nil
+}
+
+func (c *cachedRepository) BatchCreateBuilding(buildings []postalcode.PostalCodeBuilding) error {
+	defer c.Purge()
+	return c.repo.BatchCreateBuilding(buildings)
+}
+
+func (c *cachedRepository) TruncateBuilding() error {
+	defer c.Purge()
+	return c.repo.TruncateBuilding()
+}
+
+func (c *cachedRepository) FindPOBoxByZipCode(zipCode string) ([]postalcode.PostalCodePOBox, error) {
+	value, err := c.load("pobox:zip:"+zipCode, poBoxPage{}, func() (interface{}, error) {
+		rows, err := c.repo.FindPOBoxByZipCode(zipCode)
+		return poBoxPage{Rows: rows}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, _ := value.(poBoxPage).copy()
+	return rows, nil
+}
+
+func (c *cachedRepository) SearchPOBoxes(params postalcode.SearchParamsPOBox) ([]postalcode.PostalCodePOBox, int64, error) {
+	value, err := c.load(paramsKey("pobox:search", params), poBoxPage{}, func() (interface{}, error) {
+		rows, total, err := c.repo.SearchPOBoxes(params)
+		return poBoxPage{Rows: rows, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, total := value.(poBoxPage).copy()
+	return rows, total, nil
+}
+
+func (c *cachedRepository) BatchCreatePOBox(poBoxes []postalcode.PostalCodePOBox) error {
+	defer c.Purge()
+	return c.repo.BatchCreatePOBox(poBoxes)
+}
+
+func (c *cachedRepository) TruncatePOBox() error {
+	defer c.Purge()
+	return c.repo.TruncatePOBox()
+}
+
+func (c *cachedRepository) FindBulkDeliveryByZipCode(zipCode string) ([]postalcode.PostalCodeBulkDelivery, error) {
+	value, err := c.load("bulk:zip:"+zipCode, bulkDeliveryPage{}, func() (interface{}, error) {
+		rows, err := c.repo.FindBulkDeliveryByZipCode(zipCode)
+		return bulkDeliveryPage{Rows: rows}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, _ := value.(bulkDeliveryPage).copy()
+	return rows, nil
+}
+
+func (c *cachedRepository) SearchBulkDeliveries(params postalcode.SearchParamsBulkDelivery) ([]postalcode.PostalCodeBulkDelivery, int64, error) {
+	value, err := c.load(paramsKey("bulk:search", params), bulkDeliveryPage{}, func() (interface{}, error) {
+		rows, total, err := c.repo.SearchBulkDeliveries(params)
+		return bulkDeliveryPage{Rows: rows, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, total := value.(bulkDeliveryPage).copy()
+	return rows, total, nil
+}
+
+func (c *cachedRepository) BatchCreateBulkDelivery(deliveries []postalcode.PostalCodeBulkDelivery) error {
+	defer c.Purge()
+	return c.repo.BatchCreateBulkDelivery(deliveries)
+}
+
+func (c *cachedRepository) TruncateBulkDelivery() error {
+	defer c.Purge()
+	return c.repo.TruncateBulkDelivery()
+}
+
+func (c *cachedRepository) ExistsAddressZipCode(zipCode string) (bool, error) {
+	value, err := c.load("address:exists:"+zipCode, false, func() (interface{}, error) {
+		return c.repo.ExistsAddressZipCode(zipCode)
+	})
+	if err != nil {
+		return false, err
+	}
+	return value.(bool), nil
+}
+
+// ============================================================
+// Import 이력 (캐시하지 않음)
+// ============================================================
+
+func (c *cachedRepository) CreateImportRun(run *postalcode.ImportRun) error {
+	return c.repo.CreateImportRun(run)
+}
+
+// UpdateImportRun은 import 실행이 끝났으면(성공/실패) 캐시를 비웁니다.
+func (c *cachedRepository) UpdateImportRun(run *postalcode.ImportRun) error {
+	if run.Outcome != postalcode.ImportOutcomeRunning {
+		defer c.importFinished()
+	}
+	return c.repo.UpdateImportRun(run)
+}
+
+func (c *cachedRepository) ListImportRuns(limit int) ([]postalcode.ImportRun, error) {
+	return c.repo.ListImportRuns(limit)
+}
+
+func (c *cachedRepository) FindLatestImportRun() (*postalcode.ImportRun, error) {
+	return c.repo.FindLatestImportRun()
+}
+
+func (c *cachedRepository) FindLastAppliedImportRun(dataTypes ...string) (*postalcode.ImportRun, error) {
+	return c.repo.FindLastAppliedImportRun(dataTypes...)
+}
+
+func (c *cachedRepository) BatchCreateImportRejects(rejects []postalcode.ImportReject) error {
+	return c.repo.BatchCreateImportRejects(rejects)
+}
+
+func (c *cachedRepository) ListImportRejects(runID uint) ([]postalcode.ImportReject, error) {
+	return c.repo.ListImportRejects(runID)
+}
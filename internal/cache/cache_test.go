@@ -0,0 +1,866 @@
+package cache
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/importer"
+	"github.com/oursportsnation/korean-postalcode/internal/repository"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRepository는 조회 횟수를 세는 Repository입니다.
+// gate가 있으면 FindByZipCode가 gate가 닫힐 때까지 기다리고, err가 있으면 FindByZipCode가 실패합니다.
+type countingRepository struct {
+	repository.Repository
+
+	finds   int32
+	entered chan struct{}
+	gate    chan struct{}
+	err     error
+
+	latestRuns int32 // FindLatestImportRun 호출 수
+	runErr     error // 설정되어 있으면 FindLatestImportRun이 반환할 오류
+}
+
+func (r *countingRepository) FindLatestImportRun() (*postalcode.ImportRun, error) {
+	atomic.AddInt32(&r.latestRuns, 1)
+	if r.runErr != nil {
+		return nil, r.runErr
+	}
+	return r.Repository.FindLatestImportRun()
+}
+
+func (r *countingRepository) latestRunCount() int {
+	return int(atomic.LoadInt32(&r.latestRuns))
+}
+
+func (r *countingRepository) FindByZipCode(zipCode string) ([]postalcode.PostalCodeRoad, error) {
+	atomic.AddInt32(&r.finds, 1)
+	if r.entered != nil {
+		r.entered <- struct{}{}
+	}
+	if r.gate != nil {
+		<-r.gate
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.Repository.FindByZipCode(zipCode)
+}
+
+func (r *countingRepository) findCount() int {
+	return int(atomic.LoadInt32(&r.finds))
+}
+
+// fakeClock은 테스트에서 시간을 직접 진행시키는 시계입니다.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func withClock(clock *fakeClock) Option {
+	return func(o *options) {
+		o.now = clock.Now
+	}
+}
+
+func testRoad(zipCode, roadName string) postalcode.PostalCodeRoad {
+	return postalcode.PostalCodeRoad{
+		ZipCode:           zipCode,
+		ZipPrefix:         zipCode[:3],
+		SidoName:          "서울특별시",
+		SigunguName:       "강북구",
+		RoadName:          roadName,
+		StartBuildingMain: 1,
+	}
+}
+
+func setupTestRepository(t *testing.T) *countingRepository {
+	inner := repository.NewMemory()
+	require.NoError(t, inner.BatchCreate([]postalcode.PostalCodeRoad{
+		testRoad("01000", "삼양로177길"),
+		testRoad("01001", "삼양로179길"),
+		testRoad("01002", "삼양로181길"),
+	}))
+	return &countingRepository{Repository: inner}
+}
+
+func TestCachedRepository_HitAndMiss(t *testing.T) {
+	inner := setupTestRepository(t)
+	repo := New(inner)
+
+	for i := 0; i < 3; i++ {
+		roads, err := repo.FindByZipCode("01000")
+		require.NoError(t, err)
+		require.Len(t, roads, 1)
+		assert.Equal(t, "삼양로177길", roads[0].RoadName)
+	}
+	assert.Equal(t, 1, inner.findCount())
+
+	stats := repo.Stats()
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, 1, stats.Entries)
+	assert.Equal(t, DefaultSize, stats.Capacity)
+	assert.InDelta(t, 2.0/3.0, stats.HitRatio(), 0.001)
+
+	// 조회 조건이 다르면 다른 항목
+	roads, err := repo.FindByZipCode("01001")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+	assert.Equal(t, 2, inner.findCount())
+}
+
+func TestCachedRepository_SearchKeys(t *testing.T) {
+	repo := New(setupTestRepository(t))
+
+	roads, total, err := repo.Search(postalcode.SearchParams{RoadName: "삼양로", Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, roads, 2)
+	assert.Equal(t, int64(3), total)
+
+	roads, total, err = repo.Search(postalcode.SearchParams{RoadName: "삼양로", Limit: 2, Page: 2})
+	require.NoError(t, err)
+	assert.Len(t, roads, 1)
+	assert.Equal(t, int64(3), total)
+
+	roads, total, err = repo.FindByZipPrefix("010", 1, 0)
+	require.NoError(t, err)
+	assert.Len(t, roads, 1)
+	assert.Equal(t, int64(3), total)
+
+	_, _, err = repo.Search(postalcode.SearchParams{RoadName: "삼양로", Limit: 2})
+	require.NoError(t, err)
+
+	stats := repo.Stats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(3), stats.Misses)
+}
+
+func TestCachedRepository_ReturnsCopies(t *testing.T) {
+	repo := New(setupTestRepository(t))
+
+	roads, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	roads[0].RoadName = "변경됨"
+
+	roads, err = repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Equal(t, "삼양로177길", roads[0].RoadName)
+}
+
+func TestCachedRepository_WritesPurge(t *testing.T) {
+	inner := setupTestRepository(t)
+	repo := New(inner)
+
+	roads, err := repo.FindByZipCode("01003")
+	require.NoError(t, err)
+	assert.Empty(t, roads)
+
+	require.NoError(t, repo.BatchCreate([]postalcode.PostalCodeRoad{testRoad("01003", "삼양로183길")}))
+	roads, err = repo.FindByZipCode("01003")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+
+	require.NoError(t, repo.TruncateRoad())
+	roads, err = repo.FindByZipCode("01003")
+	require.NoError(t, err)
+	assert.Empty(t, roads)
+
+	assert.Equal(t, uint64(2), repo.Stats().Invalidations)
+	assert.Equal(t, 3, inner.findCount())
+}
+
+func TestCachedRepository_ErrorsNotCached(t *testing.T) {
+	inner := setupTestRepository(t)
+	inner.err = errors.New("connection refused")
+	repo := New(inner)
+
+	_, err := repo.FindByZipCode("01000")
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 0, repo.Stats().Entries)
+
+	inner.err = nil
+	roads, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 1)
+	assert.Equal(t, 2, inner.findCount())
+}
+
+func TestCachedRepository_Eviction(t *testing.T) {
+	inner := setupTestRepository(t)
+	repo := New(inner, WithSize(2))
+
+	for _, zipCode := range []string{"01000", "01001", "01000", "01002"} {
+		_, err := repo.FindByZipCode(zipCode)
+		require.NoError(t, err)
+	}
+	// 01001이 가장 오래 사용하지 않은 항목이므로 밀려남
+	_, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	_, err = repo.FindByZipCode("01001")
+	require.NoError(t, err)
+
+	stats := repo.Stats()
+	assert.Equal(t, 2, stats.Entries)
+	assert.Equal(t, 2, stats.Capacity)
+	assert.Equal(t, uint64(2), stats.Evictions)
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, 4, inner.findCount())
+}
+
+func TestCachedRepository_TTL(t *testing.T) {
+	inner := setupTestRepository(t)
+	clock := &fakeClock{now: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)}
+	repo := New(inner, WithTTL(time.Minute), WithVersionCheckInterval(0), withClock(clock))
+
+	_, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	clock.Advance(59 * time.Second)
+	_, err = repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Equal(t, 1, inner.findCount())
+
+	clock.Advance(time.Minute)
+	_, err = repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.findCount())
+	assert.Equal(t, uint64(1), repo.Stats().Expired)
+}
+
+func TestCachedRepository_CoalescesConcurrentMisses(t *testing.T) {
+	inner := setupTestRepository(t)
+	inner.entered = make(chan struct{}, 10)
+	inner.gate = make(chan struct{})
+	repo := New(inner)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([][]postalcode.PostalCodeRoad, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = repo.FindByZipCode("01000")
+		}(i)
+	}
+
+	// 첫 조회가 원본 Repository에 들어간 뒤 나머지가 모두 기다릴 때까지 대기
+	<-inner.entered
+	require.Eventually(t, func() bool {
+		return repo.Stats().Coalesced == callers-1
+	}, 5*time.Second, time.Millisecond)
+	close(inner.gate)
+	wg.Wait()
+
+	assert.Equal(t, 1, inner.findCount())
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		require.Len(t, results[i], 1)
+	}
+	// 기다린 요청도 서로 다른 복사본을 받음
+	results[0][0].RoadName = "변경됨"
+	assert.Equal(t, "삼양로177길", results[1][0].RoadName)
+
+	stats := repo.Stats()
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, uint64(callers-1), stats.Coalesced)
+	assert.Equal(t, 1.0-1.0/callers, stats.HitRatio())
+}
+
+func TestCachedRepository_PurgeDuringLoad(t *testing.T) {
+	inner := setupTestRepository(t)
+	inner.entered = make(chan struct{}, 1)
+	inner.gate = make(chan struct{})
+	repo := New(inner)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = repo.FindByZipCode("01000")
+	}()
+	<-inner.entered
+	repo.Purge()
+	close(inner.gate)
+	<-done
+
+	// 비우기 전에 시작한 조회 결과는 캐시하지 않음
+	assert.Equal(t, 0, repo.Stats().Entries)
+}
+
+func TestCachedRepository_DatasetVersionChange(t *testing.T) {
+	inner := setupTestRepository(t)
+	clock := &fakeClock{now: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)}
+	repo := New(inner, WithVersionCheckInterval(time.Minute), withClock(clock))
+
+	finished := clock.Now()
+	run := &postalcode.ImportRun{DataType: "road", Mode: "replace", DatasetDate: "2024-04-01", StartedAt: finished, FinishedAt: &finished, Outcome: postalcode.ImportOutcomeSuccess}
+	require.NoError(t, inner.CreateImportRun(run))
+
+	_, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-04-01", repo.Stats().DatasetVersion)
+
+	// 다른 프로세스가 원본 DB에 새 데이터셋을 import
+	require.NoError(t, inner.Repository.BatchCreate([]postalcode.PostalCodeRoad{testRoad("01000", "삼양로185길")}))
+	next := &postalcode.ImportRun{DataType: "road", Mode: "replace", DatasetDate: "2024-05-01", StartedAt: finished, FinishedAt: &finished, Outcome: postalcode.ImportOutcomeSuccess}
+	require.NoError(t, inner.CreateImportRun(next))
+
+	// 확인 주기 전에는 캐시된 결과
+	roads, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 1)
+	assert.Equal(t, 1, inner.findCount())
+
+	clock.Advance(time.Minute)
+	roads, err = repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 2)
+	assert.Equal(t, 2, inner.findCount())
+
+	stats := repo.Stats()
+	assert.Equal(t, "2024-05-01", stats.DatasetVersion)
+	assert.Equal(t, uint64(1), stats.Invalidations)
+}
+
+func TestCachedRepository_ImportFinished(t *testing.T) {
+	inner := setupTestRepository(t)
+	repo := New(inner, WithVersionCheckInterval(0))
+
+	_, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+
+	// 캐시를 거치지 않는 Service로 import하고 종료 이벤트만 전달
+	imp := importer.New(service.New(inner.Repository), importer.WithEventHandler(repo))
+	path := filepath.Join(t.TempDir(), "road.txt")
+	content := "우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류\n" +
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로187길|Samyang-ro 187-gil|0|1|0|9|0|3\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	_, err = imp.AppendFromFile(path, 100, nil)
+	require.NoError(t, err)
+
+	roads, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 2)
+	assert.Equal(t, uint64(1), repo.Stats().Invalidations)
+}
+
+// ============================================================
+// Redis 저장소
+// ============================================================
+
+// fakeRedis는 테스트용 Redis 프로토콜 서버입니다. (AUTH, SELECT, PING, GET, SET [PX], FLUSHALL)
+type fakeRedis struct {
+	listener net.Listener
+	password string
+
+	mu       sync.Mutex
+	data     map[string][]byte
+	expires  map[string]time.Time
+	commands []string
+	conns    int
+	badReply string // 설정되어 있으면 다음 GET에 이 원본 응답을 보냄 (잘못된 응답 테스트)
+}
+
+func newFakeRedis(t *testing.T, password string) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &fakeRedis{listener: listener, password: password, data: make(map[string][]byte), expires: make(map[string]time.Time)}
+	t.Cleanup(func() { listener.Close() })
+	go srv.serve()
+	return srv
+}
+
+func (s *fakeRedis) addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeRedis) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns++
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeRedis) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	authed := s.password == ""
+	for {
+		request, err := readReply(r)
+		if err != nil {
+			return
+		}
+		items := request.([]interface{})
+		args := make([]string, len(items))
+		for i, item := range items {
+			args[i] = string(item.([]byte))
+		}
+		name := strings.ToUpper(args[0])
+
+		s.mu.Lock()
+		s.commands = append(s.commands, strings.Join(args, " "))
+		var reply string
+		switch {
+		case name == "AUTH":
+			if args[len(args)-1] == s.password {
+				authed = true
+				reply = "+OK\r\n"
+			} else {
+				reply = "-WRONGPASS invalid username-password pair\r\n"
+			}
+		case !authed:
+			reply = "-NOAUTH Authentication required.\r\n"
+		case name == "PING":
+			reply = "+PONG\r\n"
+		case name == "SELECT", name == "FLUSHALL":
+			if name == "FLUSHALL" {
+				s.data = make(map[string][]byte)
+			}
+			reply = "+OK\r\n"
+		case name == "GET" && s.badReply != "":
+			reply, s.badReply = s.badReply, ""
+		case name == "GET":
+			value, ok := s.data[args[1]]
+			if expires, has := s.expires[args[1]]; has && !time.Now().Before(expires) {
+				ok = false
+			}
+			if ok {
+				reply = fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+			} else {
+				reply = "$-1\r\n"
+			}
+		case name == "SET":
+			s.data[args[1]] = []byte(args[2])
+			delete(s.expires, args[1])
+			if len(args) == 5 && strings.ToUpper(args[3]) == "PX" {
+				ms, _ := strconv.Atoi(args[4])
+				s.expires[args[1]] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+			}
+			reply = "+OK\r\n"
+		default:
+			reply = "-ERR unknown command '" + args[0] + "'\r\n"
+		}
+		s.mu.Unlock()
+
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeRedis) keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys []string
+	for key := range s.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *fakeRedis) lastCommand(prefix string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.commands) - 1; i >= 0; i-- {
+		if strings.HasPrefix(s.commands[i], prefix) {
+			return s.commands[i]
+		}
+	}
+	return ""
+}
+
+func TestRedisStore_GetSet(t *testing.T) {
+	srv := newFakeRedis(t, "")
+	store, err := NewRedisStore(srv.addr())
+	require.NoError(t, err)
+	defer store.Close()
+
+	require.NoError(t, store.Ping())
+
+	_, ok, err := store.Get("missing")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	value := []byte("{\"rows\":[],\"total\":0}\r\n$-1\r\n") // 값 안의 CRLF도 그대로 저장
+	require.NoError(t, store.Set("road:zip:01000", value, 90*time.Second))
+	got, ok, err := store.Get("road:zip:01000")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, value, got)
+
+	assert.Equal(t, []string{"postalcode:road:zip:01000"}, srv.keys())
+	assert.Equal(t, "SET postalcode:road:zip:01000 "+string(value)+" PX 90000", srv.lastCommand("SET"))
+
+	require.NoError(t, store.Set("forever", []byte("1"), 0))
+	assert.Equal(t, "SET postalcode:forever 1", srv.lastCommand("SET"))
+
+	// 연결은 재사용
+	srv.mu.Lock()
+	assert.Equal(t, 1, srv.conns)
+	srv.mu.Unlock()
+}
+
+func TestRedisStore_URL(t *testing.T) {
+	srv := newFakeRedis(t, "secret")
+
+	store, err := NewRedisStore("redis://:secret@"+srv.addr()+"/2", WithRedisKeyPrefix("pc:"))
+	require.NoError(t, err)
+	defer store.Close()
+	require.NoError(t, store.Set("k", []byte("v"), time.Minute))
+	assert.Equal(t, []string{"pc:k"}, srv.keys())
+	assert.Equal(t, "AUTH secret", srv.lastCommand("AUTH"))
+	assert.Equal(t, "SELECT 2", srv.lastCommand("SELECT"))
+
+	wrong, err := NewRedisStore("redis://:wrong@" + srv.addr())
+	require.NoError(t, err)
+	defer wrong.Close()
+	err = wrong.Ping()
+	var rerr RedisError
+	require.ErrorAs(t, err, &rerr)
+	assert.Contains(t, err.Error(), "WRONGPASS")
+
+	for _, rawURL := range []string{"rediss://localhost", "redis://localhost/abc", "redis:///0"} {
+		_, err := NewRedisStore(rawURL)
+		assert.Error(t, err, rawURL)
+	}
+
+	store, err = NewRedisStore("localhost")
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:6379", store.addr)
+}
+
+func TestRedisStore_ServerError(t *testing.T) {
+	srv := newFakeRedis(t, "")
+	store, err := NewRedisStore(srv.addr())
+	require.NoError(t, err)
+	defer store.Close()
+
+	_, err = store.do("EVAL", "return 1", "0")
+	assert.EqualError(t, err, "redis: ERR unknown command 'EVAL'")
+
+	// 오류 응답 후에도 연결은 계속 사용
+	require.NoError(t, store.Ping())
+	srv.mu.Lock()
+	assert.Equal(t, 1, srv.conns)
+	srv.mu.Unlock()
+
+	require.NoError(t, store.Close())
+	assert.Error(t, store.Ping())
+}
+
+func TestRedisStore_MalformedReplyDiscardsConn(t *testing.T) {
+	srv := newFakeRedis(t, "")
+	store, err := NewRedisStore(srv.addr())
+	require.NoError(t, err)
+	defer store.Close()
+
+	require.NoError(t, store.Ping())
+
+	// 길이가 잘못된 응답은 오류이며, 응답 위치를 알 수 없는 연결은 버림
+	srv.mu.Lock()
+	srv.badReply = "$-2\r\n"
+	srv.mu.Unlock()
+	_, ok, err := store.Get("key")
+	assert.EqualError(t, err, `redis: invalid length "$-2"`)
+	assert.False(t, ok)
+
+	require.NoError(t, store.Set("key", []byte("value"), 0))
+	value, ok, err := store.Get("key")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+	srv.mu.Lock()
+	assert.Equal(t, 2, srv.conns)
+	srv.mu.Unlock()
+}
+
+func TestReadReply(t *testing.T) {
+	valid := []struct {
+		raw  string
+		want interface{}
+	}{
+		{"+OK\r\n", "OK"},
+		{"-ERR bad\r\n", RedisError("ERR bad")},
+		{":-12\r\n", int64(-12)},
+		{"$5\r\nhello\r\n", []byte("hello")},
+		{"$0\r\n\r\n", []byte{}},
+		{"$-1\r\n", nil},
+		{"*-1\r\n", nil},
+		{"*2\r\n$1\r\na\r\n:5\r\n", []interface{}{[]byte("a"), int64(5)}},
+	}
+	for _, tc := range valid {
+		reply, err := readReply(bufio.NewReader(strings.NewReader(tc.raw)))
+		require.NoError(t, err, tc.raw)
+		assert.Equal(t, tc.want, reply, tc.raw)
+	}
+
+	malformed := []string{
+		"",                  // 응답 없음
+		"\r\n",              // 빈 줄
+		"+OK\n",             // CR 없음
+		"?what\r\n",         // 알 수 없는 타입
+		":12a\r\n",          // 정수가 아님
+		"$-2\r\n",           // -1보다 작은 길이
+		"$abc\r\n",          // 길이가 아님
+		"$\r\n",             // 길이 없음
+		"$3\r\nab\r\n",      // 본문이 짧음
+		"$3\r\nabcXY",       // CRLF로 끝나지 않음
+		"$999999999999\r\n", // 최대 길이 초과
+		"*-5\r\n",           // -1보다 작은 배열 길이
+		"*x\r\n",            // 배열 길이가 아님
+		"*2147483647\r\n",   // 최대 항목 수 초과 (항목을 읽기 전에 할당하지 않음)
+		"*2\r\n$1\r\na\r\n", // 배열 항목이 모자람
+		"*1\r\n$-3\r\n",     // 잘못된 배열 항목
+	}
+	for _, raw := range malformed {
+		reply, err := readReply(bufio.NewReader(strings.NewReader(raw)))
+		assert.Error(t, err, "%q", raw)
+		assert.Nil(t, reply, "%q", raw)
+	}
+
+	_, err := readReply(bufio.NewReader(strings.NewReader("*2147483647\r\n")))
+	assert.EqualError(t, err, "redis: array length 2147483647 exceeds limit")
+}
+
+// failingWriter는 항상 쓰기에 실패하는 io.Writer입니다.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+func TestWriteCommand(t *testing.T) {
+	var buf strings.Builder
+	w := bufio.NewWriter(&buf)
+	require.NoError(t, writeCommand(w, "SET", "key", []byte("value")))
+	require.NoError(t, w.Flush())
+	assert.Equal(t, "*3\r\n$3\r\nSET\r\n$3\r\nkey\r\n$5\r\nvalue\r\n", buf.String())
+
+	// 지원하지 않는 인자는 아무것도 쓰지 않음
+	buf.Reset()
+	assert.Error(t, writeCommand(w, "SET", "key", 1))
+	assert.Equal(t, 0, w.Buffered())
+
+	// 버퍼를 넘는 쓰기 오류는 반환
+	w = bufio.NewWriterSize(failingWriter{}, 16)
+	assert.EqualError(t, writeCommand(w, "SET", "key", strings.Repeat("x", 64)), "broken pipe")
+}
+
+func TestCachedRepository_SharedStore(t *testing.T) {
+	srv := newFakeRedis(t, "")
+	store, err := NewRedisStore(srv.addr())
+	require.NoError(t, err)
+	defer store.Close()
+
+	// 같은 DB를 조회하는 두 replica
+	shared := repository.NewMemory()
+	require.NoError(t, shared.BatchCreate([]postalcode.PostalCodeRoad{testRoad("01000", "삼양로177길")}))
+	finished := time.Date(2024, 4, 2, 3, 0, 0, 0, time.UTC)
+	require.NoError(t, shared.CreateImportRun(&postalcode.ImportRun{DataType: "road", Mode: "replace", DatasetDate: "2024-04-01", StartedAt: finished, FinishedAt: &finished, Outcome: postalcode.ImportOutcomeSuccess}))
+
+	innerA := &countingRepository{Repository: shared}
+	innerB := &countingRepository{Repository: shared}
+	clock := &fakeClock{now: finished}
+	replicaA := New(innerA, WithStore(store), withClock(clock))
+	replicaB := New(innerB, WithStore(store), withClock(clock))
+
+	roads, err := replicaA.FindByZipCode("01000")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+	assert.Equal(t, []string{"postalcode:2024-04-01/road/1:road:zip:01000"}, srv.keys())
+
+	// B는 원본을 조회하지 않고 공유 저장소에서 읽음
+	roads, err = replicaB.FindByZipCode("01000")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+	assert.Equal(t, "삼양로177길", roads[0].RoadName)
+	assert.Equal(t, 1, innerA.findCount())
+	assert.Equal(t, 0, innerB.findCount())
+
+	stats := replicaB.Stats()
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, uint64(1), stats.StoreHits)
+	assert.Equal(t, 1.0, stats.HitRatio())
+
+	// 새 데이터셋 import 후에는 모든 replica가 새 버전 키를 사용
+	require.NoError(t, shared.BatchCreate([]postalcode.PostalCodeRoad{testRoad("01000", "삼양로185길")}))
+	require.NoError(t, shared.CreateImportRun(&postalcode.ImportRun{DataType: "road", Mode: "replace", DatasetDate: "2024-05-01", StartedAt: finished, FinishedAt: &finished, Outcome: postalcode.ImportOutcomeSuccess}))
+	clock.Advance(DefaultVersionCheckInterval)
+
+	roads, err = replicaB.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 2)
+	assert.Equal(t, 1, innerB.findCount())
+
+	roads, err = replicaA.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 2)
+	assert.Equal(t, 1, innerA.findCount())
+
+	assert.Equal(t, []string{
+		"postalcode:2024-04-01/road/1:road:zip:01000",
+		"postalcode:2024-05-01/road/2:road:zip:01000",
+	}, srv.keys())
+	assert.Equal(t, "2024-05-01", replicaA.Stats().DatasetVersion)
+}
+
+func TestCachedRepository_SharedStore_SameFileReimport(t *testing.T) {
+	srv := newFakeRedis(t, "")
+	store, err := NewRedisStore(srv.addr())
+	require.NoError(t, err)
+	defer store.Close()
+
+	shared := repository.NewMemory()
+	require.NoError(t, shared.BatchCreate([]postalcode.PostalCodeRoad{testRoad("01000", "삼양로177길")}))
+	finished := time.Date(2024, 4, 2, 3, 0, 0, 0, time.UTC)
+	fileRun := func() *postalcode.ImportRun {
+		return &postalcode.ImportRun{DataType: "road", Mode: "replace", DatasetDate: "2024-04-01", FileSHA256: "9f86d081884c7d65",
+			StartedAt: finished, FinishedAt: &finished, Outcome: postalcode.ImportOutcomeSuccess}
+	}
+	require.NoError(t, shared.CreateImportRun(fileRun()))
+
+	inner := &countingRepository{Repository: shared}
+	clock := &fakeClock{now: finished}
+	repo := New(inner, WithStore(store), withClock(clock))
+
+	roads, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+
+	// 같은 파일(같은 데이터셋 버전)을 다시 import하는 사이에 테이블이 바뀜
+	require.NoError(t, shared.BatchCreate([]postalcode.PostalCodeRoad{testRoad("01000", "삼양로185길")}))
+	require.NoError(t, shared.CreateImportRun(fileRun()))
+	clock.Advance(DefaultVersionCheckInterval)
+
+	// 데이터셋 버전은 같지만 이전 실행의 Redis 키를 읽지 않고 원본을 다시 조회
+	roads, err = repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 2)
+	assert.Equal(t, 2, inner.findCount())
+	assert.Equal(t, uint64(0), repo.Stats().StoreHits)
+	assert.Equal(t, "2024-04-01+9f86d081", repo.Stats().DatasetVersion)
+	assert.Equal(t, []string{
+		"postalcode:2024-04-01+9f86d081/road/1:road:zip:01000",
+		"postalcode:2024-04-01+9f86d081/road/2:road:zip:01000",
+	}, srv.keys())
+}
+
+func TestCachedRepository_VersionCheckFailure(t *testing.T) {
+	for name, runErr := range map[string]error{
+		"history unavailable": postalcode.ErrImportHistoryUnavailable,
+		"query error":         errors.New("db down"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			inner := setupTestRepository(t)
+			inner.runErr = runErr
+			clock := &fakeClock{now: time.Date(2024, 4, 2, 3, 0, 0, 0, time.UTC)}
+			repo := New(inner, withClock(clock))
+
+			// 확인에 실패해도 확인 주기 안에서는 다시 조회하지 않음
+			for i := 0; i < 10; i++ {
+				_, err := repo.FindByZipCode("01000")
+				require.NoError(t, err)
+			}
+			assert.Equal(t, 1, inner.latestRunCount())
+			assert.Equal(t, 1, inner.findCount())
+
+			clock.Advance(DefaultVersionCheckInterval)
+			_, err := repo.FindByZipCode("01000")
+			require.NoError(t, err)
+			assert.Equal(t, 2, inner.latestRunCount())
+			assert.Equal(t, 1, inner.findCount())
+		})
+	}
+}
+
+func TestCachedRepository_SharedStore_WithoutHistory(t *testing.T) {
+	srv := newFakeRedis(t, "")
+	store, err := NewRedisStore(srv.addr())
+	require.NoError(t, err)
+	defer store.Close()
+
+	// import 이력이 없으면 import해도 저장소 키가 바뀌지 않으므로 저장소를 사용하지 않음
+	shared := repository.NewMemory()
+	require.NoError(t, shared.BatchCreate([]postalcode.PostalCodeRoad{testRoad("01000", "삼양로177길")}))
+	replicaA := New(&countingRepository{Repository: shared}, WithStore(store))
+
+	roads, err := replicaA.FindByZipCode("01000")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+
+	// 이력 없이 import (다른 프로세스)
+	require.NoError(t, shared.TruncateRoad())
+	require.NoError(t, shared.BatchCreate([]postalcode.PostalCodeRoad{testRoad("01000", "삼양로185길")}))
+
+	// 새로 시작한 replica는 저장소의 이전 항목이 아니라 새 데이터를 읽음
+	innerB := &countingRepository{Repository: shared}
+	replicaB := New(innerB, WithStore(store))
+	roads, err = replicaB.FindByZipCode("01000")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+	assert.Equal(t, "삼양로185길", roads[0].RoadName)
+	assert.Equal(t, 1, innerB.findCount())
+
+	assert.Empty(t, srv.keys())
+	assert.Empty(t, srv.lastCommand("GET"))
+	assert.Empty(t, srv.lastCommand("SET"))
+	assert.Equal(t, uint64(0), replicaB.Stats().StoreHits)
+}
+
+func TestCachedRepository_StoreUnavailable(t *testing.T) {
+	srv := newFakeRedis(t, "")
+	store, err := NewRedisStore(srv.addr(), WithRedisTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	srv.listener.Close()
+
+	inner := setupTestRepository(t)
+	finished := time.Date(2024, 4, 2, 3, 0, 0, 0, time.UTC)
+	require.NoError(t, inner.CreateImportRun(&postalcode.ImportRun{DataType: "road", Mode: "replace", DatasetDate: "2024-04-01", StartedAt: finished, FinishedAt: &finished, Outcome: postalcode.ImportOutcomeSuccess}))
+	repo := New(inner, WithStore(store))
+
+	// 저장소 오류는 원본 조회로 대체
+	roads, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 1)
+
+	stats := repo.Stats()
+	assert.Equal(t, uint64(2), stats.StoreErrors) // GET, SET
+	assert.Equal(t, uint64(0), stats.StoreHits)
+	assert.Equal(t, 1, stats.Entries)
+}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+)
+
+// lruEntry는 LRU 캐시 항목입니다.
+type lruEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// lru는 항목 수가 제한되고 항목마다 만료 시각이 있는 LRU 캐시입니다.
+// 동시성 보호는 호출하는 쪽(cachedRepository)이 담당합니다.
+type lru struct {
+	capacity int
+	ttl      time.Duration
+	order    *list.List // 앞쪽이 최근에 사용한 항목
+	items    map[string]*list.Element
+
+	evictions uint64 // 용량 초과로 밀려난 항목 수
+	expired   uint64 // TTL이 지나 버린 항목 수
+}
+
+// newLRU는 최대 capacity개 항목을 ttl 동안 보관하는 LRU 캐시를 생성합니다. ttl이 0 이하이면 만료되지 않습니다.
+func newLRU(capacity int, ttl time.Duration) *lru {
+	return &lru{
+		capacity: capacity,
+		ttl:      ttl,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// get은 key의 값을 반환하고 최근 사용 항목으로 옮깁니다. 만료된 항목은 버리고 없는 것으로 처리합니다.
+func (c *lru) get(key string, now time.Time) (interface{}, bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt) {
+		c.removeElement(elem)
+		c.expired++
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// add는 key의 값을 저장합니다. 용량을 넘으면 가장 오래 사용하지 않은 항목을 버립니다.
+func (c *lru) add(key string, value interface{}, now time.Time) {
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = now.Add(c.ttl)
+	}
+
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.capacity {
+		c.removeElement(c.order.Back())
+		c.evictions++
+	}
+}
+
+// purge는 모든 항목을 버립니다. 통계는 유지합니다.
+func (c *lru) purge() {
+	c.order.Init()
+	c.items = make(map[string]*list.Element)
+}
+
+// len은 보관 중인 항목 수입니다. (만료됐지만 아직 버리지 않은 항목 포함)
+func (c *lru) len() int {
+	return c.order.Len()
+}
+
+func (c *lru) removeElement(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.items, elem.Value.(*lruEntry).key)
+}
@@ -0,0 +1,409 @@
+package cache
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Store는 여러 프로세스가 공유하는 캐시 저장소입니다. (WithStore)
+// 구현은 여러 goroutine에서 동시에 호출해도 안전해야 합니다.
+type Store interface {
+	// Get은 key의 값을 반환합니다. 없으면 ok가 false입니다.
+	Get(key string) (value []byte, ok bool, err error)
+
+	// Set은 key에 value를 저장합니다. ttl이 0 이하이면 만료되지 않습니다.
+	Set(key string, value []byte, ttl time.Duration) error
+}
+
+// 기본 Redis 저장소 설정
+const (
+	DefaultRedisKeyPrefix = "postalcode:" // 모든 키 앞에 붙는 접두어
+	DefaultRedisTimeout   = time.Second   // 연결/명령 제한 시간
+	DefaultRedisPoolSize  = 10            // 재사용할 유휴 연결 최대 개수
+)
+
+// errRedisClosed는 Close 이후 명령을 실행하면 반환됩니다.
+var errRedisClosed = errors.New("redis: store closed")
+
+// 읽을 수 있는 응답 크기 한도. 길이만 보고 메모리를 할당하므로 잘못된 응답이 큰 메모리를 할당하지 않게 합니다.
+const (
+	maxBulkLen  = 512 << 20 // bulk string 최대 길이 (Redis proto-max-bulk-len 기본값)
+	maxArrayLen = 1 << 20   // 배열 최대 항목 수 (GET/SET만 사용하므로 충분)
+)
+
+// RedisError는 Redis 서버가 오류 응답(-ERR ...)을 보낸 경우의 오류입니다.
+type RedisError string
+
+// Error는 오류 메시지를 반환합니다.
+func (e RedisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// RedisStore는 Redis 프로토콜(RESP)로 통신하는 Store 구현입니다.
+// Redis와 프로토콜이 호환되는 서버(Valkey, KeyDB 등)에도 사용할 수 있으며, GET/SET(PX) 명령만 사용합니다.
+type RedisStore struct {
+	addr     string
+	username string
+	password string
+	db       int
+	prefix   string
+	timeout  time.Duration
+	poolSize int
+
+	mu     sync.Mutex
+	idle   []*redisConn
+	closed bool
+}
+
+// RedisOption은 RedisStore 생성 옵션입니다.
+type RedisOption func(*RedisStore)
+
+// WithRedisKeyPrefix는 모든 키 앞에 붙일 접두어를 지정하는 옵션입니다. (기본 DefaultRedisKeyPrefix)
+// 같은 Redis를 여러 서비스가 함께 쓸 때 키가 겹치지 않게 합니다.
+func WithRedisKeyPrefix(prefix string) RedisOption {
+	return func(s *RedisStore) {
+		s.prefix = prefix
+	}
+}
+
+// WithRedisTimeout은 연결과 명령 하나의 제한 시간을 지정하는 옵션입니다. (기본 DefaultRedisTimeout)
+func WithRedisTimeout(timeout time.Duration) RedisOption {
+	return func(s *RedisStore) {
+		if timeout > 0 {
+			s.timeout = timeout
+		}
+	}
+}
+
+// WithRedisPoolSize는 재사용할 유휴 연결 최대 개수를 지정하는 옵션입니다. (기본 DefaultRedisPoolSize)
+func WithRedisPoolSize(size int) RedisOption {
+	return func(s *RedisStore) {
+		if size > 0 {
+			s.poolSize = size
+		}
+	}
+}
+
+// NewRedisStore는 Redis 저장소를 생성합니다. 연결은 첫 명령 때 맺습니다.
+// rawURL은 "redis://[사용자:비밀번호@]호스트[:포트][/DB번호]" 또는 "호스트:포트"입니다. (기본 포트 6379)
+func NewRedisStore(rawURL string, opts ...RedisOption) (*RedisStore, error) {
+	s := &RedisStore{
+		prefix:   DefaultRedisKeyPrefix,
+		timeout:  DefaultRedisTimeout,
+		poolSize: DefaultRedisPoolSize,
+	}
+	if err := s.parseURL(rawURL); err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// parseURL은 rawURL에서 주소, 인증 정보, DB 번호를 읽습니다.
+func (s *RedisStore) parseURL(rawURL string) error {
+	if !strings.Contains(rawURL, "://") {
+		s.addr = rawURL
+		if _, _, err := net.SplitHostPort(s.addr); err != nil {
+			s.addr = net.JoinHostPort(rawURL, "6379")
+		}
+		return nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid redis URL: %w", err)
+	}
+	if u.Scheme != "redis" {
+		return fmt.Errorf("invalid redis URL: unsupported scheme %q", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("invalid redis URL: missing host")
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "6379"
+	}
+	s.addr = net.JoinHostPort(u.Hostname(), port)
+	if u.User != nil {
+		s.username = u.User.Username()
+		s.password, _ = u.User.Password()
+	}
+	if db := strings.Trim(u.Path, "/"); db != "" {
+		if s.db, err = strconv.Atoi(db); err != nil {
+			return fmt.Errorf("invalid redis URL: database %q", db)
+		}
+	}
+	return nil
+}
+
+// Get은 key의 값을 반환합니다.
+func (s *RedisStore) Get(key string) ([]byte, bool, error) {
+	reply, err := s.do("GET", s.prefix+key)
+	if err != nil || reply == nil {
+		return nil, false, err
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected GET reply %T", reply)
+	}
+	return value, true, nil
+}
+
+// Set은 key에 value를 저장합니다. ttl이 0보다 크면 밀리초 단위로 만료 시간을 지정합니다. (SET ... PX)
+func (s *RedisStore) Set(key string, value []byte, ttl time.Duration) error {
+	args := []interface{}{"SET", s.prefix + key, value}
+	if ttl > 0 {
+		ms := ttl.Milliseconds()
+		if ms < 1 {
+			ms = 1
+		}
+		args = append(args, "PX", strconv.FormatInt(ms, 10))
+	}
+	_, err := s.do(args...)
+	return err
+}
+
+// Ping은 서버에 연결할 수 있는지 확인합니다.
+func (s *RedisStore) Ping() error {
+	_, err := s.do("PING")
+	return err
+}
+
+// Close는 유휴 연결을 모두 닫습니다. 이후 명령은 실패합니다.
+func (s *RedisStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for _, conn := range s.idle {
+		conn.Close()
+	}
+	s.idle = nil
+	return nil
+}
+
+// ============================================================
+// 연결 관리
+// ============================================================
+
+// do는 명령 하나를 실행하고 응답을 반환합니다.
+// 쓰기/flush/읽기 중 오류가 난 연결은 응답 위치를 알 수 없으므로 유휴 목록에 돌려놓지 않고 닫으며,
+// 서버의 오류 응답(연결은 정상)은 RedisError로 반환합니다.
+func (s *RedisStore) do(args ...interface{}) (interface{}, error) {
+	conn, err := s.get()
+	if err != nil {
+		return nil, err
+	}
+	reply, err := conn.do(s.timeout, args...)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	s.put(conn)
+	if rerr, ok := reply.(RedisError); ok {
+		return nil, rerr
+	}
+	return reply, nil
+}
+
+// get은 유휴 연결을 꺼내거나 새로 연결합니다.
+func (s *RedisStore) get() (*redisConn, error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, errRedisClosed
+	}
+	if n := len(s.idle); n > 0 {
+		conn := s.idle[n-1]
+		s.idle = s.idle[:n-1]
+		s.mu.Unlock()
+		return conn, nil
+	}
+	s.mu.Unlock()
+	return s.dial()
+}
+
+// put은 연결을 유휴 목록에 돌려놓습니다. 목록이 가득 찼거나 닫혔으면 연결을 닫습니다.
+func (s *RedisStore) put(conn *redisConn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed || len(s.idle) >= s.poolSize {
+		conn.Close()
+		return
+	}
+	s.idle = append(s.idle, conn)
+}
+
+// dial은 새로 연결하고 인증(AUTH)과 DB 선택(SELECT)을 합니다.
+func (s *RedisStore) dial() (*redisConn, error) {
+	nc, err := net.DialTimeout("tcp", s.addr, s.timeout)
+	if err != nil {
+		return nil, err
+	}
+	conn := &redisConn{Conn: nc, r: bufio.NewReader(nc), w: bufio.NewWriter(nc)}
+
+	var setup [][]interface{}
+	if s.password != "" {
+		if s.username != "" {
+			setup = append(setup, []interface{}{"AUTH", s.username, s.password})
+		} else {
+			setup = append(setup, []interface{}{"AUTH", s.password})
+		}
+	}
+	if s.db != 0 {
+		setup = append(setup, []interface{}{"SELECT", strconv.Itoa(s.db)})
+	}
+	for _, args := range setup {
+		reply, err := conn.do(s.timeout, args...)
+		if err == nil {
+			if rerr, ok := reply.(RedisError); ok {
+				err = rerr
+			}
+		}
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("%s: %w", args[0], err)
+		}
+	}
+	return conn, nil
+}
+
+// redisConn은 Redis 서버 연결 하나입니다.
+type redisConn struct {
+	net.Conn
+	r *bufio.Reader
+	w *bufio.Writer
+}
+
+// do는 명령을 보내고 응답 하나를 읽습니다. 오류가 나면 연결을 더 사용할 수 없습니다.
+func (c *redisConn) do(timeout time.Duration, args ...interface{}) (interface{}, error) {
+	if err := c.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	if err := writeCommand(c.w, args...); err != nil {
+		return nil, err
+	}
+	if err := c.w.Flush(); err != nil {
+		return nil, err
+	}
+	return readReply(c.r)
+}
+
+// ============================================================
+// RESP 프로토콜
+// ============================================================
+
+// writeCommand는 명령을 bulk string 배열로 씁니다. 인자는 string 또는 []byte입니다.
+// 인자 타입은 쓰기 전에 모두 확인하므로 지원하지 않는 인자가 있으면 아무것도 쓰지 않으며, 쓰기 오류를 반환합니다.
+func writeCommand(w *bufio.Writer, args ...interface{}) error {
+	data := make([][]byte, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			data[i] = []byte(v)
+		case []byte:
+			data[i] = v
+		default:
+			return fmt.Errorf("redis: unsupported argument type %T", arg)
+		}
+	}
+
+	fmt.Fprintf(w, "*%d\r\n", len(data))
+	for _, arg := range data {
+		fmt.Fprintf(w, "$%d\r\n", len(arg))
+		w.Write(arg)
+		w.WriteString("\r\n")
+	}
+	// bufio.Writer는 첫 쓰기 오류를 기억하고 이후 쓰기를 무시하므로 마지막에 한 번 확인
+	_, err := w.Write(nil)
+	return err
+}
+
+// readReply는 응답 하나를 읽습니다.
+// simple string은 string, 오류는 RedisError, 정수는 int64, bulk string은 []byte, 배열은 []interface{}이며
+// null bulk string/배열(길이 -1)은 nil입니다. 길이나 정수를 읽을 수 없거나 -1보다 작은 길이는 프로토콜 오류입니다.
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, errors.New("redis: empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return string(line[1:]), nil
+	case '-':
+		return RedisError(line[1:]), nil
+	case ':':
+		n, err := strconv.ParseInt(string(line[1:]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid integer reply %q", line[1:])
+		}
+		return n, nil
+	case '$':
+		n, err := readLength(line)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		if n > maxBulkLen {
+			return nil, fmt.Errorf("redis: bulk string length %d exceeds limit", n)
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		if buf[n] != '\r' || buf[n+1] != '\n' {
+			return nil, errors.New("redis: invalid bulk string terminator")
+		}
+		return buf[:n], nil
+	case '*':
+		n, err := readLength(line)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		if n > maxArrayLen {
+			return nil, fmt.Errorf("redis: array length %d exceeds limit", n)
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			if items[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("redis: invalid reply type %q", line[0])
+}
+
+// readLength는 bulk string/배열 헤더 줄($n, *n)의 길이를 읽습니다. null(-1)이면 -1을 반환합니다.
+func readLength(line []byte) (int, error) {
+	n, err := strconv.Atoi(string(line[1:]))
+	if err != nil || n < -1 {
+		return 0, fmt.Errorf("redis: invalid length %q", line)
+	}
+	return n, nil
+}
+
+// readLine은 CRLF로 끝나는 한 줄을 CRLF 없이 읽습니다.
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadSlice('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, errors.New("redis: invalid line terminator")
+	}
+	return line[:len(line)-2], nil
+}
@@ -0,0 +1,409 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// column은 표 형식(pipe, csv) 내보내기의 컬럼입니다.
+// label은 우체국 배포 파일의 헤더 이름(pipe), name은 영문 컬럼명(csv)이며 둘 다 import 시 인식됩니다.
+type column struct {
+	name  string
+	label string
+}
+
+// roadTableColumns는 도로명주소 표 형식의 컬럼 순서입니다. (우체국 범위주소 DB 배포 파일 기준)
+var roadTableColumns = []column{
+	{"zip_code", "우편번호"},
+	{"sido_name", "시도명"},
+	{"sido_name_en", "시도명(영문)"},
+	{"sigungu_name", "시군구명"},
+	{"sigungu_name_en", "시군구명(영문)"},
+	{"eupmyeon_name", "읍면명"},
+	{"eupmyeon_name_en", "읍면명(영문)"},
+	{"road_name", "도로명"},
+	{"road_name_en", "도로명(영문)"},
+	{"is_underground", "지하여부"},
+	{"start_building_main", "건물번호본번(시작)"},
+	{"start_building_sub", "건물번호부번(시작)"},
+	{"end_building_main", "건물번호본번(종료)"},
+	{"end_building_sub", "건물번호부번(종료)"},
+	{"range_type", "범위종류"},
+}
+
+// landTableColumns는 지번주소 표 형식의 컬럼 순서입니다. (우체국 범위주소 DB 배포 파일 기준)
+var landTableColumns = []column{
+	{"zip_code", "우편번호"},
+	{"sido_name", "시도명"},
+	{"sido_name_en", "시도명(영문)"},
+	{"sigungu_name", "시군구명"},
+	{"sigungu_name_en", "시군구명(영문)"},
+	{"eupmyeondong_name", "읍면동명"},
+	{"eupmyeondong_name_en", "읍면동명(영문)"},
+	{"ri_name", "리명"},
+	{"is_mountain", "산여부"},
+	{"haengjeongdong_name", "행정동명"},
+	{"start_jibun_main", "지번본번(시작)"},
+	{"start_jibun_sub", "지번부번(시작)"},
+	{"end_jibun_main", "지번본번(종료)"},
+	{"end_jibun_sub", "지번부번(종료)"},
+}
+
+// roadRecord는 도로명주소 행을 roadTableColumns 순서의 필드로 변환합니다.
+func roadRecord(road *postalcode.PostalCodeRoad) []string {
+	return []string{
+		road.ZipCode,
+		road.SidoName,
+		road.SidoNameEn,
+		road.SigunguName,
+		road.SigunguNameEn,
+		road.EupmyeonName,
+		road.EupmyeonNameEn,
+		road.RoadName,
+		road.RoadNameEn,
+		formatFlag(road.IsUnderground),
+		strconv.Itoa(road.StartBuildingMain),
+		formatOptionalInt(road.StartBuildingSub),
+		formatOptionalInt(road.EndBuildingMain),
+		formatOptionalInt(road.EndBuildingSub),
+		strconv.Itoa(int(road.RangeType)),
+	}
+}
+
+// landRecord는 지번주소 행을 landTableColumns 순서의 필드로 변환합니다.
+func landRecord(land *postalcode.PostalCodeLand) []string {
+	return []string{
+		land.ZipCode,
+		land.SidoName,
+		land.SidoNameEn,
+		land.SigunguName,
+		land.SigunguNameEn,
+		land.EupmyeondongName,
+		land.EupmyeondongNameEn,
+		land.RiName,
+		formatFlag(land.IsMountain),
+		land.HaengjeongdongName,
+		strconv.Itoa(land.StartJibunMain),
+		formatOptionalInt(land.StartJibunSub),
+		formatOptionalInt(land.EndJibunMain),
+		formatOptionalInt(land.EndJibunSub),
+	}
+}
+
+// formatFlag는 불리언 값을 배포 파일의 0/1 플래그로 변환합니다.
+func formatFlag(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+// formatOptionalInt는 선택 정수 값을 변환합니다. nil은 빈 값입니다.
+func formatOptionalInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
+
+// encoder는 형식별로 행을 기록합니다.
+type encoder interface {
+	writeRoad(road *postalcode.PostalCodeRoad) error
+	writeLand(land *postalcode.PostalCodeLand) error
+	// close는 버퍼에 남은 내용(계층 구조 JSON은 문서 전체)을 씁니다.
+	close() error
+}
+
+// newEncoder는 format에 맞는 encoder를 생성합니다.
+// 표 형식은 데이터가 없어도 헤더 라인을 씁니다.
+func newEncoder(w io.Writer, format, dataType string) (encoder, error) {
+	switch format {
+	case postalcode.ExportFormatPipe:
+		return newTableEncoder(w, '|', dataType, true)
+	case postalcode.ExportFormatCSV:
+		return newTableEncoder(w, ',', dataType, false)
+	case postalcode.ExportFormatJSONL:
+		return newJSONLEncoder(w), nil
+	case postalcode.ExportFormatJSON:
+		return newTreeEncoder(w, dataType), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", postalcode.ErrUnknownExportFormat, format)
+	}
+}
+
+// ============================================================
+// 표 형식 (pipe, csv)
+// ============================================================
+
+// tableEncoder는 구분자로 필드를 나누는 표 형식 encoder입니다.
+type tableEncoder struct {
+	writer *csv.Writer
+}
+
+// newTableEncoder는 헤더 라인을 쓰고 tableEncoder를 반환합니다.
+// useLabels이면 배포 파일의 한글 헤더, 아니면 영문 컬럼명을 헤더로 씁니다.
+func newTableEncoder(w io.Writer, comma rune, dataType string, useLabels bool) (*tableEncoder, error) {
+	columns := roadTableColumns
+	if dataType == postalcode.ImportDataTypeLand {
+		columns = landTableColumns
+	}
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		if useLabels {
+			header[i] = col.label
+		} else {
+			header[i] = col.name
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+	return &tableEncoder{writer: writer}, nil
+}
+
+func (e *tableEncoder) writeRoad(road *postalcode.PostalCodeRoad) error {
+	return e.writer.Write(roadRecord(road))
+}
+
+func (e *tableEncoder) writeLand(land *postalcode.PostalCodeLand) error {
+	return e.writer.Write(landRecord(land))
+}
+
+func (e *tableEncoder) close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// ============================================================
+// JSON Lines
+// ============================================================
+
+// jsonlEncoder는 한 줄에 한 행씩 JSON 객체를 씁니다. (API 응답과 같은 필드)
+type jsonlEncoder struct {
+	buf     *bufio.Writer
+	encoder *json.Encoder
+}
+
+// newJSONLEncoder는 jsonlEncoder를 생성합니다.
+func newJSONLEncoder(w io.Writer) *jsonlEncoder {
+	buf := bufio.NewWriter(w)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	return &jsonlEncoder{buf: buf, encoder: encoder}
+}
+
+func (e *jsonlEncoder) writeRoad(road *postalcode.PostalCodeRoad) error {
+	return e.encoder.Encode(road)
+}
+
+func (e *jsonlEncoder) writeLand(land *postalcode.PostalCodeLand) error {
+	return e.encoder.Encode(land)
+}
+
+func (e *jsonlEncoder) close() error {
+	return e.buf.Flush()
+}
+
+// ============================================================
+// 계층 구조 JSON
+// ============================================================
+
+// treeEncoder는 모든 행을 메모리에 모아 close 시점에 계층 구조 JSON 문서로 씁니다.
+// 전체 테이블보다는 시도/시군구로 거른 일부를 내보낼 때 사용합니다.
+type treeEncoder struct {
+	w        io.Writer
+	dataType string
+	count    int
+	sidos    map[string]*sidoNode
+}
+
+// sidoNode는 시도 단계의 집계 노드입니다.
+type sidoNode struct {
+	sido     postalcode.ExportSido
+	sigungus map[string]*sigunguNode
+}
+
+// sigunguNode는 시군구 단계의 집계 노드입니다.
+type sigunguNode struct {
+	sigungu postalcode.ExportSigungu
+	roads   map[roadKey]*postalcode.ExportRoad
+	dongs   map[string]*postalcode.ExportEupmyeondong
+}
+
+// roadKey는 시군구 안에서 도로를 구분하는 키입니다. (같은 도로명이 여러 읍면에 걸칠 수 있음)
+type roadKey struct {
+	eupmyeon string
+	road     string
+}
+
+// newTreeEncoder는 treeEncoder를 생성합니다.
+func newTreeEncoder(w io.Writer, dataType string) *treeEncoder {
+	return &treeEncoder{w: w, dataType: dataType, sidos: make(map[string]*sidoNode)}
+}
+
+// sigungu는 시도/시군구 노드를 찾거나 생성합니다.
+func (e *treeEncoder) sigungu(sidoName, sidoNameEn, sigunguName, sigunguNameEn string) *sigunguNode {
+	sn, ok := e.sidos[sidoName]
+	if !ok {
+		sn = &sidoNode{
+			sido:     postalcode.ExportSido{Name: sidoName, NameEn: sidoNameEn},
+			sigungus: make(map[string]*sigunguNode),
+		}
+		e.sidos[sidoName] = sn
+	}
+
+	gn, ok := sn.sigungus[sigunguName]
+	if !ok {
+		gn = &sigunguNode{
+			sigungu: postalcode.ExportSigungu{Name: sigunguName, NameEn: sigunguNameEn},
+			roads:   make(map[roadKey]*postalcode.ExportRoad),
+			dongs:   make(map[string]*postalcode.ExportEupmyeondong),
+		}
+		sn.sigungus[sigunguName] = gn
+	}
+	return gn
+}
+
+func (e *treeEncoder) writeRoad(road *postalcode.PostalCodeRoad) error {
+	gn := e.sigungu(road.SidoName, road.SidoNameEn, road.SigunguName, road.SigunguNameEn)
+
+	key := roadKey{eupmyeon: road.EupmyeonName, road: road.RoadName}
+	rn, ok := gn.roads[key]
+	if !ok {
+		rn = &postalcode.ExportRoad{
+			Name:           road.RoadName,
+			NameEn:         road.RoadNameEn,
+			EupmyeonName:   road.EupmyeonName,
+			EupmyeonNameEn: road.EupmyeonNameEn,
+		}
+		gn.roads[key] = rn
+	}
+
+	rn.Ranges = append(rn.Ranges, postalcode.ExportRoadRange{
+		ZipCode:           road.ZipCode,
+		IsUnderground:     road.IsUnderground,
+		StartBuildingMain: road.StartBuildingMain,
+		StartBuildingSub:  road.StartBuildingSub,
+		EndBuildingMain:   road.EndBuildingMain,
+		EndBuildingSub:    road.EndBuildingSub,
+		RangeType:         road.RangeType,
+	})
+	e.count++
+	return nil
+}
+
+func (e *treeEncoder) writeLand(land *postalcode.PostalCodeLand) error {
+	gn := e.sigungu(land.SidoName, land.SidoNameEn, land.SigunguName, land.SigunguNameEn)
+
+	dn, ok := gn.dongs[land.EupmyeondongName]
+	if !ok {
+		dn = &postalcode.ExportEupmyeondong{Name: land.EupmyeondongName, NameEn: land.EupmyeondongNameEn}
+		gn.dongs[land.EupmyeondongName] = dn
+	}
+
+	dn.Ranges = append(dn.Ranges, postalcode.ExportLandRange{
+		ZipCode:            land.ZipCode,
+		RiName:             land.RiName,
+		HaengjeongdongName: land.HaengjeongdongName,
+		IsMountain:         land.IsMountain,
+		StartJibunMain:     land.StartJibunMain,
+		StartJibunSub:      land.StartJibunSub,
+		EndJibunMain:       land.EndJibunMain,
+		EndJibunSub:        land.EndJibunSub,
+	})
+	e.count++
+	return nil
+}
+
+func (e *treeEncoder) close() error {
+	tree := postalcode.ExportTree{
+		DataType: e.dataType,
+		Count:    e.count,
+		Sido:     []postalcode.ExportSido{},
+	}
+
+	sidos := make([]*sidoNode, 0, len(e.sidos))
+	for _, sn := range e.sidos {
+		sidos = append(sidos, sn)
+	}
+	sort.Slice(sidos, func(i, j int) bool { return sidos[i].sido.Name < sidos[j].sido.Name })
+
+	for _, sn := range sidos {
+		sigungus := make([]*sigunguNode, 0, len(sn.sigungus))
+		for _, gn := range sn.sigungus {
+			sigungus = append(sigungus, gn)
+		}
+		sort.Slice(sigungus, func(i, j int) bool { return sigungus[i].sigungu.Name < sigungus[j].sigungu.Name })
+
+		for _, gn := range sigungus {
+			gn.sigungu.Roads = sortedRoads(gn.roads)
+			gn.sigungu.Eupmyeondong = sortedDongs(gn.dongs)
+			sn.sido.Sigungu = append(sn.sido.Sigungu, gn.sigungu)
+		}
+		tree.Sido = append(tree.Sido, sn.sido)
+	}
+
+	encoder := json.NewEncoder(e.w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(tree)
+}
+
+// sortedRoads는 도로를 (도로명, 읍면)순으로, 각 도로의 범위를 (지하여부, 시작건물번호, 우편번호)순으로 정렬합니다.
+func sortedRoads(nodes map[roadKey]*postalcode.ExportRoad) []postalcode.ExportRoad {
+	roads := make([]postalcode.ExportRoad, 0, len(nodes))
+	for _, rn := range nodes {
+		ranges := rn.Ranges
+		sort.SliceStable(ranges, func(i, j int) bool {
+			if ranges[i].IsUnderground != ranges[j].IsUnderground {
+				return !ranges[i].IsUnderground
+			}
+			if ranges[i].StartBuildingMain != ranges[j].StartBuildingMain {
+				return ranges[i].StartBuildingMain < ranges[j].StartBuildingMain
+			}
+			return ranges[i].ZipCode < ranges[j].ZipCode
+		})
+		roads = append(roads, *rn)
+	}
+	sort.Slice(roads, func(i, j int) bool {
+		if roads[i].Name != roads[j].Name {
+			return roads[i].Name < roads[j].Name
+		}
+		return roads[i].EupmyeonName < roads[j].EupmyeonName
+	})
+	return roads
+}
+
+// sortedDongs는 읍면동을 이름순으로, 각 읍면동의 범위를 (리, 산여부, 시작주번지, 우편번호)순으로 정렬합니다.
+func sortedDongs(nodes map[string]*postalcode.ExportEupmyeondong) []postalcode.ExportEupmyeondong {
+	dongs := make([]postalcode.ExportEupmyeondong, 0, len(nodes))
+	for _, dn := range nodes {
+		ranges := dn.Ranges
+		sort.SliceStable(ranges, func(i, j int) bool {
+			if ranges[i].RiName != ranges[j].RiName {
+				return ranges[i].RiName < ranges[j].RiName
+			}
+			if ranges[i].IsMountain != ranges[j].IsMountain {
+				return !ranges[i].IsMountain
+			}
+			if ranges[i].StartJibunMain != ranges[j].StartJibunMain {
+				return ranges[i].StartJibunMain < ranges[j].StartJibunMain
+			}
+			return ranges[i].ZipCode < ranges[j].ZipCode
+		})
+		dongs = append(dongs, *dn)
+	}
+	sort.Slice(dongs, func(i, j int) bool { return dongs[i].Name < dongs[j].Name })
+	return dongs
+}
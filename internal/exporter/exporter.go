@@ -0,0 +1,116 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+)
+
+// 기본 설정
+const (
+	defaultBatchSize = 5000
+)
+
+// Exporter는 우편번호 테이블을 파일 형식으로 내보냅니다.
+type Exporter interface {
+	// ExportRoads는 검색 조건에 맞는 도로명주소 데이터를 format 형식으로 w에 씁니다.
+	// params의 Page/Limit은 무시하며 조건에 맞는 모든 행을 내보냅니다.
+	ExportRoads(w io.Writer, format string, params postalcode.SearchParams) (*postalcode.ExportResult, error)
+
+	// ExportLands는 검색 조건에 맞는 지번주소 데이터를 format 형식으로 w에 씁니다.
+	// params의 Page/Limit은 무시하며 조건에 맞는 모든 행을 내보냅니다.
+	ExportLands(w io.Writer, format string, params postalcode.SearchParamsLand) (*postalcode.ExportResult, error)
+}
+
+// exporter는 Exporter 인터페이스 구현입니다.
+type exporter struct {
+	service   service.Service
+	batchSize int
+}
+
+// Option은 Exporter 생성 옵션입니다.
+type Option func(*exporter)
+
+// WithBatchSize는 테이블을 순회할 때 한 번에 읽을 행 수를 지정합니다. (기본값: 5000)
+func WithBatchSize(size int) Option {
+	return func(e *exporter) {
+		if size > 0 {
+			e.batchSize = size
+		}
+	}
+}
+
+// New는 새로운 Exporter를 생성합니다.
+func New(svc service.Service, opts ...Option) Exporter {
+	e := &exporter{
+		service:   svc,
+		batchSize: defaultBatchSize,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// ExportRoads는 도로명주소 데이터를 내보냅니다.
+func (e *exporter) ExportRoads(w io.Writer, format string, params postalcode.SearchParams) (*postalcode.ExportResult, error) {
+	startTime := time.Now()
+
+	enc, err := newEncoder(w, format, postalcode.ImportDataTypeRoad)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &postalcode.ExportResult{DataType: postalcode.ImportDataTypeRoad, Format: format}
+	err = e.service.ScanRoads(params, e.batchSize, func(roads []postalcode.PostalCodeRoad) error {
+		for i := range roads {
+			if err := enc.writeRoad(&roads[i]); err != nil {
+				return err
+			}
+		}
+		result.RowCount += len(roads)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export roads: %w", err)
+	}
+	if err := enc.close(); err != nil {
+		return nil, fmt.Errorf("failed to export roads: %w", err)
+	}
+
+	result.Duration = time.Since(startTime).String()
+	return result, nil
+}
+
+// ExportLands는 지번주소 데이터를 내보냅니다.
+func (e *exporter) ExportLands(w io.Writer, format string, params postalcode.SearchParamsLand) (*postalcode.ExportResult, error) {
+	startTime := time.Now()
+
+	enc, err := newEncoder(w, format, postalcode.ImportDataTypeLand)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &postalcode.ExportResult{DataType: postalcode.ImportDataTypeLand, Format: format}
+	err = e.service.ScanLands(params, e.batchSize, func(lands []postalcode.PostalCodeLand) error {
+		for i := range lands {
+			if err := enc.writeLand(&lands[i]); err != nil {
+				return err
+			}
+		}
+		result.RowCount += len(lands)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export lands: %w", err)
+	}
+	if err := enc.close(); err != nil {
+		return nil, fmt.Errorf("failed to export lands: %w", err)
+	}
+
+	result.Duration = time.Since(startTime).String()
+	return result, nil
+}
@@ -0,0 +1,233 @@
+package exporter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/importer"
+	"github.com/oursportsnation/korean-postalcode/internal/repository"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const testRoadFile = `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류
+01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로177길|Samyang-ro 177-gil|0|93|0|126|0|3
+01001|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로177길|Samyang-ro 177-gil|0|1|0|91|0|1
+06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|1|101|2|||0
+63000|제주특별자치도|Jeju-do|제주시|Jeju-si|애월읍|Aewol-eup|"애월로 ""1"""|Aewol-ro|0|1||9||1
+`
+
+const testLandFile = `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면동명|읍면동명(영문)|리명|산여부|행정동명|지번본번(시작)|지번부번(시작)|지번본번(종료)|지번부번(종료)
+25627|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|모전리|0||1|0|100|0
+25628|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|심곡리|1||5|2|||
+25600|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|교동|Gyo-dong||0|교1동|1||50|
+`
+
+func setupTestExporter(t *testing.T) (Exporter, importer.Importer) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.ImportRun{})
+	require.NoError(t, err)
+
+	svc := service.New(repository.New(db))
+	imp := importer.New(svc)
+
+	_, err = imp.ImportFromReader(strings.NewReader(testRoadFile), 0, 100, nil)
+	require.NoError(t, err)
+	_, err = imp.ImportLandFromReader(strings.NewReader(testLandFile), 0, 100, nil)
+	require.NoError(t, err)
+
+	return New(svc, WithBatchSize(2)), imp
+}
+
+// ============================================================
+// Pipe Format Tests
+// ============================================================
+
+func TestExporter_Pipe_RoundTrip(t *testing.T) {
+	exp, imp := setupTestExporter(t)
+
+	var buf bytes.Buffer
+	result, err := exp.ExportRoads(&buf, postalcode.ExportFormatPipe, postalcode.SearchParams{})
+	require.NoError(t, err)
+	assert.Equal(t, postalcode.ImportDataTypeRoad, result.DataType)
+	assert.Equal(t, postalcode.ExportFormatPipe, result.Format)
+	assert.Equal(t, 4, result.RowCount)
+
+	// 배포 파일과 같은 헤더
+	firstLine := strings.SplitN(buf.String(), "\n", 2)[0]
+	assert.Equal(t, strings.SplitN(testRoadFile, "\n", 2)[0], firstLine)
+
+	exported, err := imp.ParseReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	original, err := imp.ParseReader(strings.NewReader(testRoadFile))
+	require.NoError(t, err)
+	assert.Equal(t, original, exported)
+}
+
+func TestExporter_Pipe_LandRoundTrip(t *testing.T) {
+	exp, imp := setupTestExporter(t)
+
+	var buf bytes.Buffer
+	result, err := exp.ExportLands(&buf, postalcode.ExportFormatPipe, postalcode.SearchParamsLand{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.RowCount)
+
+	exported, err := imp.ParseLandReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	original, err := imp.ParseLandReader(strings.NewReader(testLandFile))
+	require.NoError(t, err)
+	assert.Equal(t, original, exported)
+}
+
+func TestExporter_Pipe_Filtered(t *testing.T) {
+	exp, imp := setupTestExporter(t)
+
+	var buf bytes.Buffer
+	result, err := exp.ExportRoads(&buf, postalcode.ExportFormatPipe, postalcode.SearchParams{SidoName: "서울", SigunguName: "강북구"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.RowCount)
+
+	roads, err := imp.ParseReader(&buf)
+	require.NoError(t, err)
+	require.Len(t, roads, 2)
+	for _, road := range roads {
+		assert.Equal(t, "강북구", road.SigunguName)
+	}
+
+	// 결과가 없어도 헤더는 기록
+	buf.Reset()
+	result, err = exp.ExportLands(&buf, postalcode.ExportFormatPipe, postalcode.SearchParamsLand{SidoName: "부산"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.RowCount)
+	assert.Equal(t, strings.SplitN(testLandFile, "\n", 2)[0]+"\n", buf.String())
+}
+
+// ============================================================
+// CSV / JSON Lines Tests
+// ============================================================
+
+func TestExporter_CSV(t *testing.T) {
+	exp, _ := setupTestExporter(t)
+
+	var buf bytes.Buffer
+	_, err := exp.ExportRoads(&buf, postalcode.ExportFormatCSV, postalcode.SearchParams{ZipCode: "63000"})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "zip_code", records[0][0])
+	assert.Equal(t, "range_type", records[0][len(records[0])-1])
+	assert.Equal(t, []string{
+		"63000", "제주특별자치도", "Jeju-do", "제주시", "Jeju-si", "애월읍", "Aewol-eup",
+		`애월로 "1"`, "Aewol-ro", "0", "1", "", "9", "", "1",
+	}, records[1])
+}
+
+func TestExporter_JSONL(t *testing.T) {
+	exp, _ := setupTestExporter(t)
+
+	var buf bytes.Buffer
+	result, err := exp.ExportLands(&buf, postalcode.ExportFormatJSONL, postalcode.SearchParamsLand{})
+	require.NoError(t, err)
+
+	var lands []postalcode.PostalCodeLand
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var land postalcode.PostalCodeLand
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &land))
+		lands = append(lands, land)
+	}
+	require.Len(t, lands, result.RowCount)
+	assert.Equal(t, "25627", lands[0].ZipCode)
+	assert.Equal(t, "모전리", lands[0].RiName)
+	assert.True(t, lands[1].IsMountain)
+	require.NotNil(t, lands[1].StartJibunSub)
+	assert.Equal(t, 2, *lands[1].StartJibunSub)
+}
+
+// ============================================================
+// Nested JSON Tests
+// ============================================================
+
+func TestExporter_JSON_RoadTree(t *testing.T) {
+	exp, _ := setupTestExporter(t)
+
+	var buf bytes.Buffer
+	_, err := exp.ExportRoads(&buf, postalcode.ExportFormatJSON, postalcode.SearchParams{})
+	require.NoError(t, err)
+
+	var tree postalcode.ExportTree
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &tree))
+	assert.Equal(t, postalcode.ImportDataTypeRoad, tree.DataType)
+	assert.Equal(t, 4, tree.Count)
+
+	// 시도 > 시군구 이름순
+	require.Len(t, tree.Sido, 2)
+	assert.Equal(t, "서울특별시", tree.Sido[0].Name)
+	assert.Equal(t, "Seoul", tree.Sido[0].NameEn)
+	require.Len(t, tree.Sido[0].Sigungu, 2)
+	assert.Equal(t, "강남구", tree.Sido[0].Sigungu[0].Name)
+	assert.Equal(t, "강북구", tree.Sido[0].Sigungu[1].Name)
+	assert.Empty(t, tree.Sido[0].Sigungu[1].Eupmyeondong)
+
+	// 같은 도로의 범위는 시작 건물번호순
+	roads := tree.Sido[0].Sigungu[1].Roads
+	require.Len(t, roads, 1)
+	assert.Equal(t, "삼양로177길", roads[0].Name)
+	require.Len(t, roads[0].Ranges, 2)
+	assert.Equal(t, "01001", roads[0].Ranges[0].ZipCode)
+	assert.Equal(t, "01000", roads[0].Ranges[1].ZipCode)
+
+	jeju := tree.Sido[1].Sigungu[0].Roads
+	require.Len(t, jeju, 1)
+	assert.Equal(t, "애월읍", jeju[0].EupmyeonName)
+}
+
+func TestExporter_JSON_LandTree(t *testing.T) {
+	exp, _ := setupTestExporter(t)
+
+	var buf bytes.Buffer
+	_, err := exp.ExportLands(&buf, postalcode.ExportFormatJSON, postalcode.SearchParamsLand{})
+	require.NoError(t, err)
+
+	var tree postalcode.ExportTree
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &tree))
+	assert.Equal(t, postalcode.ImportDataTypeLand, tree.DataType)
+	require.Len(t, tree.Sido, 1)
+	require.Len(t, tree.Sido[0].Sigungu, 1)
+
+	dongs := tree.Sido[0].Sigungu[0].Eupmyeondong
+	require.Len(t, dongs, 2)
+	assert.Equal(t, "강동면", dongs[0].Name)
+	assert.Equal(t, "교동", dongs[1].Name)
+	require.Len(t, dongs[0].Ranges, 2)
+	assert.Equal(t, "모전리", dongs[0].Ranges[0].RiName)
+	assert.Equal(t, "교1동", dongs[1].Ranges[0].HaengjeongdongName)
+
+	// 결과가 없으면 빈 배열
+	buf.Reset()
+	_, err = exp.ExportLands(&buf, postalcode.ExportFormatJSON, postalcode.SearchParamsLand{SidoName: "부산"})
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `"sido": []`)
+}
+
+func TestExporter_UnknownFormat(t *testing.T) {
+	exp, _ := setupTestExporter(t)
+
+	var buf bytes.Buffer
+	_, err := exp.ExportRoads(&buf, "xml", postalcode.SearchParams{})
+	assert.True(t, errors.Is(err, postalcode.ErrUnknownExportFormat))
+	assert.Empty(t, buf.String())
+}
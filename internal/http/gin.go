@@ -3,6 +3,7 @@ package http
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	postalcode "github.com/oursportsnation/korean-postalcode"
@@ -29,39 +30,107 @@ type SearchResponseLand struct {
 	Total   int64                       `json:"total" example:"10"`
 }
 
+// SearchResponseBuilding은 건물 검색 응답 구조체입니다.
+type SearchResponseBuilding struct {
+	Success bool                         `json:"success" example:"true"`
+	Data    []postalcode.BuildingAddress `json:"data"`
+	Total   int64                        `json:"total" example:"1"`
+}
+
+// SearchResponsePOBox는 사서함 검색 응답 구조체입니다.
+type SearchResponsePOBox struct {
+	Success bool                         `json:"success" example:"true"`
+	Data    []postalcode.PostalCodePOBox `json:"data"`
+	Total   int64                        `json:"total" example:"1"`
+}
+
+// SearchResponseBulkDelivery는 다량배달처 검색 응답 구조체입니다.
+type SearchResponseBulkDelivery struct {
+	Success bool                                `json:"success" example:"true"`
+	Data    []postalcode.PostalCodeBulkDelivery `json:"data"`
+	Total   int64                               `json:"total" example:"1"`
+}
+
+// ZipCodeCheckResponse는 우편번호 존재 여부 응답 구조체입니다.
+type ZipCodeCheckResponse struct {
+	Success bool                    `json:"success" example:"true"`
+	Data    postalcode.ZipCodeCheck `json:"data"`
+}
+
+// CoverageResponse는 건물번호 범위 분석 응답 구조체입니다.
+type CoverageResponse struct {
+	Success bool                      `json:"success" example:"true"`
+	Data    postalcode.CoverageReport `json:"data"`
+	Total   int64                     `json:"total" example:"1"`
+}
+
 // GinHandler는 Gin 프레임워크용 우편번호 API 핸들러입니다.
 type GinHandler struct {
 	service service.Service
+	version *datasetVersion
 }
 
 // NewGin는 새로운 GinHandler를 생성합니다.
 func NewGin(svc service.Service) *GinHandler {
-	return &GinHandler{service: svc}
+	return &GinHandler{service: svc, version: newDatasetVersion(svc)}
 }
 
 // RegisterGinRoutes는 Gin RouterGroup에 라우트를 등록합니다.
 // 사용 예: handler.RegisterGinRoutes(router.Group("/api/v1/postal-codes"))
 func (h *GinHandler) RegisterGinRoutes(rg *gin.RouterGroup) {
 	// 도로명주소 엔드포인트
-	road := rg.Group("/road")
+	road := rg.Group("/road", h.setDatasetVersion)
 	{
 		road.GET("/search", h.Search)
 		road.GET("/zipcode/:code", h.GetByZipCode)
 		road.GET("/prefix/:prefix", h.GetByZipPrefix)
+		road.GET("/coverage", h.RoadCoverage)
 	}
 
 	// 지번주소 엔드포인트
-	land := rg.Group("/land")
+	land := rg.Group("/land", h.setDatasetVersion)
 	{
 		land.GET("/search", h.SearchLand)
 		land.GET("/zipcode/:code", h.GetLandByZipCode)
 		land.GET("/prefix/:prefix", h.GetLandByZipPrefix)
 	}
+
+	// 건물 엔드포인트
+	building := rg.Group("/building", h.setDatasetVersion)
+	{
+		building.GET("/search", h.SearchBuildings)
+	}
+
+	// 사서함 엔드포인트
+	pobox := rg.Group("/pobox", h.setDatasetVersion)
+	{
+		pobox.GET("/search", h.SearchPOBoxes)
+		pobox.GET("/zipcode/:code", h.GetPOBoxByZipCode)
+	}
+
+	// 다량배달처 엔드포인트
+	bulk := rg.Group("/bulk", h.setDatasetVersion)
+	{
+		bulk.GET("/search", h.SearchBulkDeliveries)
+		bulk.GET("/zipcode/:code", h.GetBulkDeliveryByZipCode)
+	}
+
+	// 우편번호 존재 여부 (도로명/지번, 사서함, 다량배달처 통합)
+	rg.GET("/zipcode/:code", h.setDatasetVersion, h.CheckZipCode)
+}
+
+// setDatasetVersion은 현재 데이터셋 버전을 응답 헤더에 설정하는 미들웨어입니다.
+func (h *GinHandler) setDatasetVersion(c *gin.Context) {
+	if version := h.version.get(); version != "" {
+		c.Header(DatasetVersionHeader, version)
+	}
+	c.Next()
 }
 
 // Search godoc
 // @Summary 복합 조건으로 우편번호 검색
 // @Description 시도, 시군구, 도로명, 우편번호 등 여러 조건으로 검색 가능
+// @Description q는 공백으로 나눈 단어가 모두 도로명/시군구명/읍면명 중 하나에 포함된 결과를 찾는 통합 검색어입니다.
 // @Tags PostalCodeRoad
 // @Accept json
 // @Produce json
@@ -70,6 +139,7 @@ func (h *GinHandler) RegisterGinRoutes(rg *gin.RouterGroup) {
 // @Param sido_name query string false "시도명 (부분 매칭)" example("서울특별시")
 // @Param sigungu_name query string false "시군구명 (부분 매칭)" example("강북구")
 // @Param road_name query string false "도로명 (부분 매칭)" example("삼양로")
+// @Param q query string false "통합 검색어 (도로명/시군구명/읍면명, 공백으로 단어 구분)" example("강북구 삼양로")
 // @Param page query int false "페이지 번호 (기본 1)" default(1)
 // @Param limit query int false "페이지당 결과 개수 (기본 10, 최대 100)" default(10)
 // @Success 200 {object} SearchResponse "성공"
@@ -83,6 +153,7 @@ func (h *GinHandler) Search(c *gin.Context) {
 		SidoName:    c.Query("sido_name"),
 		SigunguName: c.Query("sigungu_name"),
 		RoadName:    c.Query("road_name"),
+		Query:       c.Query("q"),
 	}
 
 	if page := c.Query("page"); page != "" {
@@ -202,9 +273,62 @@ func (h *GinHandler) GetByZipPrefix(c *gin.Context) {
 // 지번주소 관련 핸들러
 // ============================================================
 
+// RoadCoverage godoc
+// @Summary 도로의 건물번호 범위 겹침/빈 구간 분석
+// @Description (시도, 시군구, 도로명, 지하여부) 그룹별로 서로 다른 우편번호에 배정된 겹치는 건물번호 범위와 어떤 범위에도 속하지 않는 빈 구간을 찾습니다
+// @Tags PostalCodeRoad
+// @Accept json
+// @Produce json
+// @Param road_name query string true "도로명 (정확 매칭)" example("삼양로")
+// @Param sido_name query string false "시도명 (부분 매칭)" example("서울특별시")
+// @Param sigungu_name query string false "시군구명 (부분 매칭)" example("강북구")
+// @Success 200 {object} CoverageResponse "성공"
+// @Failure 400 {object} ErrorResponse "잘못된 요청"
+// @Failure 404 {object} ErrorResponse "도로를 찾을 수 없음"
+// @Failure 500 {object} ErrorResponse "서버 오류"
+// @Router /api/v1/postal-codes/road/coverage [get]
+func (h *GinHandler) RoadCoverage(c *gin.Context) {
+	params := postalcode.CoverageParams{
+		SidoName:    c.Query("sido_name"),
+		SigunguName: c.Query("sigungu_name"),
+		RoadName:    c.Query("road_name"),
+	}
+	if params.RoadName == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "road_name is required",
+		})
+		return
+	}
+
+	report, err := h.service.AnalyzeRoadCoverage(params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if report.RoadCount == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "road not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    report,
+		"total":   int64(report.RoadCount),
+	})
+}
+
 // SearchLand godoc
 // @Summary 복합 조건으로 지번주소 우편번호 검색
 // @Description 시도, 시군구, 읍면동, 리명, 우편번호 등 여러 조건으로 검색 가능
+// @Description q는 공백으로 나눈 단어가 모두 시군구명/읍면동명/리명 중 하나에 포함된 결과를 찾는 통합 검색어입니다.
 // @Tags PostalCodeLand
 // @Accept json
 // @Produce json
@@ -214,6 +338,7 @@ func (h *GinHandler) GetByZipPrefix(c *gin.Context) {
 // @Param sigungu_name query string false "시군구명 (부분 매칭)" example("강릉시")
 // @Param eupmyeondong_name query string false "읍면동명 (부분 매칭)" example("강동면")
 // @Param ri_name query string false "리명 (부분 매칭)" example("모전리")
+// @Param q query string false "통합 검색어 (시군구명/읍면동명/리명, 공백으로 단어 구분)" example("강릉시 모전리")
 // @Param page query int false "페이지 번호 (기본 1)" default(1)
 // @Param limit query int false "페이지당 결과 개수 (기본 10, 최대 100)" default(10)
 // @Success 200 {object} SearchResponseLand "성공"
@@ -228,6 +353,7 @@ func (h *GinHandler) SearchLand(c *gin.Context) {
 		SigunguName:      c.Query("sigungu_name"),
 		EupmyeondongName: c.Query("eupmyeondong_name"),
 		RiName:           c.Query("ri_name"),
+		Query:            c.Query("q"),
 	}
 
 	if page := c.Query("page"); page != "" {
@@ -342,3 +468,262 @@ func (h *GinHandler) GetLandByZipPrefix(c *gin.Context) {
 		"total":   total,
 	})
 }
+
+// SearchBuildings godoc
+// @Summary 건물명으로 주소 검색
+// @Description 건물명(시군구용 건물명 또는 건축물대장 건물명, 공백 무시 부분 매칭)으로 건물을 찾아 정확한 우편번호, 도로명주소, 지번주소를 반환합니다
+// @Tags PostalCodeBuilding
+// @Accept json
+// @Produce json
+// @Param building_name query string true "건물명 (부분 매칭, 공백 무시)" example("롯데월드타워")
+// @Param zip_code query string false "우편번호 (5자리 정확 매칭)"
+// @Param sido_name query string false "시도명 (부분 매칭)" example("서울특별시")
+// @Param sigungu_name query string false "시군구명 (부분 매칭)" example("송파구")
+// @Param page query int false "페이지 번호 (기본 1)" default(1)
+// @Param limit query int false "페이지당 결과 개수 (기본 10, 최대 100)" default(10)
+// @Success 200 {object} SearchResponseBuilding "성공"
+// @Failure 400 {object} ErrorResponse "잘못된 요청"
+// @Failure 500 {object} ErrorResponse "서버 오류"
+// @Router /api/v1/postal-codes/building/search [get]
+func (h *GinHandler) SearchBuildings(c *gin.Context) {
+	params := postalcode.SearchParamsBuilding{
+		BuildingName: c.Query("building_name"),
+		ZipCode:      c.Query("zip_code"),
+		SidoName:     c.Query("sido_name"),
+		SigunguName:  c.Query("sigungu_name"),
+	}
+	if strings.TrimSpace(params.BuildingName) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "building_name is required",
+		})
+		return
+	}
+
+	if page := c.Query("page"); page != "" {
+		if val, err := strconv.Atoi(page); err == nil {
+			params.Page = val
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			params.Limit = val
+		}
+	}
+
+	results, total, err := h.service.SearchBuildings(params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    results,
+		"total":   total,
+	})
+}
+
+// SearchPOBoxes godoc
+// @Summary 사서함 검색
+// @Description 사서함명(부분 매칭), 시도, 시군구, 우편번호로 사서함 우편번호 검색
+// @Tags PostalCodePOBox
+// @Accept json
+// @Produce json
+// @Param po_box_name query string false "사서함명 (부분 매칭)" example("광화문")
+// @Param zip_code query string false "우편번호 (5자리 정확 매칭)"
+// @Param sido_name query string false "시도명 (부분 매칭)" example("서울특별시")
+// @Param sigungu_name query string false "시군구명 (부분 매칭)" example("종로구")
+// @Param page query int false "페이지 번호 (기본 1)" default(1)
+// @Param limit query int false "페이지당 결과 개수 (기본 10, 최대 100)" default(10)
+// @Success 200 {object} SearchResponsePOBox "성공"
+// @Failure 500 {object} ErrorResponse "서버 오류"
+// @Router /api/v1/postal-codes/pobox/search [get]
+func (h *GinHandler) SearchPOBoxes(c *gin.Context) {
+	params := postalcode.SearchParamsPOBox{
+		ZipCode:     c.Query("zip_code"),
+		SidoName:    c.Query("sido_name"),
+		SigunguName: c.Query("sigungu_name"),
+		POBoxName:   c.Query("po_box_name"),
+	}
+
+	if page := c.Query("page"); page != "" {
+		if val, err := strconv.Atoi(page); err == nil {
+			params.Page = val
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			params.Limit = val
+		}
+	}
+
+	results, total, err := h.service.SearchPOBoxes(params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    results,
+		"total":   total,
+	})
+}
+
+// GetPOBoxByZipCode godoc
+// @Summary 우편번호로 사서함 조회
+// @Description 5자리 우편번호로 정확히 매칭되는 사서함 조회
+// @Tags PostalCodePOBox
+// @Accept json
+// @Produce json
+// @Param code path string true "우편번호 (5자리)" example("03154")
+// @Success 200 {object} SearchResponsePOBox "성공"
+// @Failure 400 {object} ErrorResponse "잘못된 요청"
+// @Failure 404 {object} ErrorResponse "우편번호를 찾을 수 없음"
+// @Router /api/v1/postal-codes/pobox/zipcode/{code} [get]
+func (h *GinHandler) GetPOBoxByZipCode(c *gin.Context) {
+	code := c.Param("code")
+	results, err := h.service.GetPOBoxByZipCode(code)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if len(results) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "postal code not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    results,
+		"total":   int64(len(results)),
+	})
+}
+
+// SearchBulkDeliveries godoc
+// @Summary 다량배달처 검색
+// @Description 다량배달처명(공백 무시 부분 매칭), 시도, 시군구, 우편번호로 다량배달처 우편번호 검색
+// @Tags PostalCodeBulkDelivery
+// @Accept json
+// @Produce json
+// @Param delivery_name query string false "다량배달처명 (부분 매칭, 공백 무시)" example("정부서울청사")
+// @Param zip_code query string false "우편번호 (5자리 정확 매칭)"
+// @Param sido_name query string false "시도명 (부분 매칭)" example("서울특별시")
+// @Param sigungu_name query string false "시군구명 (부분 매칭)" example("종로구")
+// @Param page query int false "페이지 번호 (기본 1)" default(1)
+// @Param limit query int false "페이지당 결과 개수 (기본 10, 최대 100)" default(10)
+// @Success 200 {object} SearchResponseBulkDelivery "성공"
+// @Failure 500 {object} ErrorResponse "서버 오류"
+// @Router /api/v1/postal-codes/bulk/search [get]
+func (h *GinHandler) SearchBulkDeliveries(c *gin.Context) {
+	params := postalcode.SearchParamsBulkDelivery{
+		ZipCode:      c.Query("zip_code"),
+		SidoName:     c.Query("sido_name"),
+		SigunguName:  c.Query("sigungu_name"),
+		DeliveryName: c.Query("delivery_name"),
+	}
+
+	if page := c.Query("page"); page != "" {
+		if val, err := strconv.Atoi(page); err == nil {
+			params.Page = val
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			params.Limit = val
+		}
+	}
+
+	results, total, err := h.service.SearchBulkDeliveries(params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    results,
+		"total":   total,
+	})
+}
+
+// GetBulkDeliveryByZipCode godoc
+// @Summary 우편번호로 다량배달처 조회
+// @Description 5자리 우편번호로 정확히 매칭되는 다량배달처 조회
+// @Tags PostalCodeBulkDelivery
+// @Accept json
+// @Produce json
+// @Param code path string true "우편번호 (5자리)" example("03171")
+// @Success 200 {object} SearchResponseBulkDelivery "성공"
+// @Failure 400 {object} ErrorResponse "잘못된 요청"
+// @Failure 404 {object} ErrorResponse "우편번호를 찾을 수 없음"
+// @Router /api/v1/postal-codes/bulk/zipcode/{code} [get]
+func (h *GinHandler) GetBulkDeliveryByZipCode(c *gin.Context) {
+	code := c.Param("code")
+	results, err := h.service.GetBulkDeliveryByZipCode(code)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if len(results) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "postal code not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    results,
+		"total":   int64(len(results)),
+	})
+}
+
+// CheckZipCode godoc
+// @Summary 우편번호 존재 여부 확인
+// @Description 우편번호가 일반 배달구역(도로명/지번), 사서함, 다량배달처 중 어디에 있는지 확인합니다. 존재하지 않으면 exists=false로 응답합니다
+// @Tags PostalCode
+// @Accept json
+// @Produce json
+// @Param code path string true "우편번호 (5자리)" example("03171")
+// @Success 200 {object} ZipCodeCheckResponse "성공 (kind: address, pobox, bulk)"
+// @Failure 400 {object} ErrorResponse "잘못된 요청"
+// @Router /api/v1/postal-codes/zipcode/{code} [get]
+func (h *GinHandler) CheckZipCode(c *gin.Context) {
+	code := c.Param("code")
+	check, err := h.service.CheckZipCode(code)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    check,
+	})
+}
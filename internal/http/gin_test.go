@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -22,7 +23,8 @@ func setupTestGinHandler(t *testing.T) (*GinHandler, *gin.Engine) {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{})
+	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.PostalCodeBuilding{},
+		&postalcode.PostalCodePOBox{}, &postalcode.PostalCodeBulkDelivery{}, &postalcode.ImportRun{})
 	require.NoError(t, err)
 
 	repo := repository.New(db)
@@ -55,6 +57,24 @@ func seedGinTestData(t *testing.T, handler *GinHandler) {
 	for i := range lands {
 		require.NoError(t, handler.service.UpsertLand(&lands[i]))
 	}
+	// Seed building data
+	sub := 43
+	buildings := []postalcode.PostalCodeBuilding{
+		{BuildingManagementNumber: "1165010700100200043000001", ZipCode: "06506", SidoName: "서울특별시", SigunguName: "서초구", EupmyeondongName: "반포동",
+			JibunMain: 20, JibunSub: &sub, RoadName: "반포대로", BuildingMain: 275, BuildingName: "래미안퍼스티지", IsApartment: true},
+	}
+	require.NoError(t, handler.service.BatchUpsertBuilding(buildings))
+
+	// Seed PO box / bulk delivery data
+	end := 200
+	poBoxes := []postalcode.PostalCodePOBox{
+		{ZipCode: "03154", SidoName: "서울특별시", SigunguName: "종로구", EupmyeondongName: "세종로", POBoxName: "광화문우체국사서함", StartNumberMain: 1, EndNumberMain: &end},
+	}
+	require.NoError(t, handler.service.BatchUpsertPOBox(poBoxes))
+	deliveries := []postalcode.PostalCodeBulkDelivery{
+		{ZipCode: "03171", SidoName: "서울특별시", SigunguName: "종로구", RoadName: "세종대로", BuildingMain: 209, DeliveryName: "정부서울청사"},
+	}
+	require.NoError(t, handler.service.BatchUpsertBulkDelivery(deliveries))
 }
 
 // ============================================================
@@ -206,6 +226,32 @@ func TestGinHandler_Search_MultipleParams(t *testing.T) {
 	assert.Equal(t, float64(2), resp["total"].(float64))
 }
 
+func TestGinHandler_Search_Query(t *testing.T) {
+	handler, router := setupTestGinHandler(t)
+	seedGinTestData(t, handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/postal-codes/road/search?q="+url.QueryEscape("강북 삼양로2"), nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.True(t, resp["success"].(bool))
+	assert.Equal(t, float64(1), resp["total"].(float64))
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/postal-codes/land/search?q="+url.QueryEscape("강릉 리"), nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, float64(2), resp["total"].(float64))
+}
+
 func TestGinHandler_Search_NoResults(t *testing.T) {
 	handler, router := setupTestGinHandler(t)
 	seedGinTestData(t, handler)
@@ -223,6 +269,37 @@ func TestGinHandler_Search_NoResults(t *testing.T) {
 	assert.Equal(t, float64(0), resp["total"].(float64))
 }
 
+func TestGinHandler_RoadCoverage(t *testing.T) {
+	handler, router := setupTestGinHandler(t)
+	seedGinTestData(t, handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/postal-codes/road/coverage?road_name=삼양로1", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp CoverageResponse
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Data.Roads, 1)
+	assert.Equal(t, "삼양로1", resp.Data.Roads[0].RoadName)
+	assert.Equal(t, []string{"01000"}, resp.Data.Roads[0].ZipCodes)
+	assert.False(t, resp.Data.Roads[0].HasIssues())
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/postal-codes/road/coverage", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/postal-codes/road/coverage?road_name=없는로", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
 // ============================================================
 // Land Address Gin Handler Tests
 // ============================================================
@@ -433,3 +510,114 @@ func TestGinHandler_InvalidPaginationParams(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, resp["success"].(bool))
 }
+
+func TestGinHandler_DatasetVersionHeader(t *testing.T) {
+	handler, router := setupTestGinHandler(t)
+	seedGinTestData(t, handler)
+
+	run := &postalcode.ImportRun{DataType: "land", Mode: "replace", FileSHA256: "2c26b46b68ffc68f"}
+	require.NoError(t, handler.service.StartImportRun(run))
+	require.NoError(t, handler.service.FinishImportRun(run, nil))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/postal-codes/land/zipcode/25627", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	// 배포 기준일이 없으면 import 완료일 사용
+	assert.Equal(t, run.FinishedAt.Format("2006-01-02")+"+2c26b46b", w.Header().Get(DatasetVersionHeader))
+}
+
+// ============================================================
+// Building Gin Handler Tests
+// ============================================================
+
+func TestGinHandler_SearchBuildings(t *testing.T) {
+	handler, router := setupTestGinHandler(t)
+	seedGinTestData(t, handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/postal-codes/building/search?building_name=퍼스티지&sigungu_name=서초", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp SearchResponseBuilding
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "06506", resp.Data[0].ZipCode)
+	assert.Equal(t, "서울특별시 서초구 반포대로 275 (반포동, 래미안퍼스티지)", resp.Data[0].RoadAddress)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/postal-codes/building/search", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// ============================================================
+// PO Box / Bulk Delivery Gin Handler Tests
+// ============================================================
+
+func TestGinHandler_POBoxAndBulkDelivery(t *testing.T) {
+	handler, router := setupTestGinHandler(t)
+	seedGinTestData(t, handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/postal-codes/pobox/search?sigungu_name=종로", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var poResp SearchResponsePOBox
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &poResp))
+	require.Len(t, poResp.Data, 1)
+	assert.Equal(t, "광화문우체국사서함", poResp.Data[0].POBoxName)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/postal-codes/bulk/zipcode/03171", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var bulkResp SearchResponseBulkDelivery
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &bulkResp))
+	require.Len(t, bulkResp.Data, 1)
+	assert.Equal(t, "정부서울청사", bulkResp.Data[0].DeliveryName)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/postal-codes/bulk/zipcode/03154", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGinHandler_CheckZipCode(t *testing.T) {
+	handler, router := setupTestGinHandler(t)
+	seedGinTestData(t, handler)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/postal-codes/zipcode/03154", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp ZipCodeCheckResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.True(t, resp.Data.Exists)
+	assert.Equal(t, postalcode.ZipCodeKindPOBox, resp.Data.Kind)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/postal-codes/zipcode/99999", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.False(t, resp.Data.Exists)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/postal-codes/zipcode/123", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -13,11 +13,12 @@ import (
 // Handler는 우편번호 REST API 핸들러입니다.
 type Handler struct {
 	service service.Service
+	version *datasetVersion
 }
 
 // New는 새로운 Handler를 생성합니다.
 func New(svc service.Service) *Handler {
-	return &Handler{service: svc}
+	return &Handler{service: svc, version: newDatasetVersion(svc)}
 }
 
 // Response는 API 응답 구조체입니다.
@@ -39,11 +40,26 @@ func (h *Handler) RegisterRoutes(mux *http.ServeMux, prefix string) {
 	mux.HandleFunc(prefix+"road/search", h.Search)
 	mux.HandleFunc(prefix+"road/zipcode/", h.GetByZipCode)
 	mux.HandleFunc(prefix+"road/prefix/", h.GetByZipPrefix)
+	mux.HandleFunc(prefix+"road/coverage", h.RoadCoverage)
 
 	// 지번주소 엔드포인트
 	mux.HandleFunc(prefix+"land/search", h.SearchLand)
 	mux.HandleFunc(prefix+"land/zipcode/", h.GetLandByZipCode)
 	mux.HandleFunc(prefix+"land/prefix/", h.GetLandByZipPrefix)
+
+	// 건물 엔드포인트
+	mux.HandleFunc(prefix+"building/search", h.SearchBuildings)
+
+	// 사서함 엔드포인트
+	mux.HandleFunc(prefix+"pobox/search", h.SearchPOBoxes)
+	mux.HandleFunc(prefix+"pobox/zipcode/", h.GetPOBoxByZipCode)
+
+	// 다량배달처 엔드포인트
+	mux.HandleFunc(prefix+"bulk/search", h.SearchBulkDeliveries)
+	mux.HandleFunc(prefix+"bulk/zipcode/", h.GetBulkDeliveryByZipCode)
+
+	// 우편번호 존재 여부 (도로명/지번, 사서함, 다량배달처 통합)
+	mux.HandleFunc(prefix+"zipcode/", h.CheckZipCode)
 }
 
 // Search 복합 조건으로 우편번호 검색
@@ -60,6 +76,7 @@ func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
 		SidoName:    r.URL.Query().Get("sido_name"),
 		SigunguName: r.URL.Query().Get("sigungu_name"),
 		RoadName:    r.URL.Query().Get("road_name"),
+		Query:       r.URL.Query().Get("q"),
 	}
 
 	if page := r.URL.Query().Get("page"); page != "" {
@@ -151,6 +168,7 @@ func (h *Handler) GetByZipPrefix(w http.ResponseWriter, r *http.Request) {
 // sendSuccess는 성공 응답을 보냅니다.
 func (h *Handler) sendSuccess(w http.ResponseWriter, data interface{}, total int64) {
 	w.Header().Set("Content-Type", "application/json")
+	h.setDatasetVersion(w)
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(Response{
 		Success: true,
@@ -162,6 +180,7 @@ func (h *Handler) sendSuccess(w http.ResponseWriter, data interface{}, total int
 // sendError는 에러 응답을 보냅니다.
 func (h *Handler) sendError(w http.ResponseWriter, statusCode int, message string) {
 	w.Header().Set("Content-Type", "application/json")
+	h.setDatasetVersion(w)
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(Response{
 		Success: false,
@@ -169,6 +188,13 @@ func (h *Handler) sendError(w http.ResponseWriter, statusCode int, message strin
 	})
 }
 
+// setDatasetVersion은 현재 데이터셋 버전을 응답 헤더에 설정합니다.
+func (h *Handler) setDatasetVersion(w http.ResponseWriter) {
+	if version := h.version.get(); version != "" {
+		w.Header().Set(DatasetVersionHeader, version)
+	}
+}
+
 // ============================================================
 // 지번주소 관련 핸들러
 // ============================================================
@@ -188,6 +214,7 @@ func (h *Handler) SearchLand(w http.ResponseWriter, r *http.Request) {
 		SigunguName:      r.URL.Query().Get("sigungu_name"),
 		EupmyeondongName: r.URL.Query().Get("eupmyeondong_name"),
 		RiName:           r.URL.Query().Get("ri_name"),
+		Query:            r.URL.Query().Get("q"),
 	}
 
 	if page := r.URL.Query().Get("page"); page != "" {
@@ -211,6 +238,39 @@ func (h *Handler) SearchLand(w http.ResponseWriter, r *http.Request) {
 	h.sendSuccess(w, results, total)
 }
 
+// RoadCoverage 도로의 건물번호 범위 겹침/빈 구간 분석
+func (h *Handler) RoadCoverage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.sendError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// 쿼리 파라미터 파싱 (전체 테이블 분석을 막기 위해 도로명 필수)
+	params := postalcode.CoverageParams{
+		SidoName:    r.URL.Query().Get("sido_name"),
+		SigunguName: r.URL.Query().Get("sigungu_name"),
+		RoadName:    r.URL.Query().Get("road_name"),
+	}
+	if params.RoadName == "" {
+		h.sendError(w, http.StatusBadRequest, "road_name is required")
+		return
+	}
+
+	// 분석 실행
+	report, err := h.service.AnalyzeRoadCoverage(params)
+	if err != nil {
+		h.sendError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if report.RoadCount == 0 {
+		h.sendError(w, http.StatusNotFound, "road not found")
+		return
+	}
+
+	h.sendSuccess(w, report, int64(report.RoadCount))
+}
+
 // GetLandByZipCode 우편번호로 지번주소 조회
 func (h *Handler) GetLandByZipCode(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -275,3 +335,197 @@ func (h *Handler) GetLandByZipPrefix(w http.ResponseWriter, r *http.Request) {
 
 	h.sendSuccess(w, results, total)
 }
+
+// ============================================================
+// 건물 관련 핸들러
+// ============================================================
+
+// SearchBuildings 건물명으로 우편번호, 도로명주소, 지번주소 검색
+func (h *Handler) SearchBuildings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.sendError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// 쿼리 파라미터 파싱 (건물명 필수)
+	params := postalcode.SearchParamsBuilding{
+		BuildingName: r.URL.Query().Get("building_name"),
+		ZipCode:      r.URL.Query().Get("zip_code"),
+		SidoName:     r.URL.Query().Get("sido_name"),
+		SigunguName:  r.URL.Query().Get("sigungu_name"),
+	}
+	if strings.TrimSpace(params.BuildingName) == "" {
+		h.sendError(w, http.StatusBadRequest, "building_name is required")
+		return
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if val, err := strconv.Atoi(page); err == nil {
+			params.Page = val
+		}
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			params.Limit = val
+		}
+	}
+
+	// 검색 실행
+	results, total, err := h.service.SearchBuildings(params)
+	if err != nil {
+		h.sendError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	h.sendSuccess(w, results, total)
+}
+
+// ============================================================
+// 사서함 / 다량배달처 관련 핸들러
+// ============================================================
+
+// SearchPOBoxes 복합 조건으로 사서함 검색
+func (h *Handler) SearchPOBoxes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.sendError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// 쿼리 파라미터 파싱
+	params := postalcode.SearchParamsPOBox{
+		ZipCode:     r.URL.Query().Get("zip_code"),
+		SidoName:    r.URL.Query().Get("sido_name"),
+		SigunguName: r.URL.Query().Get("sigungu_name"),
+		POBoxName:   r.URL.Query().Get("po_box_name"),
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if val, err := strconv.Atoi(page); err == nil {
+			params.Page = val
+		}
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			params.Limit = val
+		}
+	}
+
+	// 검색 실행
+	results, total, err := h.service.SearchPOBoxes(params)
+	if err != nil {
+		h.sendError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	h.sendSuccess(w, results, total)
+}
+
+// GetPOBoxByZipCode 우편번호로 사서함 조회
+func (h *Handler) GetPOBoxByZipCode(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.sendError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// URL에서 우편번호 추출 (마지막 경로 세그먼트)
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	zipCode := parts[len(parts)-1]
+
+	// 조회 실행
+	results, err := h.service.GetPOBoxByZipCode(zipCode)
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(results) == 0 {
+		h.sendError(w, http.StatusNotFound, "postal code not found")
+		return
+	}
+
+	h.sendSuccess(w, results, int64(len(results)))
+}
+
+// SearchBulkDeliveries 복합 조건으로 다량배달처 검색
+func (h *Handler) SearchBulkDeliveries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.sendError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// 쿼리 파라미터 파싱
+	params := postalcode.SearchParamsBulkDelivery{
+		ZipCode:      r.URL.Query().Get("zip_code"),
+		SidoName:     r.URL.Query().Get("sido_name"),
+		SigunguName:  r.URL.Query().Get("sigungu_name"),
+		DeliveryName: r.URL.Query().Get("delivery_name"),
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if val, err := strconv.Atoi(page); err == nil {
+			params.Page = val
+		}
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			params.Limit = val
+		}
+	}
+
+	// 검색 실행
+	results, total, err := h.service.SearchBulkDeliveries(params)
+	if err != nil {
+		h.sendError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	h.sendSuccess(w, results, total)
+}
+
+// GetBulkDeliveryByZipCode 우편번호로 다량배달처 조회
+func (h *Handler) GetBulkDeliveryByZipCode(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.sendError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// URL에서 우편번호 추출 (마지막 경로 세그먼트)
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	zipCode := parts[len(parts)-1]
+
+	// 조회 실행
+	results, err := h.service.GetBulkDeliveryByZipCode(zipCode)
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(results) == 0 {
+		h.sendError(w, http.StatusNotFound, "postal code not found")
+		return
+	}
+
+	h.sendSuccess(w, results, int64(len(results)))
+}
+
+// CheckZipCode 우편번호 존재 여부와 종류(일반 배달구역, 사서함, 다량배달처) 확인
+// 존재하지 않는 우편번호도 200 OK와 exists=false로 응답합니다.
+func (h *Handler) CheckZipCode(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.sendError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	// URL에서 우편번호 추출 (마지막 경로 세그먼트)
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	zipCode := parts[len(parts)-1]
+
+	// 조회 실행
+	check, err := h.service.CheckZipCode(zipCode)
+	if err != nil {
+		h.sendError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.sendSuccess(w, check, 0)
+}
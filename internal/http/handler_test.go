@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	postalcode "github.com/oursportsnation/korean-postalcode"
 	"github.com/oursportsnation/korean-postalcode/internal/repository"
@@ -19,7 +22,8 @@ func setupTestHandler(t *testing.T) *Handler {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{})
+	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.PostalCodeBuilding{},
+		&postalcode.PostalCodePOBox{}, &postalcode.PostalCodeBulkDelivery{}, &postalcode.ImportRun{})
 	require.NoError(t, err)
 
 	repo := repository.New(db)
@@ -46,6 +50,24 @@ func seedTestData(t *testing.T, handler *Handler) {
 	for i := range lands {
 		require.NoError(t, handler.service.UpsertLand(&lands[i]))
 	}
+	// Seed building data
+	sub := 43
+	buildings := []postalcode.PostalCodeBuilding{
+		{BuildingManagementNumber: "1165010700100200043000001", ZipCode: "06506", SidoName: "서울특별시", SigunguName: "서초구", EupmyeondongName: "반포동",
+			JibunMain: 20, JibunSub: &sub, RoadName: "반포대로", BuildingMain: 275, BuildingName: "래미안퍼스티지", IsApartment: true},
+	}
+	require.NoError(t, handler.service.BatchUpsertBuilding(buildings))
+
+	// Seed PO box / bulk delivery data
+	end := 200
+	poBoxes := []postalcode.PostalCodePOBox{
+		{ZipCode: "03154", SidoName: "서울특별시", SigunguName: "종로구", EupmyeondongName: "세종로", POBoxName: "광화문우체국사서함", StartNumberMain: 1, EndNumberMain: &end},
+	}
+	require.NoError(t, handler.service.BatchUpsertPOBox(poBoxes))
+	deliveries := []postalcode.PostalCodeBulkDelivery{
+		{ZipCode: "03171", SidoName: "서울특별시", SigunguName: "종로구", RoadName: "세종대로", BuildingMain: 209, DeliveryName: "정부서울청사"},
+	}
+	require.NoError(t, handler.service.BatchUpsertBulkDelivery(deliveries))
 }
 
 // ============================================================
@@ -222,6 +244,33 @@ func TestHandler_Search_MultipleParams(t *testing.T) {
 	assert.Equal(t, int64(2), resp.Total) // 2 Gangbuk addresses
 }
 
+func TestHandler_Search_Query(t *testing.T) {
+	handler := setupTestHandler(t)
+	seedTestData(t, handler)
+
+	req := httptest.NewRequest("GET", "/road/search?q="+url.QueryEscape("서울 테헤란"), nil)
+	w := httptest.NewRecorder()
+
+	handler.Search(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp Response
+	err := json.NewDecoder(w.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(0), resp.Total) // 시도명은 통합 검색 대상이 아님
+
+	req = httptest.NewRequest("GET", "/land/search?q="+url.QueryEscape("강동면 심곡"), nil)
+	w = httptest.NewRecorder()
+
+	handler.SearchLand(w, req)
+
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Total)
+}
+
 func TestHandler_Search_NoResults(t *testing.T) {
 	handler := setupTestHandler(t)
 	seedTestData(t, handler)
@@ -256,6 +305,72 @@ func TestHandler_Search_MethodNotAllowed(t *testing.T) {
 	assert.False(t, resp.Success)
 }
 
+func TestHandler_RoadCoverage_Success(t *testing.T) {
+	handler := setupTestHandler(t)
+	seedTestData(t, handler)
+
+	// 같은 도로에서 서로 다른 우편번호의 홀수 범위가 51~99 구간에서 겹침
+	firstEnd, secondEnd := 99, 151
+	ranges := []postalcode.PostalCodeRoad{
+		{ZipCode: "06001", ZipPrefix: "060", SidoName: "서울특별시", SigunguName: "강남구", RoadName: "테헤란로", StartBuildingMain: 1, EndBuildingMain: &firstEnd, RangeType: postalcode.RangeTypeOdd},
+		{ZipCode: "06002", ZipPrefix: "060", SidoName: "서울특별시", SigunguName: "강남구", RoadName: "테헤란로", StartBuildingMain: 51, EndBuildingMain: &secondEnd, RangeType: postalcode.RangeTypeOdd},
+	}
+	for i := range ranges {
+		require.NoError(t, handler.service.Upsert(&ranges[i]))
+	}
+
+	req := httptest.NewRequest("GET", "/road/coverage?road_name=테헤란로", nil)
+	w := httptest.NewRecorder()
+
+	handler.RoadCoverage(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp struct {
+		Success bool                      `json:"success"`
+		Data    postalcode.CoverageReport `json:"data"`
+		Total   int64                     `json:"total"`
+	}
+	err := json.NewDecoder(w.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(1), resp.Total)
+	assert.Equal(t, 1, resp.Data.OverlapCount)
+	require.Len(t, resp.Data.Roads, 1)
+	require.Len(t, resp.Data.Roads[0].Overlaps, 1)
+	assert.Equal(t, [2]string{"06001", "06002"}, resp.Data.Roads[0].Overlaps[0].ZipCodes)
+	assert.Equal(t, 51, resp.Data.Roads[0].Overlaps[0].StartMain)
+	assert.Equal(t, 99, resp.Data.Roads[0].Overlaps[0].EndMain)
+}
+
+func TestHandler_RoadCoverage_Errors(t *testing.T) {
+	handler := setupTestHandler(t)
+	seedTestData(t, handler)
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+	}{
+		{"missing road name", "/road/coverage", http.StatusBadRequest},
+		{"partial road name", "/road/coverage?road_name=삼양", http.StatusNotFound},
+		{"unknown road", "/road/coverage?road_name=없는로", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handler.RoadCoverage(w, httptest.NewRequest("GET", tt.url, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+
+			var resp Response
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			assert.False(t, resp.Success)
+		})
+	}
+}
+
 // ============================================================
 // Land Address Handler Tests
 // ============================================================
@@ -369,6 +484,66 @@ func TestHandler_SearchLand_MethodNotAllowed(t *testing.T) {
 	assert.False(t, resp.Success)
 }
 
+// ============================================================
+// Building Handler Tests
+// ============================================================
+
+func TestHandler_SearchBuildings_Success(t *testing.T) {
+	handler := setupTestHandler(t)
+	seedTestData(t, handler)
+
+	// 공백이 있어도 건물명을 찾음
+	req := httptest.NewRequest("GET", "/building/search?building_name=래미안%20퍼스티지", nil)
+	w := httptest.NewRecorder()
+
+	handler.SearchBuildings(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp struct {
+		Success bool                         `json:"success"`
+		Data    []postalcode.BuildingAddress `json:"data"`
+		Total   int64                        `json:"total"`
+	}
+	err := json.NewDecoder(w.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "06506", resp.Data[0].ZipCode)
+	assert.Equal(t, "서울특별시 서초구 반포대로 275 (반포동, 래미안퍼스티지)", resp.Data[0].RoadAddress)
+	assert.Equal(t, "서울특별시 서초구 반포동 20-43 래미안퍼스티지", resp.Data[0].JibunAddress)
+}
+
+func TestHandler_SearchBuildings_Errors(t *testing.T) {
+	handler := setupTestHandler(t)
+	seedTestData(t, handler)
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		status int
+	}{
+		{"missing building name", "GET", "/building/search", http.StatusBadRequest},
+		{"blank building name", "GET", "/building/search?building_name=%20", http.StatusBadRequest},
+		{"method not allowed", "POST", "/building/search?building_name=래미안", http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handler.SearchBuildings(w, httptest.NewRequest(tt.method, tt.url, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+
+			var resp Response
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			assert.False(t, resp.Success)
+		})
+	}
+}
+
 // ============================================================
 // Route Registration Tests
 // ============================================================
@@ -469,3 +644,172 @@ func TestHandler_ResponseFormat_Error(t *testing.T) {
 	// Verify content type
 	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
 }
+
+func TestHandler_DatasetVersionHeader(t *testing.T) {
+	// import 이력이 없으면 헤더 없음
+	handler := setupTestHandler(t)
+	seedTestData(t, handler)
+
+	w := httptest.NewRecorder()
+	handler.GetByZipCode(w, httptest.NewRequest("GET", "/road/zipcode/01000", nil))
+	assert.Empty(t, w.Header().Get(DatasetVersionHeader))
+
+	// 성공한 import가 있으면 헤더에 데이터셋 버전 포함
+	handler = setupTestHandler(t)
+	seedTestData(t, handler)
+	run := &postalcode.ImportRun{DataType: "road", Mode: "replace", FileSHA256: "9f86d081884c7d65", DatasetDate: "2024-05-01"}
+	require.NoError(t, handler.service.StartImportRun(run))
+	require.NoError(t, handler.service.FinishImportRun(run, nil))
+
+	w = httptest.NewRecorder()
+	handler.GetByZipCode(w, httptest.NewRequest("GET", "/road/zipcode/01000", nil))
+	assert.Equal(t, "2024-05-01+9f86d081", w.Header().Get(DatasetVersionHeader))
+
+	// 에러 응답에도 포함
+	w = httptest.NewRecorder()
+	handler.GetByZipCode(w, httptest.NewRequest("GET", "/road/zipcode/99999", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "2024-05-01+9f86d081", w.Header().Get(DatasetVersionHeader))
+}
+
+// slowDatasetService는 gate가 닫힐 때까지 GetCurrentDataset을 지연시키고 호출 횟수를 셉니다.
+type slowDatasetService struct {
+	service.Service
+	gate  chan struct{}
+	calls int32
+}
+
+func (s *slowDatasetService) GetCurrentDataset() (*postalcode.ImportRun, error) {
+	atomic.AddInt32(&s.calls, 1)
+	<-s.gate
+	return s.Service.GetCurrentDataset()
+}
+
+func TestDatasetVersion_RefreshDoesNotBlock(t *testing.T) {
+	handler := setupTestHandler(t)
+	run := &postalcode.ImportRun{DataType: "road", Mode: "replace", FileSHA256: "9f86d081884c7d65", DatasetDate: "2024-05-01"}
+	require.NoError(t, handler.service.StartImportRun(run))
+	require.NoError(t, handler.service.FinishImportRun(run, nil))
+
+	svc := &slowDatasetService{Service: handler.service, gate: make(chan struct{})}
+	close(svc.gate)
+	version := newDatasetVersion(svc)
+	require.Equal(t, "2024-05-01+9f86d081", version.get())
+
+	// TTL 만료 후 첫 요청만 조회하고, 조회가 끝나기 전의 요청은 이전 버전을 바로 받음
+	svc.gate = make(chan struct{})
+	version.mu.Lock()
+	version.expiresAt = time.Time{}
+	version.mu.Unlock()
+
+	refreshed := make(chan string)
+	go func() { refreshed <- version.get() }()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&svc.calls) == 2 }, time.Second, time.Millisecond)
+
+	served := make(chan string)
+	go func() {
+		for i := 0; i < 10; i++ {
+			served <- version.get()
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		select {
+		case got := <-served:
+			assert.Equal(t, "2024-05-01+9f86d081", got)
+		case <-time.After(time.Second):
+			t.Fatal("get blocked while another request was refreshing")
+		}
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&svc.calls))
+
+	close(svc.gate)
+	assert.Equal(t, "2024-05-01+9f86d081", <-refreshed)
+	assert.Equal(t, "2024-05-01+9f86d081", version.get())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&svc.calls))
+}
+
+// ============================================================
+// PO Box / Bulk Delivery Handler Tests
+// ============================================================
+
+func TestHandler_POBoxAndBulkDelivery(t *testing.T) {
+	handler := setupTestHandler(t)
+	seedTestData(t, handler)
+
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux, "/api/v1/postal-codes")
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		total  int64
+	}{
+		{"pobox by zipcode", "/api/v1/postal-codes/pobox/zipcode/03154", http.StatusOK, 1},
+		{"pobox not found", "/api/v1/postal-codes/pobox/zipcode/03171", http.StatusNotFound, 0},
+		{"pobox search", "/api/v1/postal-codes/pobox/search?po_box_name=광화문", http.StatusOK, 1},
+		{"bulk by zipcode", "/api/v1/postal-codes/bulk/zipcode/03171", http.StatusOK, 1},
+		{"bulk invalid zipcode", "/api/v1/postal-codes/bulk/zipcode/031", http.StatusBadRequest, 0},
+		{"bulk search ignores spaces", "/api/v1/postal-codes/bulk/search?delivery_name=정부%20서울", http.StatusOK, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest("GET", tt.url, nil))
+
+			assert.Equal(t, tt.status, w.Code)
+
+			var resp Response
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			assert.Equal(t, tt.status == http.StatusOK, resp.Success)
+			assert.Equal(t, tt.total, resp.Total)
+		})
+	}
+}
+
+func TestHandler_CheckZipCode(t *testing.T) {
+	handler := setupTestHandler(t)
+	seedTestData(t, handler)
+
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux, "/api/v1/postal-codes")
+
+	tests := []struct {
+		zipCode string
+		exists  bool
+		kind    string
+		name    string
+	}{
+		{"01000", true, postalcode.ZipCodeKindAddress, ""},
+		{"25627", true, postalcode.ZipCodeKindAddress, ""},
+		{"03154", true, postalcode.ZipCodeKindPOBox, "광화문우체국사서함"},
+		{"03171", true, postalcode.ZipCodeKindBulk, "정부서울청사"},
+		{"99999", false, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zipCode, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/postal-codes/zipcode/"+tt.zipCode, nil))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var resp struct {
+				Success bool                    `json:"success"`
+				Data    postalcode.ZipCodeCheck `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			assert.True(t, resp.Success)
+			assert.Equal(t, tt.zipCode, resp.Data.ZipCode)
+			assert.Equal(t, tt.exists, resp.Data.Exists)
+			assert.Equal(t, tt.kind, resp.Data.Kind)
+			assert.Equal(t, tt.name, resp.Data.Name)
+		})
+	}
+
+	// 잘못된 형식은 400
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/postal-codes/zipcode/1234", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"sync"
+	"time"
+
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+)
+
+// DatasetVersionHeader는 현재 서비스 중인 데이터셋 버전을 알려주는 응답 헤더입니다.
+const DatasetVersionHeader = "X-Dataset-Version"
+
+// datasetVersionTTL은 데이터셋 버전 조회 결과를 재사용하는 시간입니다.
+// 매 요청마다 import_runs 테이블을 조회하지 않도록 짧게 캐시합니다.
+const datasetVersionTTL = 30 * time.Second
+
+// datasetVersion은 마지막으로 성공한 import의 데이터셋 버전을 TTL 동안 캐시합니다.
+// TTL이 지나면 한 요청만 DB를 조회하고, 그동안 다른 요청은 이전 버전을 그대로 사용합니다.
+type datasetVersion struct {
+	service service.Service
+
+	mu         sync.Mutex
+	version    string
+	loaded     bool // 한 번이라도 조회를 마쳤는지 여부
+	expiresAt  time.Time
+	refreshing chan struct{} // 조회 중이면 조회가 끝날 때 닫히는 채널
+}
+
+// newDatasetVersion은 새로운 datasetVersion을 생성합니다.
+func newDatasetVersion(svc service.Service) *datasetVersion {
+	return &datasetVersion{service: svc}
+}
+
+// get은 현재 데이터셋 버전을 반환합니다.
+// import 이력이 없거나 조회에 실패하면 빈 문자열을 반환합니다.
+// 조회는 잠금 밖에서 하므로 느린 DB가 다른 요청을 막지 않으며, 첫 조회가 끝나기 전의 요청만 그 결과를 기다립니다.
+func (v *datasetVersion) get() string {
+	v.mu.Lock()
+	if time.Now().Before(v.expiresAt) {
+		defer v.mu.Unlock()
+		return v.version
+	}
+
+	if done := v.refreshing; done != nil {
+		version, loaded := v.version, v.loaded
+		v.mu.Unlock()
+		if loaded {
+			return version
+		}
+
+		<-done
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		return v.version
+	}
+
+	v.refreshing = make(chan struct{})
+	v.mu.Unlock()
+	return v.refresh()
+}
+
+// refresh는 DB에서 데이터셋 버전을 조회하여 저장하고, 기다리는 요청을 깨웁니다.
+// 조회 중 panic이 나도 기다리는 요청이 멈추지 않도록 defer로 정리합니다.
+func (v *datasetVersion) refresh() (version string) {
+	defer func() {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		v.version, v.loaded = version, true
+		v.expiresAt = time.Now().Add(datasetVersionTTL)
+		close(v.refreshing)
+		v.refreshing = nil
+	}()
+
+	if run, err := v.service.GetCurrentDataset(); err == nil {
+		version = run.DatasetVersion()
+	}
+	return version
+}
@@ -0,0 +1,332 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// roadColumns는 도로명주소 범위 파일의 표준 컬럼 순서입니다. (우체국 범위주소 DB 기준)
+var roadColumns = []string{
+	"zip_code", "sido_name", "sido_name_en", "sigungu_name", "sigungu_name_en",
+	"eupmyeon_name", "eupmyeon_name_en", "road_name", "road_name_en", "is_underground",
+	"start_building_main", "start_building_sub", "end_building_main", "end_building_sub", "range_type",
+}
+
+// landColumns는 지번주소 범위 파일의 표준 컬럼 순서입니다. (우체국 범위주소 DB 기준)
+var landColumns = []string{
+	"zip_code", "sido_name", "sido_name_en", "sigungu_name", "sigungu_name_en",
+	"eupmyeondong_name", "eupmyeondong_name_en", "ri_name", "is_mountain", "haengjeongdong_name",
+	"start_jibun_main", "start_jibun_sub", "end_jibun_main", "end_jibun_sub",
+}
+
+// buildingColumns는 건물 파일의 표준 컬럼 순서입니다. (도로명주소 건물 DB 기준)
+var buildingColumns = []string{
+	"building_management_number", "zip_code", "legal_dong_code", "sido_name", "sigungu_name",
+	"eupmyeondong_name", "ri_name", "haengjeongdong_name", "is_mountain", "jibun_main", "jibun_sub",
+	"road_code", "road_name", "is_underground", "building_main", "building_sub",
+	"building_name", "building_ledger_name", "detail_building_name", "is_apartment",
+}
+
+// poBoxColumns는 사서함 파일의 표준 컬럼 순서입니다. (우체국 사서함 우편번호 DB 기준)
+var poBoxColumns = []string{
+	"zip_code", "sido_name", "sigungu_name", "eupmyeondong_name", "ri_name", "po_box_name",
+	"start_po_box_main", "start_po_box_sub", "end_po_box_main", "end_po_box_sub",
+}
+
+// bulkColumns는 다량배달처 파일의 표준 컬럼 순서입니다. (우체국 다량배달처 우편번호 DB 기준)
+var bulkColumns = []string{
+	"zip_code", "sido_name", "sigungu_name", "eupmyeondong_name", "road_name", "is_underground",
+	"building_main", "building_sub", "delivery_name",
+}
+
+// buildingDBHeader는 헤더가 없는 도로명주소 건물 DB(build_*.txt) 파일의 필드 순서입니다. (31개 필드)
+// 인식하지 않는 필드(이동사유코드 등)도 거부 라인 파일의 헤더로 쓰기 위해 이름을 유지합니다.
+var buildingDBHeader = []string{
+	"법정동코드", "시도명", "시군구명", "법정읍면동명", "법정리명",
+	"산여부", "지번본번", "지번부번", "도로명코드", "도로명",
+	"지하여부", "건물본번", "건물부번", "건축물대장건물명", "상세건물명",
+	"건물관리번호", "읍면동일련번호", "행정동코드", "행정동명", "우편번호",
+	"우편일련번호", "다량배달처명", "이동사유코드", "변경일자", "변경전도로명주소",
+	"시군구용건물명", "공동주택여부", "기초구역번호", "상세주소여부", "비고1",
+	"비고2",
+}
+
+// requiredRoadColumns는 도로명주소 파일에 반드시 있어야 하는 컬럼입니다. (유니크 키 구성 컬럼)
+var requiredRoadColumns = []string{"zip_code", "sido_name", "sigungu_name", "road_name", "start_building_main"}
+
+// requiredLandColumns는 지번주소 파일에 반드시 있어야 하는 컬럼입니다. (유니크 키 구성 컬럼)
+var requiredLandColumns = []string{"zip_code", "sido_name", "sigungu_name", "eupmyeondong_name", "start_jibun_main"}
+
+// requiredBuildingColumns는 건물 파일에 반드시 있어야 하는 컬럼입니다. (유니크 키와 주소 구성 컬럼)
+var requiredBuildingColumns = []string{"building_management_number", "zip_code", "sido_name", "road_name", "building_main"}
+
+// requiredPOBoxColumns는 사서함 파일에 반드시 있어야 하는 컬럼입니다. (유니크 키 구성 컬럼)
+var requiredPOBoxColumns = []string{"zip_code", "sido_name", "po_box_name", "start_po_box_main"}
+
+// requiredBulkColumns는 다량배달처 파일에 반드시 있어야 하는 컬럼입니다. (유니크 키 구성 컬럼)
+var requiredBulkColumns = []string{"zip_code", "sido_name", "delivery_name"}
+
+// columnAliases는 컬럼별로 인식하는 헤더 이름입니다.
+// 우체국 참고자료 표기, 기존 배포 파일 표기, 영문(snake_case) 표기를 모두 포함합니다.
+// 컬럼 이름 자체(예: "zip_code")는 항상 인식하므로 별도로 적지 않습니다.
+var columnAliases = map[string][]string{
+	// 공통
+	"zip_code":        {"구역번호", "우편번호", "zipcode", "zip", "postal_code", "zip_no"},
+	"sido_name":       {"시도", "시도명", "sido"},
+	"sido_name_en":    {"시도영문", "시도명(영문)", "시도영문명", "sido_en"},
+	"sigungu_name":    {"시군구", "시군구명", "sigungu"},
+	"sigungu_name_en": {"시군구영문", "시군구명(영문)", "시군구영문명", "sigungu_en"},
+
+	// 도로명주소
+	"eupmyeon_name":       {"읍면", "읍면명", "eupmyeon"},
+	"eupmyeon_name_en":    {"읍면영문", "읍면명(영문)", "읍면영문명", "eupmyeon_en"},
+	"road_name":           {"도로명", "road"},
+	"road_name_en":        {"도로명영문", "도로명(영문)", "road_en"},
+	"is_underground":      {"지하여부", "underground"},
+	"start_building_main": {"시작건물번호(주)", "건물번호본번(시작)", "시작건물본번"},
+	"start_building_sub":  {"시작건물번호(부)", "건물번호부번(시작)", "시작건물부번"},
+	"end_building_main":   {"끝건물번호(주)", "건물번호본번(종료)", "끝건물본번"},
+	"end_building_sub":    {"끝건물번호(부)", "건물번호부번(종료)", "끝건물부번"},
+	"range_type":          {"범위종류"},
+
+	// 지번주소
+	"eupmyeondong_name":    {"읍면동", "읍면동명", "법정읍면동명", "법정동명", "eupmyeondong"},
+	"eupmyeondong_name_en": {"읍면동영문", "읍면동명(영문)", "읍면동영문명", "eupmyeondong_en"},
+	"ri_name":              {"리명", "리", "법정리명", "ri"},
+	"is_mountain":          {"산여부", "mountain"},
+	"haengjeongdong_name":  {"행정동", "행정동명", "haengjeongdong"},
+	"start_jibun_main":     {"시작주번지", "지번본번(시작)"},
+	"start_jibun_sub":      {"시작부번지", "지번부번(시작)"},
+	"end_jibun_main":       {"끝주번지", "지번본번(종료)"},
+	"end_jibun_sub":        {"끝부번지", "지번부번(종료)"},
+
+	// 건물
+	"building_management_number": {"건물관리번호", "bd_mgt_sn"},
+	"legal_dong_code":            {"법정동코드", "bjd_cd"},
+	"jibun_main":                 {"지번본번"},
+	"jibun_sub":                  {"지번부번"},
+	"road_code":                  {"도로명코드", "rn_mgt_sn"},
+	"building_main":              {"건물본번", "건물번호본번"},
+	"building_sub":               {"건물부번", "건물번호부번"},
+	"building_name":              {"시군구용건물명", "건물명"},
+	"building_ledger_name":       {"건축물대장건물명"},
+	"detail_building_name":       {"상세건물명"},
+	"is_apartment":               {"공동주택여부", "apartment"},
+
+	// 사서함
+	"po_box_name":       {"사서함명", "사서함", "pobox_name", "pobox"},
+	"start_po_box_main": {"시작사서함번호(주)", "사서함번호(시작)", "시작번호(주)"},
+	"start_po_box_sub":  {"시작사서함번호(부)", "시작번호(부)"},
+	"end_po_box_main":   {"끝사서함번호(주)", "사서함번호(종료)", "끝번호(주)"},
+	"end_po_box_sub":    {"끝사서함번호(부)", "끝번호(부)"},
+
+	// 다량배달처
+	"delivery_name": {"다량배달처명", "다량배달처", "bulk_name"},
+}
+
+// headerIndex는 정규화된 헤더 이름 → 컬럼 이름 인덱스입니다.
+var headerIndex = buildHeaderIndex()
+
+// buildHeaderIndex는 columnAliases로 headerIndex를 생성합니다.
+func buildHeaderIndex() map[string]string {
+	index := make(map[string]string)
+	for column, aliases := range columnAliases {
+		index[normalizeHeader(column)] = column
+		for _, alias := range aliases {
+			index[normalizeHeader(alias)] = column
+		}
+	}
+	return index
+}
+
+// normalizeHeader는 헤더 비교를 위해 공백, 밑줄, 하이픈을 제거하고 소문자로 변환합니다.
+func normalizeHeader(name string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "", "\t", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// isKnownColumn은 column이 도로명/지번/건물/사서함/다량배달처 파일의 컬럼 이름인지 확인합니다.
+func isKnownColumn(column string) bool {
+	_, ok := columnAliases[column]
+	return ok
+}
+
+// ============================================================
+// 컬럼 매핑 파일
+// ============================================================
+
+// ColumnMapping은 사용자 정의 export 파일을 위한 헤더 → 컬럼 매핑입니다.
+//
+// 매핑 파일 예 (JSON):
+//
+//	{
+//	  "type": "road",
+//	  "columns": {
+//	    "ZIP": "zip_code",
+//	    "SIDO": "sido_name",
+//	    "ROAD": "road_name"
+//	  }
+//	}
+//
+// 매핑에 없는 헤더는 기본 헤더 이름 규칙으로 인식하며, 인식할 수 없는 헤더는 무시합니다.
+type ColumnMapping struct {
+	// DataType은 파일 타입("road", "land", "building", "pobox" 또는 "bulk")입니다. 비어 있으면 헤더로 감지합니다.
+	DataType string `json:"type,omitempty"`
+
+	// Columns는 헤더 이름 → 컬럼 이름(예: "zip_code") 매핑입니다.
+	Columns map[string]string `json:"columns"`
+}
+
+// LoadColumnMapping은 JSON 매핑 파일을 읽습니다.
+func LoadColumnMapping(path string) (*ColumnMapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read column mapping: %w", err)
+	}
+
+	var mapping ColumnMapping
+	if err := json.Unmarshal(data, &mapping); err != nil {
+		return nil, fmt.Errorf("failed to parse column mapping: %w", err)
+	}
+	if err := mapping.validate(); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+// validate는 매핑의 타입과 컬럼 이름을 검증합니다.
+func (m *ColumnMapping) validate() error {
+	switch m.DataType {
+	case "", DataTypeRoad, DataTypeLand, DataTypeBuilding, DataTypePOBox, DataTypeBulk:
+	default:
+		return fmt.Errorf("%w: invalid column mapping type %q", postalcode.ErrInvalidFileFormat, m.DataType)
+	}
+	for header, column := range m.Columns {
+		if !isKnownColumn(column) {
+			return fmt.Errorf("%w: unknown column %q for header %q", postalcode.ErrInvalidFileFormat, column, header)
+		}
+	}
+	return nil
+}
+
+// ============================================================
+// 레이아웃 감지
+// ============================================================
+
+// layout은 헤더로 결정된 파일 구조입니다.
+type layout struct {
+	header   []string
+	dataType string
+	columns  map[string]int // 컬럼 이름 → 필드 인덱스
+	width    int            // 데이터 라인에 필요한 최소 필드 수
+}
+
+// resolveLayout은 헤더를 컬럼 이름에 매핑하고 도로명/지번/건물/사서함/다량배달처 레이아웃을 결정합니다.
+//
+// want가 비어 있지 않으면 감지된 타입이 want와 같아야 합니다.
+// 필수 컬럼이 없거나 타입을 판별할 수 없으면 postalcode.ErrInvalidFileFormat을 반환합니다.
+func resolveLayout(header []string, want string, mapping *ColumnMapping) (*layout, error) {
+	custom := make(map[string]string)
+	if mapping != nil {
+		for name, column := range mapping.Columns {
+			custom[normalizeHeader(name)] = column
+		}
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		key := normalizeHeader(name)
+		column, ok := custom[key]
+		if !ok {
+			column, ok = headerIndex[key]
+		}
+		if !ok {
+			continue // 알 수 없는 컬럼은 무시
+		}
+		if prev, dup := columns[column]; dup {
+			return nil, fmt.Errorf("%w: 컬럼 %q가 헤더에 중복됩니다 (%d, %d번째)", postalcode.ErrInvalidFileFormat, column, prev+1, i+1)
+		}
+		columns[column] = i
+	}
+
+	dataType := want
+	if mapping != nil && mapping.DataType != "" {
+		dataType = mapping.DataType
+	}
+	if dataType == "" {
+		dataType = detectLayoutType(columns)
+	}
+
+	var required []string
+	switch dataType {
+	case DataTypeRoad:
+		required = requiredRoadColumns
+	case DataTypeLand:
+		required = requiredLandColumns
+	case DataTypeBuilding:
+		required = requiredBuildingColumns
+	case DataTypePOBox:
+		required = requiredPOBoxColumns
+	case DataTypeBulk:
+		required = requiredBulkColumns
+	default:
+		return nil, fmt.Errorf("%w: 헤더로 도로명/지번/건물/사서함/다량배달처 파일을 구분할 수 없습니다 (%s)", postalcode.ErrInvalidFileFormat, strings.Join(header, "|"))
+	}
+
+	var missing []string
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s 파일에 필수 컬럼이 없습니다: %s", postalcode.ErrInvalidFileFormat, dataType, strings.Join(missing, ", "))
+	}
+
+	width := 0
+	for _, idx := range columns {
+		if idx+1 > width {
+			width = idx + 1
+		}
+	}
+
+	return &layout{header: header, dataType: dataType, columns: columns, width: width}, nil
+}
+
+// detectLayoutType은 매핑된 컬럼으로 도로명/지번/건물/사서함/다량배달처 타입을 판별합니다.
+// 건물 파일은 도로명과 지번 컬럼(다량배달처명 포함)을 모두 가지므로 건물관리번호로 먼저 판별하고,
+// 사서함과 다량배달처는 각각 사서함명, 다량배달처명 컬럼으로 판별합니다.
+// 그 밖에 판별할 수 없거나 도로명/지번 컬럼이 섞여 있으면 빈 문자열을 반환합니다.
+func detectLayoutType(columns map[string]int) string {
+	has := func(names ...string) bool {
+		for _, name := range names {
+			if _, ok := columns[name]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case has("building_management_number"):
+		return DataTypeBuilding
+	case has("po_box_name"):
+		return DataTypePOBox
+	case has("delivery_name"):
+		return DataTypeBulk
+	}
+
+	road := has("road_name", "start_building_main", "is_underground")
+	land := has("eupmyeondong_name", "start_jibun_main", "is_mountain")
+	switch {
+	case road && !land:
+		return DataTypeRoad
+	case land && !road:
+		return DataTypeLand
+	default:
+		return ""
+	}
+}
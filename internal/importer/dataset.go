@@ -0,0 +1,207 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// datasetFile은 데이터셋에 포함된 파일 하나의 파싱 결과입니다.
+type datasetFile struct {
+	src      source
+	dataType string
+	loadedFile
+}
+
+// info는 파일을 읽으며 얻은 인코딩/체크섬 정보입니다.
+func (f *datasetFile) info() sourceInfo {
+	return f.sourceInfo()
+}
+
+// loadDataset은 path의 모든 파일을 헤더로 분류하고 파싱/검증합니다.
+// 하나라도 열거나 분류할 수 없는 파일이 있으면 즉시 실패합니다.
+func (imp *importer) loadDataset(path string, tr *progressTracker) ([]*datasetFile, error) {
+	sources, closer, err := listSources(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	files := make([]*datasetFile, 0, len(sources))
+	for _, src := range sources {
+		dataType, err := imp.detectDataType(src)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", src.name, err)
+		}
+
+		file := &datasetFile{src: src, dataType: dataType}
+		switch dataType {
+		case DataTypeRoad:
+			file.loadedFile, err = loadRecords(imp, imp.roadRecords(), src, tr)
+		case DataTypeBuilding:
+			file.loadedFile, err = loadRecords(imp, imp.buildingRecords(), src, tr)
+		case DataTypePOBox:
+			file.loadedFile, err = loadRecords(imp, imp.poBoxRecords(), src, tr)
+		case DataTypeBulk:
+			file.loadedFile, err = loadRecords(imp, imp.bulkDeliveryRecords(), src, tr)
+		default:
+			file.loadedFile, err = loadRecords(imp, imp.landRecords(), src, tr)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", src.name, err)
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// datasetChecksum은 데이터셋 파일들의 이름과 SHA-256으로 데이터셋 전체의 SHA-256을 계산합니다.
+// 파일 목록은 이름순으로 정렬되어 있으므로 같은 데이터셋은 항상 같은 값을 가집니다.
+func datasetChecksum(files []*datasetFile) string {
+	hash := sha256.New()
+	for _, file := range files {
+		fmt.Fprintf(hash, "%s %s\n", file.info().checksum, filepath.Base(file.src.name))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ImportDataset은 ZIP 아카이브, 디렉토리 또는 glob 패턴의 모든 파일을 하나의 데이터셋으로 import합니다.
+//
+// 각 파일은 헤더로 도로명주소/지번주소/건물/사서함/다량배달처를 구분하며, 모든 파일의 파싱/검증이 끝난 뒤
+// 데이터셋에 포함된 타입의 테이블만 한 번씩 truncate하고 저장합니다.
+// 진행 상황은 데이터셋 전체 행 수 기준으로 보고합니다.
+func (imp *importer) ImportDataset(path string, batchSize int, progressFn postalcode.ProgressFunc) (result *postalcode.DatasetImportResult, err error) {
+	startTime := time.Now()
+
+	run := imp.beginRun(postalcode.ImportDataTypeDataset, postalcode.ImportModeReplace, path)
+	defer func() {
+		var summary *postalcode.ImportResult
+		if result != nil {
+			summary = &postalcode.ImportResult{TotalCount: result.RoadCount + result.LandCount + result.BuildingCount + result.POBoxCount + result.BulkCount, ErrorCount: result.ErrorCount}
+		}
+		imp.finishRun(run, summary, err)
+	}()
+
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	// 모든 파일 파싱 및 검증 (truncate 전에 수행하여 파일 오류 시 기존 데이터 보존)
+	tr := imp.newProgressTracker(progressFn)
+	files, err := imp.loadDataset(path, tr)
+	if err != nil {
+		return nil, fmt.Errorf("file parsing failed: %w", err)
+	}
+	run.FileSHA256 = datasetChecksum(files)
+
+	total := 0
+	hasType := make(map[string]bool)
+	for _, file := range files {
+		total += file.rowCount()
+		hasType[file.dataType] = true
+	}
+
+	// 데이터셋에 포함된 타입만 한 번씩 truncate
+	if len(hasType) > 0 {
+		tr.setPhase(postalcode.ImportPhaseTruncate)
+		imp.markTruncated(run)
+	}
+	for _, dataType := range []string{DataTypeRoad, DataTypeLand, DataTypeBuilding, DataTypePOBox, DataTypeBulk} {
+		if !hasType[dataType] {
+			continue
+		}
+		if err := imp.truncate(dataType); err != nil {
+			return nil, err
+		}
+	}
+
+	result = &postalcode.DatasetImportResult{
+		Files: make([]postalcode.FileImportResult, 0, len(files)),
+	}
+
+	tr.startWrite(total, 0)
+	processed := 0
+	for _, file := range files {
+		imp.emit(postalcode.Event{Type: postalcode.EventFileStarted, DataType: file.dataType, File: file.src.name, Total: file.rowCount()})
+		tr.setFile(file.dataType, file.src.name)
+
+		fileResult := postalcode.FileImportResult{
+			FileName: file.src.name,
+			DataType: file.dataType,
+			Encoding: string(file.info().encoding),
+		}
+
+		base := processed
+		onBatch := func(done, failed int) {
+			processed = base + done
+			tr.batchDone(processed, failed)
+		}
+
+		imp.emitRejects(file.dataType, file.src.name, file.rejected())
+		saved, rejects := file.save(imp, batchSize, onBatch)
+		switch file.dataType {
+		case DataTypeRoad:
+			result.RoadCount += saved
+		case DataTypeBuilding:
+			result.BuildingCount += saved
+		case DataTypePOBox:
+			result.POBoxCount += saved
+		case DataTypeBulk:
+			result.BulkCount += saved
+		default:
+			result.LandCount += saved
+		}
+		imp.quarantine(run, datasetRejectPath(imp.rejectFile, file.src.name), file.dataType, file.src.name, file.info().header, rejects)
+		fileResult.TotalCount = saved
+		fileResult.ErrorCount = len(rejects)
+
+		result.ErrorCount += fileResult.ErrorCount
+		result.Files = append(result.Files, fileResult)
+	}
+
+	tr.finish()
+	result.Duration = time.Since(startTime).String()
+	return result, nil
+}
+
+// ValidateDataset은 DB에 접근하지 않고 데이터셋의 모든 파일을 파싱/검증하여 파일별 리포트를 반환합니다.
+func (imp *importer) ValidateDataset(path string) ([]*postalcode.ValidationReport, error) {
+	sources, closer, err := listSources(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	reports := make([]*postalcode.ValidationReport, 0, len(sources))
+	for _, src := range sources {
+		dataType, err := imp.detectDataType(src)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", src.name, err)
+		}
+
+		var report *postalcode.ValidationReport
+		switch dataType {
+		case DataTypeRoad:
+			report, err = validateRecords(imp, imp.roadRecords(), src)
+		case DataTypeBuilding:
+			report, err = validateRecords(imp, imp.buildingRecords(), src)
+		case DataTypePOBox:
+			report, err = validateRecords(imp, imp.poBoxRecords(), src)
+		case DataTypeBulk:
+			report, err = validateRecords(imp, imp.bulkDeliveryRecords(), src)
+		default:
+			report, err = validateRecords(imp, imp.landRecords(), src)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", src.name, err)
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
@@ -0,0 +1,322 @@
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// maxChangesetSamples는 changeset에 기록할 샘플 키의 최대 개수입니다.
+const maxChangesetSamples = 10
+
+// diffType은 diff import를 지원하는 데이터 타입(도로명주소, 지번주소)의 레코드 타입과 현재 테이블 접근 방법입니다.
+type diffType[T any] struct {
+	records recordType[T]                                          // 파싱, 검증, upsert, 자연키
+	scan    func(batchSize int, fn func(existing []T) error) error // 현재 테이블 전체 순회
+	equal   func(a, b *T) bool                                     // 자연키 외의 데이터 컬럼 비교
+	id      func(record *T) uint                                   // 삭제할 행의 ID
+	remove  func(ids []uint) error                                 // ID 배치 삭제
+}
+
+// roadDiff는 도로명주소 diff import 타입입니다.
+func (imp *importer) roadDiff() diffType[postalcode.PostalCodeRoad] {
+	return diffType[postalcode.PostalCodeRoad]{
+		records: imp.roadRecords(),
+		scan: func(batchSize int, fn func([]postalcode.PostalCodeRoad) error) error {
+			return imp.service.ScanRoads(postalcode.SearchParams{}, batchSize, fn)
+		},
+		equal:  roadEqual,
+		id:     func(road *postalcode.PostalCodeRoad) uint { return road.ID },
+		remove: imp.service.BatchDelete,
+	}
+}
+
+// landDiff는 지번주소 diff import 타입입니다.
+func (imp *importer) landDiff() diffType[postalcode.PostalCodeLand] {
+	return diffType[postalcode.PostalCodeLand]{
+		records: imp.landRecords(),
+		scan: func(batchSize int, fn func([]postalcode.PostalCodeLand) error) error {
+			return imp.service.ScanLands(postalcode.SearchParamsLand{}, batchSize, fn)
+		},
+		equal:  landEqual,
+		id:     func(land *postalcode.PostalCodeLand) uint { return land.ID },
+		remove: imp.service.BatchDeleteLand,
+	}
+}
+
+// DiffImportFromFile은 파일과 현재 도로명주소 테이블을 비교하여 변경분만 반영합니다.
+//
+// 비교 기준은 유니크 인덱스 idx_postal_unique와 동일한 자연키
+// (우편번호, 시도, 시군구, 도로명, 시작건물번호(주))입니다.
+//   - 파일에만 있는 키: 추가
+//   - 양쪽에 있지만 나머지 컬럼이 다른 키: 변경
+//   - 테이블에만 있는 키: 삭제
+//
+// 반영하기 전에 안전 장치를 검사하여, 유효한 행이 없거나 거부 비율 또는 삭제 비율이
+// 한도(WithDiffLimits)를 넘으면 아무것도 반영하지 않고 postalcode.ErrUnsafeDiff를 반환합니다. (WithForceDiff로 무시)
+// Changeset의 추가/변경/삭제 건수는 커밋된 배치만 셉니다.
+// 삭제 배치가 실패하면 나머지 삭제를 중단하고, 그때까지 반영된 결과와 오류를 함께 반환합니다.
+func (imp *importer) DiffImportFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return diffRecords(imp, imp.roadDiff(), fileSource(filePath), batchSize, progressFn)
+}
+
+// DiffImportLandFromFile은 파일과 현재 지번주소 테이블을 비교하여 변경분만 반영합니다.
+//
+// 비교 기준은 유니크 인덱스 idx_land_unique와 동일한 자연키
+// (우편번호, 시도, 시군구, 읍면동, 리명, 산여부, 시작주번지)이며, 안전 장치는 DiffImportFromFile과 같습니다.
+func (imp *importer) DiffImportLandFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return diffRecords(imp, imp.landDiff(), fileSource(filePath), batchSize, progressFn)
+}
+
+// diffRecords는 source와 현재 테이블을 자연키(typ.records.key)로 비교하여 추가/변경 행은 upsert하고 사라진 행은 삭제합니다.
+func diffRecords[T any](imp *importer, typ diffType[T], src source, batchSize int, progressFn postalcode.ProgressFunc) (result *postalcode.ImportResult, err error) {
+	startTime := time.Now()
+	dataType := typ.records.dataType
+
+	run := imp.beginRun(dataType, postalcode.ImportModeDiff, src.name)
+	defer func() { imp.finishRun(run, result, err) }()
+
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	// 파일 파싱 및 검증
+	tr := imp.newProgressTracker(progressFn)
+	loaded, err := loadRecords(imp, typ.records, src, tr)
+	if err != nil {
+		return nil, fmt.Errorf("file parsing failed: %w", err)
+	}
+	run.FileSHA256 = loaded.info.checksum
+	imp.emitRejects(dataType, src.name, loaded.rejects)
+	if err := imp.checkDiffInput(len(loaded.rows), len(loaded.rejects)); err != nil {
+		return nil, err
+	}
+
+	// 파일 데이터를 자연키 기준으로 정리 (중복 키는 마지막 행 우선)
+	incoming := make(map[string]T, len(loaded.rows))
+	incomingLines := make(map[string]sourceLine, len(loaded.rows))
+	order := make([]string, 0, len(loaded.rows))
+	for i := range loaded.rows {
+		key := typ.records.key(&loaded.rows[i])
+		if _, exists := incoming[key]; !exists {
+			order = append(order, key)
+		}
+		incoming[key] = loaded.rows[i]
+		incomingLines[key] = loaded.lines[i]
+	}
+
+	// 현재 테이블과 비교
+	tr.setPhase(postalcode.ImportPhaseCompare)
+	changeset := &postalcode.ImportChangeset{}
+	seen := make(map[string]bool, len(incoming))
+	updatedKeys := make(map[string]bool)
+	var removedIDs []uint
+	var removedKeys []string
+	existingRows := 0
+
+	err = typ.scan(batchSize, func(existing []T) error {
+		existingRows += len(existing)
+		for i := range existing {
+			key := typ.records.key(&existing[i])
+			next, ok := incoming[key]
+			if !ok {
+				removedIDs = append(removedIDs, typ.id(&existing[i]))
+				removedKeys = append(removedKeys, key)
+				continue
+			}
+
+			seen[key] = true
+			if typ.equal(&existing[i], &next) {
+				changeset.Unchanged++
+			} else {
+				updatedKeys[key] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load existing data: %w", err)
+	}
+
+	if err := imp.checkDiffRemovals(existingRows, len(removedIDs)); err != nil {
+		return nil, err
+	}
+
+	// 추가/변경 대상 수집 (파일 순서 유지)
+	var changed []T
+	var changedLines []sourceLine
+	var changedKeys []string
+	var added []bool
+	for _, key := range order {
+		if seen[key] && !updatedKeys[key] {
+			continue
+		}
+		changed = append(changed, incoming[key])
+		changedLines = append(changedLines, incomingLines[key])
+		changedKeys = append(changedKeys, key)
+		added = append(added, !seen[key])
+	}
+
+	tr.startWrite(len(changed)+len(removedIDs), 0)
+
+	// 추가/변경 반영 (유니크 키 기준 upsert, 커밋된 배치만 changeset에 기록)
+	batchStart := 0
+	totalCount, _, failures := saveRecords(imp, typ.records, changed, batchSize, func(done, failed int) {
+		if failed == 0 {
+			countChanges(changeset, changedKeys[batchStart:done], added[batchStart:done])
+		}
+		batchStart = done
+		tr.batchDone(done, failed)
+	})
+	rejects := append(loaded.rejects, batchRejects(failures, changedLines)...)
+
+	// 사라진 행 삭제 (실패하면 나머지 삭제를 중단하고, 이미 반영된 변경분은 결과와 함께 반환)
+	var removeErr error
+	processed := len(changed)
+	for i := 0; i < len(removedIDs); i += batchSize {
+		end := i + batchSize
+		if end > len(removedIDs) {
+			end = len(removedIDs)
+		}
+
+		batch := removedIDs[i:end]
+		if err := typ.remove(batch); err != nil {
+			removeErr = fmt.Errorf("failed to delete removed rows (%d of %d deleted): %w", i, len(removedIDs), err)
+			break
+		}
+		countRemoved(changeset, removedKeys[i:end])
+
+		processed += len(batch)
+		tr.batchDone(processed, 0)
+	}
+
+	imp.quarantine(run, imp.rejectFile, dataType, src.name, loaded.info.header, rejects)
+	tr.finish()
+
+	duration := time.Since(startTime)
+	return &postalcode.ImportResult{
+		TotalCount: totalCount,
+		ErrorCount: len(rejects),
+		Duration:   duration.String(),
+		Changeset:  changeset,
+	}, removeErr
+}
+
+// checkDiffInput은 파일의 유효 행 수와 거부 행 수로 diff 안전 장치를 검사합니다.
+// 거부된 행의 키는 파일에 없는 것으로 비교되어 삭제 대상이 되므로, 거부가 많은 파일은 반영하지 않습니다.
+func (imp *importer) checkDiffInput(valid, rejected int) error {
+	if imp.forceDiff {
+		return nil
+	}
+	if valid == 0 {
+		return fmt.Errorf("%w: file has no valid rows", postalcode.ErrUnsafeDiff)
+	}
+	if float64(rejected) > float64(valid+rejected)*imp.diffMaxRejectRatio {
+		return fmt.Errorf("%w: %d of %d rows rejected (limit %.1f%%)",
+			postalcode.ErrUnsafeDiff, rejected, valid+rejected, imp.diffMaxRejectRatio*100)
+	}
+	return nil
+}
+
+// checkDiffRemovals는 삭제 대상 행 수가 기존 행 수의 한도를 넘는지 검사합니다.
+func (imp *importer) checkDiffRemovals(existing, removed int) error {
+	if imp.forceDiff || existing == 0 {
+		return nil
+	}
+	if float64(removed) > float64(existing)*imp.diffMaxRemoveRatio {
+		return fmt.Errorf("%w: would remove %d of %d existing rows (limit %.1f%%)",
+			postalcode.ErrUnsafeDiff, removed, existing, imp.diffMaxRemoveRatio*100)
+	}
+	return nil
+}
+
+// countChanges는 커밋된 배치의 추가/변경 건수와 샘플 키를 changeset에 기록합니다.
+// added[i]가 true이면 keys[i]는 추가, 아니면 변경된 행입니다.
+func countChanges(changeset *postalcode.ImportChangeset, keys []string, added []bool) {
+	for i, key := range keys {
+		if added[i] {
+			changeset.Added++
+			changeset.SampleAdded = appendSample(changeset.SampleAdded, key)
+		} else {
+			changeset.Updated++
+			changeset.SampleUpdated = appendSample(changeset.SampleUpdated, key)
+		}
+	}
+}
+
+// countRemoved는 커밋된 삭제 배치의 건수와 샘플 키를 changeset에 기록합니다.
+func countRemoved(changeset *postalcode.ImportChangeset, keys []string) {
+	changeset.Removed += len(keys)
+	for _, key := range keys {
+		changeset.SampleRemoved = appendSample(changeset.SampleRemoved, key)
+	}
+}
+
+// roadKey는 idx_postal_unique 컬럼으로 자연키 문자열을 만듭니다.
+func roadKey(road *postalcode.PostalCodeRoad) string {
+	return strings.Join([]string{
+		road.ZipCode,
+		road.SidoName,
+		road.SigunguName,
+		road.RoadName,
+		strconv.Itoa(road.StartBuildingMain),
+	}, "|")
+}
+
+// landKey는 idx_land_unique 컬럼으로 자연키 문자열을 만듭니다.
+func landKey(land *postalcode.PostalCodeLand) string {
+	return strings.Join([]string{
+		land.ZipCode,
+		land.SidoName,
+		land.SigunguName,
+		land.EupmyeondongName,
+		land.RiName,
+		strconv.FormatBool(land.IsMountain),
+		strconv.Itoa(land.StartJibunMain),
+	}, "|")
+}
+
+// roadEqual은 자연키 외의 데이터 컬럼이 모두 같은지 비교합니다.
+// ID, ZipPrefix(우편번호에서 파생), 타임스탬프는 비교하지 않습니다.
+func roadEqual(a, b *postalcode.PostalCodeRoad) bool {
+	return a.SidoNameEn == b.SidoNameEn &&
+		a.SigunguNameEn == b.SigunguNameEn &&
+		a.EupmyeonName == b.EupmyeonName &&
+		a.EupmyeonNameEn == b.EupmyeonNameEn &&
+		a.RoadNameEn == b.RoadNameEn &&
+		a.IsUnderground == b.IsUnderground &&
+		intPtrEqual(a.StartBuildingSub, b.StartBuildingSub) &&
+		intPtrEqual(a.EndBuildingMain, b.EndBuildingMain) &&
+		intPtrEqual(a.EndBuildingSub, b.EndBuildingSub) &&
+		a.RangeType == b.RangeType
+}
+
+// landEqual은 자연키 외의 데이터 컬럼이 모두 같은지 비교합니다.
+func landEqual(a, b *postalcode.PostalCodeLand) bool {
+	return a.SidoNameEn == b.SidoNameEn &&
+		a.SigunguNameEn == b.SigunguNameEn &&
+		a.EupmyeondongNameEn == b.EupmyeondongNameEn &&
+		a.HaengjeongdongName == b.HaengjeongdongName &&
+		intPtrEqual(a.StartJibunSub, b.StartJibunSub) &&
+		intPtrEqual(a.EndJibunMain, b.EndJibunMain) &&
+		intPtrEqual(a.EndJibunSub, b.EndJibunSub)
+}
+
+// intPtrEqual은 nil을 포함하여 두 *int 값이 같은지 비교합니다.
+func intPtrEqual(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// appendSample은 샘플 개수 제한 내에서만 키를 추가합니다.
+func appendSample(samples []string, key string) []string {
+	if len(samples) >= maxChangesetSamples {
+		return samples
+	}
+	return append(samples, key)
+}
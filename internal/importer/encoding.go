@@ -0,0 +1,143 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/transform"
+)
+
+// Encoding은 import 파일의 문자 인코딩입니다.
+type Encoding string
+
+const (
+	// EncodingAuto는 파일 앞부분을 검사하여 인코딩을 자동 감지합니다. (기본값)
+	EncodingAuto Encoding = "auto"
+
+	// EncodingUTF8은 UTF-8 인코딩입니다. (BOM은 자동으로 제거)
+	EncodingUTF8 Encoding = "utf-8"
+
+	// EncodingCP949는 CP949(EUC-KR 확장) 인코딩입니다.
+	// 우체국 배포 파일은 대부분 이 인코딩이며, EUC-KR 파일도 그대로 읽을 수 있습니다.
+	EncodingCP949 Encoding = "cp949"
+)
+
+// sniffSize는 인코딩 감지에 사용하는 파일 앞부분 크기입니다.
+const sniffSize = 64 * 1024
+
+// utf8BOM은 UTF-8 Byte Order Mark입니다.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// ParseEncoding은 인코딩 이름을 Encoding으로 변환합니다.
+// 대소문자와 하이픈/언더스코어 표기 차이는 무시합니다. (예: "EUC-KR", "euckr", "MS949")
+func ParseEncoding(name string) (Encoding, error) {
+	normalized := strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+	switch normalized {
+	case "", "auto":
+		return EncodingAuto, nil
+	case "utf8":
+		return EncodingUTF8, nil
+	case "cp949", "ms949", "euckr", "uhc":
+		return EncodingCP949, nil
+	default:
+		return "", fmt.Errorf("%w: %q", postalcode.ErrUnsupportedEncoding, name)
+	}
+}
+
+// decodeReader는 r을 UTF-8로 읽을 수 있는 reader로 감싸고 실제 사용된 인코딩을 반환합니다.
+// enc가 EncodingAuto이면 BOM, UTF-8 유효성, CP949 바이트 패턴 순으로 인코딩을 감지합니다.
+func decodeReader(r io.Reader, enc Encoding) (io.Reader, Encoding, error) {
+	br := bufio.NewReaderSize(r, sniffSize)
+	sample, err := br.Peek(sniffSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	// BOM은 인코딩 지정 여부와 관계없이 제거
+	if bytes.HasPrefix(sample, utf8BOM) {
+		if _, err := br.Discard(len(utf8BOM)); err != nil {
+			return nil, "", fmt.Errorf("failed to read file: %w", err)
+		}
+		if enc == EncodingAuto {
+			enc = EncodingUTF8
+		}
+		sample = sample[len(utf8BOM):]
+	}
+
+	if enc == EncodingAuto {
+		enc, err = detectEncoding(sample)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	switch enc {
+	case EncodingUTF8:
+		return br, enc, nil
+	case EncodingCP949:
+		return transform.NewReader(br, korean.EUCKR.NewDecoder()), enc, nil
+	default:
+		return nil, "", fmt.Errorf("%w: %q", postalcode.ErrUnsupportedEncoding, enc)
+	}
+}
+
+// detectEncoding은 파일 앞부분으로 인코딩을 추정합니다.
+// 유효한 UTF-8이면 UTF-8, CP949 2바이트 문자 패턴만으로 구성되어 있으면 CP949로 판단합니다.
+func detectEncoding(sample []byte) (Encoding, error) {
+	if isUTF8(sample) {
+		return EncodingUTF8, nil
+	}
+	if isCP949(sample) {
+		return EncodingCP949, nil
+	}
+	return "", fmt.Errorf("%w: UTF-8/CP949 어느 쪽으로도 해석할 수 없는 파일입니다 (-encoding 으로 지정하세요)", postalcode.ErrUnsupportedEncoding)
+}
+
+// isUTF8은 sample이 유효한 UTF-8인지 검사합니다.
+// sample 끝에서 잘린 멀티바이트 문자는 허용합니다.
+func isUTF8(sample []byte) bool {
+	for len(sample) > 0 {
+		r, size := utf8.DecodeRune(sample)
+		if r == utf8.RuneError && size == 1 {
+			return len(sample) < utf8.UTFMax && !utf8.FullRune(sample)
+		}
+		sample = sample[size:]
+	}
+	return true
+}
+
+// isCP949는 sample의 비ASCII 바이트가 모두 CP949 2바이트 문자로 해석되는지 검사합니다.
+// sample 끝에서 잘린 선행 바이트는 허용합니다.
+func isCP949(sample []byte) bool {
+	pairs := 0
+	for i := 0; i < len(sample); i++ {
+		lead := sample[i]
+		if lead < 0x80 {
+			continue
+		}
+		if lead < 0x81 || lead > 0xFE {
+			return false
+		}
+		if i+1 == len(sample) {
+			break
+		}
+		trail := sample[i+1]
+		if !isCP949Trail(trail) {
+			return false
+		}
+		pairs++
+		i++
+	}
+	return pairs > 0
+}
+
+// isCP949Trail은 CP949 2바이트 문자의 후행 바이트 범위인지 검사합니다.
+func isCP949Trail(b byte) bool {
+	return (b >= 0x41 && b <= 0x5A) || (b >= 0x61 && b <= 0x7A) || (b >= 0x81 && b <= 0xFE)
+}
@@ -0,0 +1,82 @@
+package importer
+
+import (
+	"fmt"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// emit은 이벤트를 WithEventHandler로 지정한 핸들러에 전달합니다.
+func (imp *importer) emit(event postalcode.Event) {
+	imp.events.HandleEvent(event)
+}
+
+// warn은 import를 중단하지 않는 부가 작업(이력, 체크포인트, 격리 기록)의 실패를 알립니다.
+func (imp *importer) warn(message string, err error) {
+	imp.emit(postalcode.Event{
+		Type:    postalcode.EventWarning,
+		Message: fmt.Sprintf("%s: %v", message, err),
+		Err:     err,
+	})
+}
+
+// emitRejects는 파싱/검증 중 거부된 라인을 라인마다 RowRejected 이벤트로 알립니다.
+func (imp *importer) emitRejects(dataType, fileName string, rejects []*postalcode.ImportError) {
+	for _, rerr := range rejects {
+		imp.emit(postalcode.Event{
+			Type:     postalcode.EventRowRejected,
+			DataType: dataType,
+			File:     fileName,
+			Line:     rerr.Line,
+			Field:    rerr.Field,
+			Value:    rerr.Value,
+			Message:  rerr.Error(),
+			Err:      rerr,
+		})
+	}
+}
+
+// truncate는 dataType 테이블의 모든 데이터를 삭제하고 시작/완료 이벤트를 알립니다.
+func (imp *importer) truncate(dataType string) error {
+	imp.emit(postalcode.Event{Type: postalcode.EventTruncateStarted, DataType: dataType})
+
+	var err error
+	switch dataType {
+	case DataTypeRoad:
+		err = imp.service.TruncateRoad()
+	case DataTypeBuilding:
+		err = imp.service.TruncateBuilding()
+	case DataTypePOBox:
+		err = imp.service.TruncatePOBox()
+	case DataTypeBulk:
+		err = imp.service.TruncateBulkDelivery()
+	default:
+		err = imp.service.TruncateLand()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to truncate existing data: %w", err)
+	}
+
+	imp.emit(postalcode.Event{Type: postalcode.EventTruncateCompleted, DataType: dataType})
+	return nil
+}
+
+// saveBatch는 배치 하나를 save로 저장하고 시작/완료/실패 이벤트를 알립니다.
+func (imp *importer) saveBatch(dataType string, start, end int, save func() error) error {
+	imp.emit(postalcode.Event{Type: postalcode.EventBatchStarted, DataType: dataType, Start: start, End: end})
+
+	if err := save(); err != nil {
+		imp.emit(postalcode.Event{
+			Type:     postalcode.EventBatchFailed,
+			DataType: dataType,
+			Start:    start,
+			End:      end,
+			Message:  err.Error(),
+			Err:      err,
+		})
+		return err
+	}
+
+	imp.emit(postalcode.Event{Type: postalcode.EventBatchCompleted, DataType: dataType, Start: start, End: end})
+	return nil
+}
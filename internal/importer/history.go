@@ -0,0 +1,117 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// beginRun은 import 실행 이력을 "running" 상태로 기록합니다.
+//
+// 이력 기록은 선택 사항이며 import 자체의 성공 여부에 영향을 주지 않습니다.
+// WithoutHistory가 지정되었거나 import_runs 테이블이 없으면 기록하지 않고, 그 밖의 기록 실패는
+// 경고 이벤트만 알립니다. 어느 경우든 ID가 0인 run을 반환하며 이후 checkpoint/finishRun은 아무것도 기록하지 않습니다.
+func (imp *importer) beginRun(dataType, mode, fileName string) *postalcode.ImportRun {
+	run := &postalcode.ImportRun{
+		DataType:    dataType,
+		Mode:        mode,
+		FileName:    fileName,
+		DatasetDate: imp.datasetDate,
+		Operator:    imp.operator,
+	}
+	if imp.noHistory {
+		return run
+	}
+	if err := imp.service.StartImportRun(run); err != nil {
+		if !errors.Is(err, postalcode.ErrImportHistoryUnavailable) {
+			imp.warn("import 이력 기록 실패", err)
+		}
+		run.ID = 0
+	}
+	return run
+}
+
+// checkpoint는 커밋된 배치까지 처리한 유효 행 수를 실행 이력에 기록합니다.
+//
+// 배치 저장과 체크포인트 기록은 하나의 트랜잭션이 아니므로, 그 사이에 중단되면
+// 재개 시 마지막 배치를 다시 저장합니다. 저장은 유니크 키 기준 upsert이므로 결과는 같습니다.
+func (imp *importer) checkpoint(run *postalcode.ImportRun, rows int) {
+	if run.ID == 0 {
+		return
+	}
+	if err := imp.service.CheckpointImportRun(run, rows); err != nil {
+		imp.warn("체크포인트 기록 실패", err)
+	}
+}
+
+// markTruncated는 대상 테이블을 비우기 직전에 실행 이력에 truncate 여부를 기록합니다.
+//
+// truncate 직후 첫 체크포인트 전에 중단되어도 이 실행이 테이블에 마지막으로 반영된 실행이 되어,
+// 이전에 중단된 실행을 잘못 재개(비워진 테이블에 중간부터 저장)하지 않습니다.
+func (imp *importer) markTruncated(run *postalcode.ImportRun) {
+	run.Truncated = true
+	imp.checkpoint(run, 0)
+}
+
+// findResumeRun은 WithResume이 지정된 경우 재개할 중단된 실행을 조회합니다.
+// WithResume이 없으면 nil을 반환합니다.
+func (imp *importer) findResumeRun(dataType string) (*postalcode.ImportRun, error) {
+	if !imp.resume {
+		return nil, nil
+	}
+	if imp.noHistory {
+		return nil, fmt.Errorf("%w: import history is disabled", postalcode.ErrNoResumableImport)
+	}
+	return imp.service.FindResumableImportRun(dataType)
+}
+
+// resumeOffset은 재개할 때 건너뛸 행 수를 반환합니다.
+// 파싱한 파일이 중단된 실행과 다르면 postalcode.ErrResumeMismatch를 반환합니다.
+func (imp *importer) resumeOffset(run, resumeFrom *postalcode.ImportRun, total int) (int, error) {
+	if resumeFrom == nil {
+		return 0, nil
+	}
+	if run.FileSHA256 != resumeFrom.FileSHA256 {
+		return 0, fmt.Errorf("%w: import #%d sha256 %s, file sha256 %s", postalcode.ErrResumeMismatch, resumeFrom.ID, resumeFrom.FileSHA256, run.FileSHA256)
+	}
+	if resumeFrom.CheckpointRows > total {
+		return 0, fmt.Errorf("%w: checkpoint %d exceeds %d rows", postalcode.ErrResumeMismatch, resumeFrom.CheckpointRows, total)
+	}
+
+	run.ResumedFromID = &resumeFrom.ID
+	if run.DatasetDate == "" {
+		run.DatasetDate = resumeFrom.DatasetDate
+	}
+	imp.emit(postalcode.Event{Type: postalcode.EventImportResumed, DataType: run.DataType, RunID: resumeFrom.ID, Count: resumeFrom.CheckpointRows, Total: total})
+	return resumeFrom.CheckpointRows, nil
+}
+
+// finishRun은 import 결과와 건수를 실행 이력에 기록하고 EventImportFinished 이벤트를 알립니다.
+// result가 nil이면(실패) 건수는 기록하지 않습니다.
+// result와 runErr가 모두 nil이면(panic 등 비정상 종료) "running" 상태로 두어 재개할 수 있게 합니다.
+func (imp *importer) finishRun(run *postalcode.ImportRun, result *postalcode.ImportResult, runErr error) {
+	if result == nil && runErr == nil {
+		return
+	}
+	// 이력 기록 후에 알려야 캐시 등이 새 데이터셋 버전을 읽음
+	defer imp.emit(postalcode.Event{Type: postalcode.EventImportFinished, DataType: run.DataType, RunID: run.ID, Err: runErr})
+	if run.ID == 0 {
+		return
+	}
+
+	if result != nil {
+		if cs := result.Changeset; cs != nil {
+			run.InsertedCount = cs.Added
+			run.UpdatedCount = cs.Updated
+			run.DeletedCount = cs.Removed
+		} else {
+			run.InsertedCount = result.TotalCount
+		}
+		run.RejectedCount = result.ErrorCount
+	}
+
+	if err := imp.service.FinishImportRun(run, runErr); err != nil {
+		imp.warn("import 이력 기록 실패", err)
+	}
+}
@@ -1,14 +1,12 @@
 package importer
 
 import (
-	"bufio"
-	"encoding/csv"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
-	"os"
 	"strconv"
 	"strings"
-	"time"
 
 	postalcode "github.com/oursportsnation/korean-postalcode"
 	"github.com/oursportsnation/korean-postalcode/internal/service"
@@ -20,421 +18,564 @@ type Importer interface {
 	// ImportFromFile은 파일에서 도로명주소 데이터를 가져와 DB에 저장합니다.
 	ImportFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
 
+	// ImportFromReader는 reader에서 도로명주소 데이터를 가져와 DB에 저장합니다.
+	// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다.
+	ImportFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
 	// ParseFile은 파일을 파싱하여 postalcode.PostalCodeRoad 슬라이스로 변환합니다.
 	ParseFile(filePath string) ([]postalcode.PostalCodeRoad, error)
 
+	// ParseReader는 reader를 파싱하여 postalcode.PostalCodeRoad 슬라이스로 변환합니다.
+	ParseReader(r io.Reader) ([]postalcode.PostalCodeRoad, error)
+
+	// Validate는 DB에 접근하지 않고 도로명주소 파일 전체를 파싱/검증하여 리포트를 반환합니다.
+	Validate(filePath string) (*postalcode.ValidationReport, error)
+
+	// DiffImportFromFile은 파일과 현재 도로명주소 테이블을 비교하여
+	// 추가/변경된 행만 반영하고 파일에서 사라진 행은 삭제합니다.
+	DiffImportFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// AppendFromFile은 기존 도로명주소 데이터를 유지한 채 파일의 행만 upsert합니다.
+	// 거부 라인 파일을 수정하여 해당 행만 다시 import할 때 사용합니다.
+	AppendFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
 	// 지번주소 관련 메서드
 	// ImportLandFromFile은 파일에서 지번주소 데이터를 가져와 DB에 저장합니다.
 	ImportLandFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
 
+	// ImportLandFromReader는 reader에서 지번주소 데이터를 가져와 DB에 저장합니다.
+	// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다.
+	ImportLandFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
 	// ParseLandFile은 파일을 파싱하여 postalcode.PostalCodeLand 슬라이스로 변환합니다.
 	ParseLandFile(filePath string) ([]postalcode.PostalCodeLand, error)
+
+	// ParseLandReader는 reader를 파싱하여 postalcode.PostalCodeLand 슬라이스로 변환합니다.
+	ParseLandReader(r io.Reader) ([]postalcode.PostalCodeLand, error)
+
+	// ValidateLand는 DB에 접근하지 않고 지번주소 파일 전체를 파싱/검증하여 리포트를 반환합니다.
+	ValidateLand(filePath string) (*postalcode.ValidationReport, error)
+
+	// DiffImportLandFromFile은 파일과 현재 지번주소 테이블을 비교하여
+	// 추가/변경된 행만 반영하고 파일에서 사라진 행은 삭제합니다.
+	DiffImportLandFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// AppendLandFromFile은 기존 지번주소 데이터를 유지한 채 파일의 행만 upsert합니다.
+	AppendLandFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// 건물 관련 메서드
+	// ImportBuildingFromFile은 도로명주소 건물 DB 파일에서 건물 데이터를 가져와 DB에 저장합니다.
+	// 헤더가 있는 파일과 헤더 없는 원본 건물 DB(build_*.txt) 파일을 모두 지원합니다.
+	ImportBuildingFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// ImportBuildingFromReader는 reader에서 건물 데이터를 가져와 DB에 저장합니다.
+	// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다.
+	ImportBuildingFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// AppendBuildingFromFile은 기존 건물 데이터를 유지한 채 파일의 행만 upsert합니다.
+	// 건물 DB 변동분 파일을 반영할 때 사용합니다.
+	AppendBuildingFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// ValidateBuilding은 DB에 접근하지 않고 건물 파일 전체를 파싱/검증하여 리포트를 반환합니다.
+	ValidateBuilding(filePath string) (*postalcode.ValidationReport, error)
+
+	// 사서함 관련 메서드
+	// ImportPOBoxFromFile은 사서함 우편번호 파일에서 데이터를 가져와 DB에 저장합니다.
+	ImportPOBoxFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// ImportPOBoxFromReader는 reader에서 사서함 데이터를 가져와 DB에 저장합니다.
+	// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다.
+	ImportPOBoxFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// AppendPOBoxFromFile은 기존 사서함 데이터를 유지한 채 파일의 행만 upsert합니다.
+	AppendPOBoxFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// ValidatePOBox는 DB에 접근하지 않고 사서함 파일 전체를 파싱/검증하여 리포트를 반환합니다.
+	ValidatePOBox(filePath string) (*postalcode.ValidationReport, error)
+
+	// 다량배달처 관련 메서드
+	// ImportBulkDeliveryFromFile은 다량배달처 우편번호 파일에서 데이터를 가져와 DB에 저장합니다.
+	ImportBulkDeliveryFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// ImportBulkDeliveryFromReader는 reader에서 다량배달처 데이터를 가져와 DB에 저장합니다.
+	// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다.
+	ImportBulkDeliveryFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// AppendBulkDeliveryFromFile은 기존 다량배달처 데이터를 유지한 채 파일의 행만 upsert합니다.
+	AppendBulkDeliveryFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error)
+
+	// ValidateBulkDelivery는 DB에 접근하지 않고 다량배달처 파일 전체를 파싱/검증하여 리포트를 반환합니다.
+	ValidateBulkDelivery(filePath string) (*postalcode.ValidationReport, error)
+
+	// DetectDataType은 파일 헤더로 도로명주소("road")/지번주소("land")/건물("building")/
+	// 사서함("pobox")/다량배달처("bulk") 여부를 판별합니다.
+	DetectDataType(filePath string) (string, error)
+
+	// 데이터셋 관련 메서드
+	// ImportDataset은 ZIP 아카이브, 디렉토리 또는 glob 패턴의 모든 파일을 하나의 데이터셋으로 import합니다.
+	// 파일별 도로명/지번/건물/사서함/다량배달처 구분은 헤더로 자동 감지하며, 타입별 테이블은 한 번만 truncate합니다.
+	ImportDataset(path string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.DatasetImportResult, error)
+
+	// ValidateDataset은 DB에 접근하지 않고 데이터셋의 모든 파일을 검증하여 파일별 리포트를 반환합니다.
+	ValidateDataset(path string) ([]*postalcode.ValidationReport, error)
 }
 
 // importer는 Importer 인터페이스 구현입니다.
 type importer struct {
-	service service.Service
-}
-
-// New는 새로운 Importer를 생성합니다.
-func New(svc service.Service) Importer {
-	return &importer{service: svc}
+	service     service.Service
+	encoding    Encoding
+	mapping     *ColumnMapping
+	operator    string
+	datasetDate string
+	resume      bool
+	noHistory   bool
+	rejectFile  string
+	rejectTable bool
+	events      postalcode.EventHandler
+	progress    postalcode.ProgressHandler
+
+	// diff 모드 안전 장치
+	forceDiff          bool
+	diffMaxRemoveRatio float64
+	diffMaxRejectRatio float64
 }
 
-// countDataLines counts the number of data lines in a file (excluding header)
-func countDataLines(filePath string) (int, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return 0, err
-	}
-	defer file.Close()
+// diff 모드 안전 장치 기본 한도
+const (
+	DefaultDiffMaxRemoveRatio = 0.5  // 기존 행 중 삭제할 수 있는 최대 비율
+	DefaultDiffMaxRejectRatio = 0.01 // 파일 데이터 행 중 거부될 수 있는 최대 비율
+)
 
-	scanner := bufio.NewScanner(file)
-	lineCount := 0
-	firstLine := true
+// Option은 Importer 생성 옵션입니다.
+type Option func(*importer)
 
-	for scanner.Scan() {
-		if firstLine {
-			firstLine = false
-			continue // Skip header
-		}
-		lineCount++
+// WithEncoding은 import 파일의 인코딩을 지정합니다.
+// 지정하지 않으면 EncodingAuto로 자동 감지합니다.
+func WithEncoding(enc Encoding) Option {
+	return func(imp *importer) {
+		imp.encoding = enc
 	}
+}
 
-	if err := scanner.Err(); err != nil {
-		return 0, err
+// WithColumnMapping은 사용자 정의 헤더 → 컬럼 매핑을 지정합니다.
+// 매핑에 없는 헤더는 기본 헤더 이름 규칙으로 인식합니다.
+func WithColumnMapping(mapping *ColumnMapping) Option {
+	return func(imp *importer) {
+		imp.mapping = mapping
 	}
-
-	return lineCount, nil
 }
 
-// ImportFromFile은 파일에서 우편번호 데이터를 가져와 DB에 저장합니다.
-func (imp *importer) ImportFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
-	startTime := time.Now()
+// WithOperator는 import 실행 이력에 기록할 실행자 이름을 지정합니다.
+func WithOperator(name string) Option {
+	return func(imp *importer) {
+		imp.operator = name
+	}
+}
 
-	if batchSize <= 0 {
-		batchSize = 1000
+// WithDatasetDate는 import 실행 이력에 기록할 데이터셋 배포 기준일(YYYY-MM-DD)을 지정합니다.
+// 지정하지 않으면 데이터셋 버전에 import 완료일을 사용합니다.
+func WithDatasetDate(date string) Option {
+	return func(imp *importer) {
+		imp.datasetDate = date
 	}
+}
 
-	// 기존 데이터 truncate (새로운 데이터로 완전히 교체)
-	fmt.Println("🗑️  기존 도로명주소 데이터 삭제 중...")
-	if err := imp.service.TruncateRoad(); err != nil {
-		return nil, fmt.Errorf("failed to truncate existing data: %w", err)
+// WithoutHistory는 import 실행 이력(import_runs)을 기록하지 않도록 합니다.
+// 지정하지 않아도 import_runs 테이블이 없으면 이력 없이 import합니다. 이력이 없으면 WithResume을 사용할 수 없습니다.
+func WithoutHistory() Option {
+	return func(imp *importer) {
+		imp.noHistory = true
 	}
-	fmt.Println("✅ 기존 데이터 삭제 완료")
+}
 
-	// Count total lines in file (excluding header)
-	totalLines, err := countDataLines(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to count lines: %w", err)
+// WithResume은 replace 모드 import가 중단된 실행을 이어서 진행하도록 합니다.
+//
+// 같은 타입 테이블에 마지막으로 반영된 실행이 중단된 import이고 파일 SHA-256이 같으면
+// truncate하지 않고 마지막으로 커밋된 배치 이후의 행부터 저장합니다.
+// 재개할 실행이 없으면 postalcode.ErrNoResumableImport, 파일이 다르면 postalcode.ErrResumeMismatch를 반환합니다.
+// 단일 파일 replace 모드 import(ImportFromFile, ImportFromReader 및 지번주소 대응 메서드)에만 적용됩니다.
+func WithResume() Option {
+	return func(imp *importer) {
+		imp.resume = true
 	}
+}
 
-	// 파일 파싱
-	roads, err := imp.ParseFile(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("file parsing failed: %w", err)
+// WithRejectFile은 거부된 라인(파싱/검증 실패 및 저장 실패 배치)을 기록할 파일 경로를 지정합니다.
+//
+// 원본과 같은 파이프('|') 구분 형식에 라인 번호와 거부 사유 컬럼을 추가하여 기록하므로,
+// 수정한 뒤 append 모드로 해당 행만 다시 import할 수 있습니다.
+// 데이터셋 import에서는 path를 디렉토리로 사용하여 원본 파일 이름으로 파일별로 기록합니다.
+func WithRejectFile(path string) Option {
+	return func(imp *importer) {
+		imp.rejectFile = path
 	}
+}
 
-	totalCount := 0
-	errorCount := 0
+// WithRejectTable은 거부된 라인을 postal_code_import_rejects 테이블에도 기록합니다.
+// postalcode.ImportReject 테이블이 migrate되어 있어야 합니다.
+func WithRejectTable() Option {
+	return func(imp *importer) {
+		imp.rejectTable = true
+	}
+}
 
-	// 배치 처리
-	for i := 0; i < len(roads); i += batchSize {
-		end := i + batchSize
-		if end > len(roads) {
-			end = len(roads)
+// WithDiffLimits는 diff 모드 안전 장치의 한도를 지정합니다.
+// maxRemoveRatio는 기존 행 중 삭제할 수 있는 최대 비율, maxRejectRatio는 파일 데이터 행 중 거부될 수 있는 최대 비율이며
+// 0 이상 1 이하의 값만 적용합니다. (기본 DefaultDiffMaxRemoveRatio, DefaultDiffMaxRejectRatio)
+func WithDiffLimits(maxRemoveRatio, maxRejectRatio float64) Option {
+	return func(imp *importer) {
+		if maxRemoveRatio >= 0 && maxRemoveRatio <= 1 {
+			imp.diffMaxRemoveRatio = maxRemoveRatio
+		}
+		if maxRejectRatio >= 0 && maxRejectRatio <= 1 {
+			imp.diffMaxRejectRatio = maxRejectRatio
 		}
+	}
+}
 
-		batch := roads[i:end]
+// WithForceDiff는 diff 모드 안전 장치(빈 파일, 거부 비율, 삭제 비율)를 검사하지 않고 변경분을 반영하도록 합니다.
+// 테이블 대부분을 실제로 교체하는 경우에만 사용하세요.
+func WithForceDiff() Option {
+	return func(imp *importer) {
+		imp.forceDiff = true
+	}
+}
 
-		// DB에 저장
-		if err := imp.service.BatchUpsert(batch); err != nil {
-			fmt.Printf("❌ 배치 %d-%d 저장 실패: %v\n", i, end, err)
-			errorCount += len(batch)
-		} else {
-			totalCount += len(batch)
+// WithEventHandler는 배치 저장, 라인 거부, 테이블 삭제 등 import 진행 이벤트를 받을 핸들러를 지정합니다.
+// 지정하지 않으면 이벤트를 무시하며, 라이브러리는 표준출력에 아무것도 출력하지 않습니다.
+func WithEventHandler(handler postalcode.EventHandler) Option {
+	return func(imp *importer) {
+		if handler != nil {
+			imp.events = handler
 		}
+	}
+}
 
-		// 진행 상황 보고
-		if progressFn != nil {
-			progressFn(i+len(batch), len(roads))
-		}
+// WithProgressHandler는 단계, 건수, 처리 속도, 남은 시간을 포함한 상세 진행 상황을 받을 핸들러를 지정합니다.
+// import 메서드에 전달한 ProgressFunc와 함께 사용할 수 있습니다.
+func WithProgressHandler(handler postalcode.ProgressHandler) Option {
+	return func(imp *importer) {
+		imp.progress = handler
 	}
+}
 
-	// Parse errors = total lines - successfully parsed records
-	parseErrors := totalLines - len(roads)
-	errorCount += parseErrors
+// New는 새로운 Importer를 생성합니다.
+func New(svc service.Service, opts ...Option) Importer {
+	imp := &importer{
+		service:            svc,
+		encoding:           EncodingAuto,
+		events:             postalcode.NopEventHandler,
+		diffMaxRemoveRatio: DefaultDiffMaxRemoveRatio,
+		diffMaxRejectRatio: DefaultDiffMaxRejectRatio,
+	}
+	for _, opt := range opts {
+		opt(imp)
+	}
+	return imp
+}
 
-	duration := time.Since(startTime)
-	return &postalcode.ImportResult{
-		TotalCount: totalCount,
-		ErrorCount: errorCount,
-		Duration:   duration.String(),
-	}, nil
+// sourceInfo는 source를 끝까지 읽은 뒤 알 수 있는 정보입니다.
+type sourceInfo struct {
+	encoding Encoding // 실제 사용된 인코딩
+	checksum string   // 원본 바이트의 SHA-256 (hex)
+	header   []string // 헤더 라인 (UTF-8 변환 후)
 }
 
-// ParseFile은 파일을 파싱하여 PostalCodeRoad 슬라이스로 변환합니다.
-func (imp *importer) ParseFile(filePath string) ([]postalcode.PostalCodeRoad, error) {
-	// 파일 열기
-	file, err := os.Open(filePath)
+// readSource는 source를 열어 인코딩을 UTF-8로 변환한 뒤 모든 데이터 라인에 대해 fn을 호출합니다.
+// tr이 nil이 아니면 읽은 바이트와 라인 수를 기록합니다.
+func (imp *importer) readSource(src source, dataType string, tr *progressTracker, fn func(*row) error, onReject func(*postalcode.ImportError)) (sourceInfo, error) {
+	rc, err := src.open()
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+		return sourceInfo{}, fmt.Errorf("failed to open file: %w", err)
 	}
-	defer file.Close()
-
-	// CSV 리더 생성 (파이프 구분자)
-	reader := csv.NewReader(bufio.NewReader(file))
-	reader.Comma = '|'
-	reader.LazyQuotes = true
-	reader.TrimLeadingSpace = true
-
-	// 헤더 읽기 (첫 줄 스킵)
-	if _, err := reader.Read(); err != nil {
-		return nil, fmt.Errorf("failed to read header: %w", err)
+	defer rc.Close()
+
+	// 원본 바이트 그대로 SHA-256 계산 (import 이력 기록용)
+	hash := sha256.New()
+	raw := io.TeeReader(rc, hash)
+	size := sourceSize(src, rc)
+	if tr != nil {
+		tr.startFile(dataType, src.name, size)
+		raw = &countingReader{r: raw, tracker: tr}
+	}
+	if size > 0 {
+		raw = newReadProgress(raw, size, func(read, size int64) {
+			imp.emit(postalcode.Event{Type: postalcode.EventReadProgress, File: src.name, Count: int(read), Total: int(size)})
+			tr.update()
+		})
 	}
 
-	var roads []postalcode.PostalCodeRoad
-	lineNumber := 1 // 헤더 이후부터
-	var parseErrors []string
-
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			parseErrors = append(parseErrors, fmt.Sprintf("라인 %d: CSV 파싱 에러 - %v", lineNumber, err))
-			lineNumber++
-			continue
-		}
-
-		// 필드 수 검증
-		if len(record) < 15 {
-			parseErrors = append(parseErrors, fmt.Sprintf("라인 %d: 필드 수 부족 (필요: 15, 실제: %d)", lineNumber, len(record)))
-			lineNumber++
-			continue
-		}
-
-		// 데이터 파싱
-		zipCode := strings.TrimSpace(record[0])
-		zipPrefix := ""
-		if len(zipCode) >= 3 {
-			zipPrefix = zipCode[:3]
-		}
+	reader, enc, err := decodeReader(raw, imp.encoding)
+	if err != nil {
+		return sourceInfo{}, err
+	}
 
-		road := postalcode.PostalCodeRoad{
-			ZipCode:        zipCode,
-			ZipPrefix:      zipPrefix,
-			SidoName:       strings.TrimSpace(record[1]),
-			SidoNameEn:     strings.TrimSpace(record[2]),
-			SigunguName:    strings.TrimSpace(record[3]),
-			SigunguNameEn:  strings.TrimSpace(record[4]),
-			EupmyeonName:   strings.TrimSpace(record[5]),
-			EupmyeonNameEn: strings.TrimSpace(record[6]),
-			RoadName:       strings.TrimSpace(record[7]),
-			RoadNameEn:     strings.TrimSpace(record[8]),
-		}
+	layout, err := forEachRow(reader, dataType, imp.mapping, func(r *row) error {
+		tr.rowRead()
+		return fn(r)
+	}, func(rerr *postalcode.ImportError) {
+		tr.rowRead()
+		onReject(rerr)
+	})
+	if err != nil {
+		return sourceInfo{}, err
+	}
 
-		// 지하여부 파싱
-		if underground := strings.TrimSpace(record[9]); underground == "1" {
-			road.IsUnderground = true
-		}
+	return sourceInfo{encoding: enc, checksum: hex.EncodeToString(hash.Sum(nil)), header: layout.header}, nil
+}
 
-		// 시작건물번호(주) 파싱
-		if startMain := strings.TrimSpace(record[10]); startMain != "" {
-			if val, err := strconv.Atoi(startMain); err == nil {
-				road.StartBuildingMain = val
-			}
-		}
+// ============================================================
+// 도로명주소 관련 메서드
+// ============================================================
 
-		// 시작건물번호(부) 파싱
-		if startSub := strings.TrimSpace(record[11]); startSub != "" && startSub != "0" {
-			if val, err := strconv.Atoi(startSub); err == nil {
-				road.StartBuildingSub = &val
-			}
-		}
+// roadRecords는 도로명주소 파일의 레코드 타입입니다.
+func (imp *importer) roadRecords() recordType[postalcode.PostalCodeRoad] {
+	return recordType[postalcode.PostalCodeRoad]{
+		dataType: DataTypeRoad,
+		parse:    parseRoadRow,
+		validate: imp.service.Validate,
+		upsert:   imp.service.BatchUpsert,
+		key:      roadKey,
+		zipSido: func(road *postalcode.PostalCodeRoad) (string, string) {
+			return road.ZipCode, road.SidoName
+		},
+	}
+}
 
-		// 끝건물번호(주) 파싱
-		if endMain := strings.TrimSpace(record[12]); endMain != "" {
-			if val, err := strconv.Atoi(endMain); err == nil {
-				road.EndBuildingMain = &val
-			}
-		}
+// ImportFromFile은 파일에서 우편번호 데이터를 가져와 DB에 저장합니다.
+func (imp *importer) ImportFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.roadRecords(), fileSource(filePath), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-		// 끝건물번호(부) 파싱
-		if endSub := strings.TrimSpace(record[13]); endSub != "" && endSub != "0" {
-			if val, err := strconv.Atoi(endSub); err == nil {
-				road.EndBuildingSub = &val
-			}
-		}
+// ImportFromReader는 reader에서 도로명주소 데이터를 가져와 DB에 저장합니다.
+// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다. (읽기 진행률 표시에 사용)
+func (imp *importer) ImportFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.roadRecords(), readerSource(r, size), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-		// 범위종류 파싱
-		if rangeType := strings.TrimSpace(record[14]); rangeType != "" {
-			if val, err := strconv.Atoi(rangeType); err == nil {
-				road.RangeType = int8(val)
-			}
-		}
+// AppendFromFile은 기존 데이터를 유지한 채 파일의 도로명주소 행만 upsert합니다.
+func (imp *importer) AppendFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.roadRecords(), fileSource(filePath), postalcode.ImportModeAppend, batchSize, progressFn)
+}
 
-		roads = append(roads, road)
-		lineNumber++
-	}
+// ParseFile은 파일을 파싱하여 PostalCodeRoad 슬라이스로 변환합니다.
+// 형식 오류가 있는 라인은 건너뜁니다. (검증 규칙은 적용하지 않음)
+func (imp *importer) ParseFile(filePath string) ([]postalcode.PostalCodeRoad, error) {
+	return parseRecords(imp, imp.roadRecords(), fileSource(filePath))
+}
 
-	// 파싱 에러가 있으면 출력
-	if len(parseErrors) > 0 {
-		fmt.Printf("⚠️  파싱 중 %d개 에러 발생:\n", len(parseErrors))
-		for i, errMsg := range parseErrors {
-			if i < 10 { // 최대 10개만 출력
-				fmt.Printf("  - %s\n", errMsg)
-			}
-		}
-		if len(parseErrors) > 10 {
-			fmt.Printf("  ... 외 %d개\n", len(parseErrors)-10)
-		}
-	}
+// ParseReader는 reader를 파싱하여 PostalCodeRoad 슬라이스로 변환합니다.
+// 형식 오류가 있는 라인은 건너뜁니다. (검증 규칙은 적용하지 않음)
+func (imp *importer) ParseReader(r io.Reader) ([]postalcode.PostalCodeRoad, error) {
+	return parseRecords(imp, imp.roadRecords(), readerSource(r, 0))
+}
 
-	return roads, nil
+// Validate는 DB에 접근하지 않고 도로명주소 파일 전체를 파싱/검증합니다.
+func (imp *importer) Validate(filePath string) (*postalcode.ValidationReport, error) {
+	return validateRecords(imp, imp.roadRecords(), fileSource(filePath))
 }
 
 // ============================================================
 // 지번주소 관련 메서드
 // ============================================================
 
-// ImportLandFromFile은 파일에서 지번주소 데이터를 가져와 DB에 저장합니다.
-func (imp *importer) ImportLandFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
-	startTime := time.Now()
-
-	if batchSize <= 0 {
-		batchSize = 1000
+// landRecords는 지번주소 파일의 레코드 타입입니다.
+func (imp *importer) landRecords() recordType[postalcode.PostalCodeLand] {
+	return recordType[postalcode.PostalCodeLand]{
+		dataType: DataTypeLand,
+		parse:    parseLandRow,
+		validate: imp.service.ValidateLand,
+		upsert:   imp.service.BatchUpsertLand,
+		key:      landKey,
+		zipSido: func(land *postalcode.PostalCodeLand) (string, string) {
+			return land.ZipCode, land.SidoName
+		},
 	}
+}
 
-	// 기존 데이터 truncate (새로운 데이터로 완전히 교체)
-	fmt.Println("🗑️  기존 지번주소 데이터 삭제 중...")
-	if err := imp.service.TruncateLand(); err != nil {
-		return nil, fmt.Errorf("failed to truncate existing data: %w", err)
-	}
-	fmt.Println("✅ 기존 데이터 삭제 완료")
+// ImportLandFromFile은 파일에서 지번주소 데이터를 가져와 DB에 저장합니다.
+func (imp *importer) ImportLandFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.landRecords(), fileSource(filePath), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-	// Count total lines in file (excluding header)
-	totalLines, err := countDataLines(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to count lines: %w", err)
-	}
+// ImportLandFromReader는 reader에서 지번주소 데이터를 가져와 DB에 저장합니다.
+// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다. (읽기 진행률 표시에 사용)
+func (imp *importer) ImportLandFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.landRecords(), readerSource(r, size), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-	// 파일 파싱
-	lands, err := imp.ParseLandFile(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("file parsing failed: %w", err)
-	}
+// AppendLandFromFile은 기존 데이터를 유지한 채 파일의 지번주소 행만 upsert합니다.
+func (imp *importer) AppendLandFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.landRecords(), fileSource(filePath), postalcode.ImportModeAppend, batchSize, progressFn)
+}
 
-	totalCount := 0
-	errorCount := 0
+// ParseLandFile은 파일을 파싱하여 PostalCodeLand 슬라이스로 변환합니다.
+// 형식 오류가 있는 라인은 건너뜁니다. (검증 규칙은 적용하지 않음)
+func (imp *importer) ParseLandFile(filePath string) ([]postalcode.PostalCodeLand, error) {
+	return parseRecords(imp, imp.landRecords(), fileSource(filePath))
+}
 
-	// 배치 처리
-	for i := 0; i < len(lands); i += batchSize {
-		end := i + batchSize
-		if end > len(lands) {
-			end = len(lands)
-		}
+// ParseLandReader는 reader를 파싱하여 PostalCodeLand 슬라이스로 변환합니다.
+// 형식 오류가 있는 라인은 건너뜁니다. (검증 규칙은 적용하지 않음)
+func (imp *importer) ParseLandReader(r io.Reader) ([]postalcode.PostalCodeLand, error) {
+	return parseRecords(imp, imp.landRecords(), readerSource(r, 0))
+}
 
-		batch := lands[i:end]
+// ValidateLand는 DB에 접근하지 않고 지번주소 파일 전체를 파싱/검증합니다.
+func (imp *importer) ValidateLand(filePath string) (*postalcode.ValidationReport, error) {
+	return validateRecords(imp, imp.landRecords(), fileSource(filePath))
+}
 
-		// DB에 저장
-		if err := imp.service.BatchUpsertLand(batch); err != nil {
-			fmt.Printf("❌ 배치 %d-%d 저장 실패: %v\n", i, end, err)
-			errorCount += len(batch)
-		} else {
-			totalCount += len(batch)
-		}
+// ============================================================
+// 건물 관련 메서드
+// ============================================================
 
-		// 진행 상황 보고
-		if progressFn != nil {
-			progressFn(i+len(batch), len(lands))
-		}
+// buildingRecords는 건물 파일의 레코드 타입입니다. 건물관리번호가 유니크 키입니다.
+func (imp *importer) buildingRecords() recordType[postalcode.PostalCodeBuilding] {
+	return recordType[postalcode.PostalCodeBuilding]{
+		dataType: DataTypeBuilding,
+		parse:    parseBuildingRow,
+		validate: imp.service.ValidateBuilding,
+		upsert:   imp.service.BatchUpsertBuilding,
+		key: func(building *postalcode.PostalCodeBuilding) string {
+			return building.BuildingManagementNumber
+		},
+		zipSido: func(building *postalcode.PostalCodeBuilding) (string, string) {
+			return building.ZipCode, building.SidoName
+		},
 	}
+}
 
-	// Parse errors = total lines - successfully parsed records
-	parseErrors := totalLines - len(lands)
-	errorCount += parseErrors
+// ImportBuildingFromFile은 파일에서 건물 데이터를 가져와 DB에 저장합니다.
+func (imp *importer) ImportBuildingFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.buildingRecords(), fileSource(filePath), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-	duration := time.Since(startTime)
-	return &postalcode.ImportResult{
-		TotalCount: totalCount,
-		ErrorCount: errorCount,
-		Duration:   duration.String(),
-	}, nil
+// ImportBuildingFromReader는 reader에서 건물 데이터를 가져와 DB에 저장합니다.
+// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다. (읽기 진행률 표시에 사용)
+func (imp *importer) ImportBuildingFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.buildingRecords(), readerSource(r, size), postalcode.ImportModeReplace, batchSize, progressFn)
 }
 
-// ParseLandFile은 파일을 파싱하여 PostalCodeLand 슬라이스로 변환합니다.
-func (imp *importer) ParseLandFile(filePath string) ([]postalcode.PostalCodeLand, error) {
-	// 파일 열기
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
-	}
-	defer file.Close()
+// AppendBuildingFromFile은 기존 데이터를 유지한 채 파일의 건물 행만 upsert합니다.
+func (imp *importer) AppendBuildingFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.buildingRecords(), fileSource(filePath), postalcode.ImportModeAppend, batchSize, progressFn)
+}
 
-	// CSV 리더 생성 (파이프 구분자)
-	reader := csv.NewReader(bufio.NewReader(file))
-	reader.Comma = '|'
-	reader.LazyQuotes = true
-	reader.TrimLeadingSpace = true
+// ValidateBuilding은 DB에 접근하지 않고 건물 파일 전체를 파싱/검증합니다.
+// 건물관리번호가 같은 행은 중복 키로 집계합니다.
+func (imp *importer) ValidateBuilding(filePath string) (*postalcode.ValidationReport, error) {
+	return validateRecords(imp, imp.buildingRecords(), fileSource(filePath))
+}
 
-	// 헤더 읽기 (첫 줄 스킵)
-	if _, err := reader.Read(); err != nil {
-		return nil, fmt.Errorf("failed to read header: %w", err)
+// ============================================================
+// 사서함 관련 메서드
+// ============================================================
+
+// poBoxRecords는 사서함 파일의 레코드 타입입니다.
+// 우편번호, 사서함명, 시작사서함번호가 유니크 키입니다. (idx_pobox_unique)
+func (imp *importer) poBoxRecords() recordType[postalcode.PostalCodePOBox] {
+	return recordType[postalcode.PostalCodePOBox]{
+		dataType: DataTypePOBox,
+		parse:    parsePOBoxRow,
+		validate: imp.service.ValidatePOBox,
+		upsert:   imp.service.BatchUpsertPOBox,
+		key: func(poBox *postalcode.PostalCodePOBox) string {
+			return strings.Join([]string{poBox.ZipCode, poBox.POBoxName, strconv.Itoa(poBox.StartNumberMain)}, "|")
+		},
+		zipSido: func(poBox *postalcode.PostalCodePOBox) (string, string) {
+			return poBox.ZipCode, poBox.SidoName
+		},
 	}
+}
 
-	var lands []postalcode.PostalCodeLand
-	lineNumber := 1 // 헤더 이후부터
-	var parseErrors []string
+// ImportPOBoxFromFile은 파일에서 사서함 데이터를 가져와 DB에 저장합니다.
+func (imp *importer) ImportPOBoxFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.poBoxRecords(), fileSource(filePath), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			parseErrors = append(parseErrors, fmt.Sprintf("라인 %d: CSV 파싱 에러 - %v", lineNumber, err))
-			lineNumber++
-			continue
-		}
+// ImportPOBoxFromReader는 reader에서 사서함 데이터를 가져와 DB에 저장합니다.
+// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다. (읽기 진행률 표시에 사용)
+func (imp *importer) ImportPOBoxFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.poBoxRecords(), readerSource(r, size), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-		// 필드 수 검증
-		if len(record) < 14 {
-			parseErrors = append(parseErrors, fmt.Sprintf("라인 %d: 필드 수 부족 (필요: 14, 실제: %d)", lineNumber, len(record)))
-			lineNumber++
-			continue
-		}
+// AppendPOBoxFromFile은 기존 데이터를 유지한 채 파일의 사서함 행만 upsert합니다.
+func (imp *importer) AppendPOBoxFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.poBoxRecords(), fileSource(filePath), postalcode.ImportModeAppend, batchSize, progressFn)
+}
 
-		// 데이터 파싱
-		zipCode := strings.TrimSpace(record[0])
-		zipPrefix := ""
-		if len(zipCode) >= 3 {
-			zipPrefix = zipCode[:3]
-		}
+// ValidatePOBox는 DB에 접근하지 않고 사서함 파일 전체를 파싱/검증합니다.
+func (imp *importer) ValidatePOBox(filePath string) (*postalcode.ValidationReport, error) {
+	return validateRecords(imp, imp.poBoxRecords(), fileSource(filePath))
+}
 
-		land := postalcode.PostalCodeLand{
-			ZipCode:            zipCode,
-			ZipPrefix:          zipPrefix,
-			SidoName:           strings.TrimSpace(record[1]),
-			SidoNameEn:         strings.TrimSpace(record[2]),
-			SigunguName:        strings.TrimSpace(record[3]),
-			SigunguNameEn:      strings.TrimSpace(record[4]),
-			EupmyeondongName:   strings.TrimSpace(record[5]),
-			EupmyeondongNameEn: strings.TrimSpace(record[6]),
-			RiName:             strings.TrimSpace(record[7]),
-			HaengjeongdongName: strings.TrimSpace(record[9]),
-		}
+// ============================================================
+// 다량배달처 관련 메서드
+// ============================================================
 
-		// 산여부 파싱
-		if mountain := strings.TrimSpace(record[8]); mountain == "1" {
-			land.IsMountain = true
-		}
+// bulkDeliveryRecords는 다량배달처 파일의 레코드 타입입니다.
+// 우편번호와 다량배달처명이 유니크 키입니다. (idx_bulk_unique)
+func (imp *importer) bulkDeliveryRecords() recordType[postalcode.PostalCodeBulkDelivery] {
+	return recordType[postalcode.PostalCodeBulkDelivery]{
+		dataType: DataTypeBulk,
+		parse:    parseBulkDeliveryRow,
+		validate: imp.service.ValidateBulkDelivery,
+		upsert:   imp.service.BatchUpsertBulkDelivery,
+		key: func(delivery *postalcode.PostalCodeBulkDelivery) string {
+			return delivery.ZipCode + "|" + delivery.DeliveryName
+		},
+		zipSido: func(delivery *postalcode.PostalCodeBulkDelivery) (string, string) {
+			return delivery.ZipCode, delivery.SidoName
+		},
+	}
+}
 
-		// 시작주번지 파싱
-		if startMain := strings.TrimSpace(record[10]); startMain != "" {
-			if val, err := strconv.Atoi(startMain); err == nil {
-				land.StartJibunMain = val
-			}
-		}
+// ImportBulkDeliveryFromFile은 파일에서 다량배달처 데이터를 가져와 DB에 저장합니다.
+func (imp *importer) ImportBulkDeliveryFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.bulkDeliveryRecords(), fileSource(filePath), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-		// 시작부번지 파싱
-		if startSub := strings.TrimSpace(record[11]); startSub != "" && startSub != "0" {
-			if val, err := strconv.Atoi(startSub); err == nil {
-				land.StartJibunSub = &val
-			}
-		}
+// ImportBulkDeliveryFromReader는 reader에서 다량배달처 데이터를 가져와 DB에 저장합니다.
+// size는 reader의 전체 바이트 수이며, 알 수 없으면 0을 전달합니다. (읽기 진행률 표시에 사용)
+func (imp *importer) ImportBulkDeliveryFromReader(r io.Reader, size int64, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.bulkDeliveryRecords(), readerSource(r, size), postalcode.ImportModeReplace, batchSize, progressFn)
+}
 
-		// 끝주번지 파싱
-		if endMain := strings.TrimSpace(record[12]); endMain != "" {
-			if val, err := strconv.Atoi(endMain); err == nil {
-				land.EndJibunMain = &val
-			}
-		}
+// AppendBulkDeliveryFromFile은 기존 데이터를 유지한 채 파일의 다량배달처 행만 upsert합니다.
+func (imp *importer) AppendBulkDeliveryFromFile(filePath string, batchSize int, progressFn postalcode.ProgressFunc) (*postalcode.ImportResult, error) {
+	return importRecords(imp, imp.bulkDeliveryRecords(), fileSource(filePath), postalcode.ImportModeAppend, batchSize, progressFn)
+}
 
-		// 끝부번지 파싱
-		if endSub := strings.TrimSpace(record[13]); endSub != "" && endSub != "0" {
-			if val, err := strconv.Atoi(endSub); err == nil {
-				land.EndJibunSub = &val
-			}
-		}
+// ValidateBulkDelivery는 DB에 접근하지 않고 다량배달처 파일 전체를 파싱/검증합니다.
+func (imp *importer) ValidateBulkDelivery(filePath string) (*postalcode.ValidationReport, error) {
+	return validateRecords(imp, imp.bulkDeliveryRecords(), fileSource(filePath))
+}
+
+// ============================================================
+// 공통 헬퍼
+// ============================================================
 
-		lands = append(lands, land)
-		lineNumber++
+// newValidationReport는 거부 라인 통계가 채워진 ValidationReport를 생성합니다.
+func newValidationReport(filePath, dataType string, rejects []*postalcode.ImportError) *postalcode.ValidationReport {
+	report := &postalcode.ValidationReport{
+		FilePath:      filePath,
+		DataType:      dataType,
+		RejectedCount: len(rejects),
+		SidoCounts:    make(map[string]int),
+		FieldErrors:   make(map[string]int),
+		Errors:        rejects,
+	}
+	if report.Errors == nil {
+		report.Errors = []*postalcode.ImportError{}
 	}
 
-	// 파싱 에러가 있으면 출력
-	if len(parseErrors) > 0 {
-		fmt.Printf("⚠️  파싱 중 %d개 에러 발생:\n", len(parseErrors))
-		for i, errMsg := range parseErrors {
-			if i < 10 { // 최대 10개만 출력
-				fmt.Printf("  - %s\n", errMsg)
-			}
-		}
-		if len(parseErrors) > 10 {
-			fmt.Printf("  ... 외 %d개\n", len(parseErrors)-10)
+	for _, rerr := range rejects {
+		field := rerr.Field
+		if field == "" {
+			field = recordField
 		}
+		report.FieldErrors[field]++
 	}
-
-	return lands, nil
+	return report
 }
@@ -1,15 +1,26 @@
 package importer
 
 import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	postalcode "github.com/oursportsnation/korean-postalcode"
 	"github.com/oursportsnation/korean-postalcode/internal/repository"
 	"github.com/oursportsnation/korean-postalcode/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/transform"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -18,7 +29,8 @@ func setupTestImporter(t *testing.T) Importer {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{})
+	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.PostalCodeBuilding{},
+		&postalcode.PostalCodePOBox{}, &postalcode.PostalCodeBulkDelivery{}, &postalcode.ImportRun{}, &postalcode.ImportReject{})
 	require.NoError(t, err)
 
 	repo := repository.New(db)
@@ -104,7 +116,7 @@ func TestImporter_ImportFromFile_BatchProcessing(t *testing.T) {
 	require.NoError(t, err)
 	defer os.Remove(tmpFile.Name())
 
-	// Write header + 5 valid rows
+	// Write header + 5 rows (first row has no road name)
 	content := `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류
 01000|서울특별시|Seoul|강북구|Gangbuk-gu||||||93|0|126|0|3
 01001|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로1|Samyang-ro1|0|1|0|999|0|1
@@ -119,8 +131,8 @@ func TestImporter_ImportFromFile_BatchProcessing(t *testing.T) {
 	// Test with batch size of 2
 	result, err := imp.ImportFromFile(tmpFile.Name(), 2, nil)
 	assert.NoError(t, err)
-	assert.Equal(t, 5, result.TotalCount)
-	assert.Equal(t, 0, result.ErrorCount)
+	assert.Equal(t, 4, result.TotalCount)
+	assert.Equal(t, 1, result.ErrorCount) // road name is required
 }
 
 func TestImporter_ImportFromFile_ProgressCallback(t *testing.T) {
@@ -151,6 +163,65 @@ func TestImporter_ImportFromFile_NilProgressCallback(t *testing.T) {
 	assert.NotNil(t, result)
 }
 
+func TestImporter_ImportFromReader(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"))
+	require.NoError(t, err)
+
+	var lastCurrent, lastTotal int
+	result, err := imp.ImportFromReader(strings.NewReader(string(content)), int64(len(content)), 1, func(current, total int) {
+		lastCurrent, lastTotal = current, total
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 0, result.ErrorCount)
+	assert.Equal(t, 2, lastCurrent)
+	assert.Equal(t, 2, lastTotal)
+
+	// 크기를 모르는 reader (size=0)
+	result, err = imp.ImportFromReader(iotest.OneByteReader(strings.NewReader(string(content))), 0, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+
+	_, err = imp.ImportFromReader(strings.NewReader(""), 0, 100, nil)
+	assert.ErrorIs(t, err, postalcode.ErrEmptyFile)
+}
+
+func TestImporter_ImportFromReader_DuplicateKeysInBatch(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	content := "우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류\n" +
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|999|0|1\n" +
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n" +
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|800|0|1\n"
+
+	result, err := imp.ImportFromReader(strings.NewReader(content), int64(len(content)), 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount) // 저장된 행 수는 중복 제거 후 건수
+	assert.Equal(t, 1, result.DuplicateCount)
+	assert.Equal(t, 0, result.ErrorCount)
+
+	// 같은 키는 마지막 행이 저장됨
+	roads, err := imp.(*importer).service.GetByZipCode("01001")
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+	require.NotNil(t, roads[0].EndBuildingMain)
+	assert.Equal(t, 800, *roads[0].EndBuildingMain)
+}
+
+func TestImporter_ParseReader(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"))
+	require.NoError(t, err)
+
+	roads, err := imp.ParseReader(strings.NewReader(string(content)))
+	require.NoError(t, err)
+	require.Len(t, roads, 2)
+	assert.Equal(t, "서울특별시", roads[0].SidoName)
+}
+
 // ============================================================
 // Land Address Import Tests
 // ============================================================
@@ -231,6 +302,24 @@ func TestImporter_ImportLandFromFile_BatchProcessing(t *testing.T) {
 	assert.Equal(t, 0, result.ErrorCount)
 }
 
+func TestImporter_ImportLandFromReader(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	file, err := os.Open(filepath.Join("..", "..", "tests", "testdata", "sample_land.txt"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	result, err := imp.ImportLandFromReader(file, 0, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.TotalCount)
+
+	lands, err := imp.ParseLandReader(strings.NewReader(testLandHeader +
+		"25627|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|모전리|0||2|0|878|0\n"))
+	require.NoError(t, err)
+	require.Len(t, lands, 1)
+	assert.Equal(t, "모전리", lands[0].RiName)
+}
+
 func TestImporter_ImportLandFromFile_ProgressCallback(t *testing.T) {
 	imp := setupTestImporter(t)
 
@@ -248,6 +337,1453 @@ func TestImporter_ImportLandFromFile_ProgressCallback(t *testing.T) {
 	assert.Equal(t, result.TotalCount, lastTotal)
 }
 
+// ============================================================
+// Building Import Tests
+// ============================================================
+
+// buildingDBLine은 헤더 없는 도로명주소 건물 DB 형식(31개 필드)의 라인을 만듭니다.
+func buildingDBLine(managementNumber, zipCode, buildingName string, buildingMain int) string {
+	fields := []string{
+		"1165010700", "서울특별시", "서초구", "반포동", "", "0", "20", "43", "116504163213", "반포대로",
+		"0", strconv.Itoa(buildingMain), "0", "래미안 퍼스티지", "101동", managementNumber, "01", "1165053000", "반포2동", zipCode,
+		"", "", "31", "20240101", "", buildingName, "1", zipCode, "0", "", "",
+	}
+	return strings.Join(fields, "|") + "\n"
+}
+
+func TestImporter_ImportBuildingFromFile_Headerless(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	path := writeTempFile(t, "build_*.txt",
+		buildingDBLine("1165010700100200043000001", "06506", "래미안퍼스티지", 275)+
+			buildingDBLine("1165010700100200043000002", "06506", "", 277)+
+			buildingDBLine("1165010700100200043000003", "065", "", 279)) // 우편번호 오류
+
+	dataType, err := imp.DetectDataType(path)
+	require.NoError(t, err)
+	assert.Equal(t, DataTypeBuilding, dataType)
+
+	result, err := imp.ImportBuildingFromFile(path, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 1, result.ErrorCount)
+
+	// 첫 라인도 데이터로 저장되어야 함
+	svc := imp.(*importer).service
+	results, total, err := svc.SearchBuildings(postalcode.SearchParamsBuilding{BuildingName: "래미안퍼스티지"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total) // 시군구용 건물명 또는 건축물대장 건물명 매칭
+	require.Len(t, results, 2)
+
+	building := results[0].PostalCodeBuilding
+	assert.Equal(t, "1165010700100200043000001", building.BuildingManagementNumber)
+	assert.Equal(t, "06506", building.ZipCode)
+	assert.Equal(t, "065", building.ZipPrefix)
+	assert.Equal(t, "1165010700", building.LegalDongCode)
+	assert.Equal(t, "반포동", building.EupmyeondongName)
+	assert.Equal(t, "반포2동", building.HaengjeongdongName)
+	assert.Equal(t, "116504163213", building.RoadCode)
+	assert.Equal(t, 275, building.BuildingMain)
+	assert.Nil(t, building.BuildingSub)
+	assert.Equal(t, 20, building.JibunMain)
+	require.NotNil(t, building.JibunSub)
+	assert.Equal(t, 43, *building.JibunSub)
+	assert.Equal(t, "래미안 퍼스티지", building.BuildingLedgerName)
+	assert.Equal(t, "101동", building.DetailBuildingName)
+	assert.True(t, building.IsApartment)
+	assert.Equal(t, "서울특별시 서초구 반포대로 275 (반포동, 래미안퍼스티지)", results[0].RoadAddress)
+	assert.Equal(t, "서울특별시 서초구 반포동 20-43 래미안퍼스티지", results[0].JibunAddress)
+}
+
+func TestImporter_ImportBuildingFromFile_Header(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	path := writeTempFile(t, "building_*.txt", "건물관리번호|우편번호|시도명|시군구명|법정동명|산여부|지번본번|지번부번|도로명코드|도로명|지하여부|건물본번|건물부번|시군구용건물명|공동주택여부\n"+
+		"1171010100100400000000001|05551|서울특별시|송파구|신천동|0|29||117103123008|올림픽로|0|300||롯데월드타워|0\n"+
+		"1171010100100400000000002|05551|서울특별시|송파구|신천동|0|29||117103123008|올림픽로|2|300|1|롯데월드몰|0\n")
+
+	result, err := imp.ImportBuildingFromFile(path, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 0, result.ErrorCount)
+
+	svc := imp.(*importer).service
+	results, _, err := svc.SearchBuildings(postalcode.SearchParamsBuilding{BuildingName: "롯데월드몰"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].IsUnderground) // 2(공중)는 지상으로 취급
+	assert.Equal(t, "서울특별시 송파구 올림픽로 300-1 (신천동)", results[0].RoadAddress)
+
+	// 도로명주소 파일을 건물로 import하면 필수 컬럼 누락
+	_, err = imp.ImportBuildingFromFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"), 100, nil)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+}
+
+func TestImporter_ValidateBuilding(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	path := writeTempFile(t, "build_*.txt",
+		buildingDBLine("1165010700100200043000001", "06506", "래미안퍼스티지", 275)+
+			buildingDBLine("1165010700100200043000001", "06506", "래미안퍼스티지", 275)+
+			buildingDBLine("1165010700100200043000002", "06506", "", -1)[:20]) // 필드 수 부족
+
+	report, err := imp.ValidateBuilding(path)
+	require.NoError(t, err)
+	assert.Equal(t, DataTypeBuilding, report.DataType)
+	assert.Equal(t, 3, report.TotalLines)
+	assert.Equal(t, 2, report.ValidCount)
+	assert.Equal(t, 1, report.RejectedCount)
+	assert.Equal(t, 1, report.DuplicateKeys)
+	assert.Equal(t, 1, report.UniqueZipCodes)
+}
+
+func TestImporter_ImportDataset_WithBuildings(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "서울특별시.txt"), []byte(testDatasetFiles["서울특별시.txt"]), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build_seoul.txt"), []byte(
+		buildingDBLine("1165010700100200043000001", "06506", "래미안퍼스티지", 275)), 0o644))
+
+	result, err := imp.ImportDataset(dir, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.RoadCount)
+	assert.Equal(t, 1, result.BuildingCount)
+	require.Len(t, result.Files, 2)
+	assert.Equal(t, DataTypeBuilding, result.Files[0].DataType)
+
+	reports, err := imp.ValidateDataset(dir)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, DataTypeBuilding, reports[0].DataType)
+	assert.Equal(t, 1, reports[0].ValidCount)
+}
+
+// ============================================================
+// PO Box / Bulk Delivery Import Tests
+// ============================================================
+
+const (
+	testPOBoxFile = "우편번호|시도|시군구|읍면동|리|사서함명|시작번호(주)|시작번호(부)|끝번호(주)|끝번호(부)\n" +
+		"03154|서울특별시|종로구|세종로||광화문우체국사서함|1||100|\n" +
+		"03154|서울특별시|종로구|세종로||광화문우체국사서함|101||200|\n" +
+		"0315|서울특별시|종로구|세종로||광화문우체국사서함|201||300|\n" // 우편번호 오류
+
+	testBulkFile = "우편번호|시도|시군구|읍면|도로명|지하여부|건물번호본번|건물번호부번|다량배달처명\n" +
+		"03171|서울특별시|종로구||세종대로|0|209||정부서울청사\n" +
+		"30116|세종특별자치시|||도움6로|0|11||정부세종청사\n"
+)
+
+func TestImporter_ImportPOBoxFromFile(t *testing.T) {
+	imp := setupTestImporter(t)
+	path := writeTempFile(t, "pobox_*.txt", testPOBoxFile)
+
+	dataType, err := imp.DetectDataType(path)
+	require.NoError(t, err)
+	assert.Equal(t, DataTypePOBox, dataType)
+
+	result, err := imp.ImportPOBoxFromFile(path, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 1, result.ErrorCount)
+
+	svc := imp.(*importer).service
+	poBoxes, err := svc.GetPOBoxByZipCode("03154")
+	require.NoError(t, err)
+	require.Len(t, poBoxes, 2)
+	assert.Equal(t, "광화문우체국사서함", poBoxes[0].POBoxName)
+	assert.Equal(t, 1, poBoxes[0].StartNumberMain)
+	require.NotNil(t, poBoxes[0].EndNumberMain)
+	assert.Equal(t, 100, *poBoxes[0].EndNumberMain)
+
+	check, err := svc.CheckZipCode("03154")
+	require.NoError(t, err)
+	assert.Equal(t, postalcode.ZipCodeKindPOBox, check.Kind)
+
+	report, err := imp.ValidatePOBox(path)
+	require.NoError(t, err)
+	assert.Equal(t, DataTypePOBox, report.DataType)
+	assert.Equal(t, 2, report.ValidCount)
+	assert.Equal(t, 1, report.RejectedCount)
+}
+
+func TestImporter_ImportBulkDeliveryFromFile(t *testing.T) {
+	imp := setupTestImporter(t)
+	path := writeTempFile(t, "bulk_*.txt", testBulkFile)
+
+	dataType, err := imp.DetectDataType(path)
+	require.NoError(t, err)
+	assert.Equal(t, DataTypeBulk, dataType)
+
+	result, err := imp.ImportBulkDeliveryFromFile(path, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 0, result.ErrorCount)
+
+	svc := imp.(*importer).service
+	deliveries, err := svc.GetBulkDeliveryByZipCode("03171")
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, "정부서울청사", deliveries[0].DeliveryName)
+	assert.Equal(t, "세종대로", deliveries[0].RoadName)
+	assert.Equal(t, 209, deliveries[0].BuildingMain)
+
+	// append는 기존 데이터 유지
+	appendPath := writeTempFile(t, "bulk_*.txt", "우편번호|시도|다량배달처명\n04383|서울특별시|국방부\n")
+	_, err = imp.AppendBulkDeliveryFromFile(appendPath, 100, nil)
+	require.NoError(t, err)
+	_, total, err := svc.SearchBulkDeliveries(postalcode.SearchParamsBulkDelivery{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+}
+
+func TestImporter_ImportDataset_WithPOBoxAndBulk(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "서울특별시.txt"), []byte(testDatasetFiles["서울특별시.txt"]), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "사서함.txt"), []byte(testPOBoxFile), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "다량배달처.txt"), []byte(testBulkFile), 0o644))
+
+	result, err := imp.ImportDataset(dir, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.RoadCount)
+	assert.Equal(t, 2, result.POBoxCount)
+	assert.Equal(t, 2, result.BulkCount)
+	assert.Equal(t, 1, result.ErrorCount)
+	require.Len(t, result.Files, 3)
+}
+
+// ============================================================
+// Diff Import Tests
+// ============================================================
+
+// writeTempFile은 테스트용 임시 파일을 생성합니다.
+func writeTempFile(t *testing.T, pattern, content string) string {
+	tmpFile, err := os.CreateTemp("", pattern)
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Remove(tmpFile.Name()) })
+
+	_, err = tmpFile.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, tmpFile.Close())
+	return tmpFile.Name()
+}
+
+func TestImporter_DiffImportFromFile(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	header := "우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류\n"
+	initial := writeTempFile(t, "diff_initial_*.txt", header+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|1|0|99|0|1\n"+
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n")
+
+	result, err := imp.ImportFromFile(initial, 100, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, result.TotalCount)
+	assert.Nil(t, result.Changeset)
+
+	// 01000: 변경 없음, 01001: 끝건물번호 변경, 06000: 삭제, 06001: 추가
+	next := writeTempFile(t, "diff_next_*.txt", header+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|1|0|150|0|1\n"+
+		"06001|서울특별시|Seoul|강남구|Gangnam-gu|||역삼로|Yeoksam-ro|0|1|0|300|0|1\n")
+
+	var lastCurrent, lastTotal int
+	result, err = imp.DiffImportFromFile(next, 1, func(current, total int) {
+		lastCurrent, lastTotal = current, total
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Changeset)
+
+	cs := result.Changeset
+	assert.Equal(t, 1, cs.Added)
+	assert.Equal(t, 1, cs.Updated)
+	assert.Equal(t, 1, cs.Removed)
+	assert.Equal(t, 1, cs.Unchanged)
+	assert.Equal(t, []string{"06001|서울특별시|강남구|역삼로|1"}, cs.SampleAdded)
+	assert.Equal(t, []string{"01001|서울특별시|강북구|도봉로|1"}, cs.SampleUpdated)
+	assert.Equal(t, []string{"06000|서울특별시|강남구|테헤란로|1"}, cs.SampleRemoved)
+	assert.Equal(t, 2, result.TotalCount) // 추가 + 변경
+	assert.Equal(t, 0, result.ErrorCount)
+	assert.Equal(t, 3, lastCurrent)
+	assert.Equal(t, 3, lastTotal)
+
+	// 최종 상태 확인
+	var stored []string
+	var updatedEnd int
+	err = imp.(*importer).service.ScanRoads(postalcode.SearchParams{}, 100, func(batch []postalcode.PostalCodeRoad) error {
+		for _, road := range batch {
+			stored = append(stored, road.ZipCode)
+			if road.ZipCode == "01001" {
+				updatedEnd = *road.EndBuildingMain
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"01000", "01001", "06001"}, stored)
+	assert.Equal(t, 150, updatedEnd)
+
+	// 같은 파일로 다시 실행하면 변경 없음
+	result, err = imp.DiffImportFromFile(next, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Changeset.Added+result.Changeset.Updated+result.Changeset.Removed)
+	assert.Equal(t, 3, result.Changeset.Unchanged)
+}
+
+func TestImporter_DiffImportLandFromFile(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	testDataPath := filepath.Join("..", "..", "tests", "testdata", "sample_land.txt")
+	_, err := imp.ImportLandFromFile(testDataPath, 100, nil)
+	require.NoError(t, err)
+
+	// 모전리: 행정동 변경, 심곡리: 유지, 안인리: 삭제
+	next := writeTempFile(t, "diff_land_*.txt", `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면동명|읍면동명(영문)|리명|산여부|행정동명|지번본번(시작)|지번부번(시작)|지번본번(종료)|지번부번(종료)
+25627|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|모전리|0|강동면|2|0|878|0
+25628|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|심곡리|0||1|0|999|0
+`)
+
+	result, err := imp.DiffImportLandFromFile(next, 100, nil)
+	require.NoError(t, err)
+	require.NotNil(t, result.Changeset)
+	assert.Equal(t, 0, result.Changeset.Added)
+	assert.Equal(t, 1, result.Changeset.Updated)
+	assert.Equal(t, 1, result.Changeset.Removed)
+	assert.Equal(t, 1, result.Changeset.Unchanged)
+	assert.Equal(t, []string{"25629|강원특별자치도|강릉시|강동면|안인리|false|1"}, result.Changeset.SampleRemoved)
+}
+
+func TestImporter_DiffImport_SafetyLimits(t *testing.T) {
+	imp := setupTestImporter(t)
+	svc := imp.(*importer).service
+
+	initial := writeTempFile(t, "diff_guard_initial_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|1|0|99|0|1\n"+
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n")
+	_, err := imp.ImportFromFile(initial, 100, nil)
+	require.NoError(t, err)
+
+	// 유효 행 없음: 헤더만 있거나 모든 행이 거부되면 전체 삭제가 되므로 중단
+	empty := writeTempFile(t, "diff_guard_empty_*.txt", testRoadHeader)
+	_, err = imp.DiffImportFromFile(empty, 100, nil)
+	assert.ErrorIs(t, err, postalcode.ErrUnsafeDiff)
+
+	// 거부 비율 초과: 거부된 행의 키는 삭제 대상이 되므로 중단
+	rejected := writeTempFile(t, "diff_guard_rejects_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|X|0|99|0|1\n"+
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n")
+	_, err = imp.DiffImportFromFile(rejected, 100, nil)
+	assert.ErrorIs(t, err, postalcode.ErrUnsafeDiff)
+
+	// 삭제 비율 초과: 기존 3건 중 2건 삭제
+	shrunk := writeTempFile(t, "diff_guard_shrunk_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n")
+	_, err = imp.DiffImportFromFile(shrunk, 100, nil)
+	assert.ErrorIs(t, err, postalcode.ErrUnsafeDiff)
+
+	// 중단된 실행은 아무것도 반영하지 않고 실패로 기록
+	roads, _ := countRows(t, imp)
+	assert.Equal(t, 3, roads)
+	run, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, postalcode.ImportModeReplace, run.Mode)
+	runs, err := svc.ListImportRuns(10)
+	require.NoError(t, err)
+	assert.Equal(t, postalcode.ImportOutcomeFailed, runs[0].Outcome)
+	assert.Contains(t, runs[0].ErrorMessage, "would remove 2 of 3 existing rows")
+
+	// 한도를 높이면 거부 행이 있어도 반영 (거부된 01001은 삭제)
+	result, err := New(svc, WithDiffLimits(0.5, 0.5)).DiffImportFromFile(rejected, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Changeset.Removed)
+	assert.Equal(t, 1, result.ErrorCount)
+
+	// 강제 반영
+	result, err = New(svc, WithForceDiff()).DiffImportFromFile(empty, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Changeset.Removed)
+	roads, _ = countRows(t, imp)
+	assert.Equal(t, 0, roads)
+}
+
+// nthFailingService는 n번째 BatchUpsert 호출만 실패시킵니다.
+type nthFailingService struct {
+	service.Service
+	n, calls int
+}
+
+func (s *nthFailingService) BatchUpsert(roads []postalcode.PostalCodeRoad) error {
+	s.calls++
+	if s.calls == s.n {
+		return errors.New("deadlock found")
+	}
+	return s.Service.BatchUpsert(roads)
+}
+
+func TestImporter_DiffImport_CountsCommittedBatches(t *testing.T) {
+	imp := setupTestImporter(t)
+	svc := imp.(*importer).service
+
+	initial := writeTempFile(t, "diff_commit_initial_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|1|0|99|0|1\n")
+	_, err := imp.ImportFromFile(initial, 100, nil)
+	require.NoError(t, err)
+
+	// 01001 변경(배치 1, 실패), 06000 추가(배치 2), 06001 추가(배치 3)
+	next := writeTempFile(t, "diff_commit_next_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|1|0|150|0|1\n"+
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n"+
+		"06001|서울특별시|Seoul|강남구|Gangnam-gu|||역삼로|Yeoksam-ro|0|1|0|300|0|1\n")
+	result, err := New(&nthFailingService{Service: svc, n: 1}, WithDiffLimits(0.5, 0.5)).DiffImportFromFile(next, 1, nil)
+	require.NoError(t, err)
+
+	cs := result.Changeset
+	assert.Equal(t, 2, cs.Added)
+	assert.Equal(t, 0, cs.Updated)
+	assert.Empty(t, cs.SampleUpdated)
+	assert.Equal(t, 1, cs.Unchanged)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 1, result.ErrorCount)
+
+	run, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, 2, run.InsertedCount)
+	assert.Equal(t, 0, run.UpdatedCount)
+}
+
+// nthDeleteFailingService는 n번째 BatchDelete 호출만 실패시킵니다.
+type nthDeleteFailingService struct {
+	service.Service
+	n, calls int
+}
+
+func (s *nthDeleteFailingService) BatchDelete(ids []uint) error {
+	s.calls++
+	if s.calls == s.n {
+		return errors.New("lock wait timeout")
+	}
+	return s.Service.BatchDelete(ids)
+}
+
+func TestImporter_DiffImport_DeleteFailureReturnsPartialResult(t *testing.T) {
+	imp := setupTestImporter(t)
+	svc := imp.(*importer).service
+
+	initial := writeTempFile(t, "diff_delete_initial_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|1|0|99|0|1\n"+
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n")
+	_, err := imp.ImportFromFile(initial, 100, nil)
+	require.NoError(t, err)
+
+	// 07000 추가, 01001/06000 삭제(배치 1 성공, 배치 2 실패)
+	next := writeTempFile(t, "diff_delete_next_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"07000|서울특별시|Seoul|영등포구|Yeongdeungpo-gu|||국회대로|Gukhoe-daero|0|1|0|99|0|1\n")
+	result, err := New(&nthDeleteFailingService{Service: svc, n: 2}, WithForceDiff()).DiffImportFromFile(next, 1, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "lock wait timeout")
+	require.NotNil(t, result)
+
+	cs := result.Changeset
+	assert.Equal(t, 1, cs.Added)
+	assert.Equal(t, 1, cs.Removed)
+	assert.Len(t, cs.SampleRemoved, 1)
+	assert.Equal(t, 1, result.TotalCount)
+
+	roads, _ := countRows(t, imp)
+	assert.Equal(t, 3, roads)
+
+	runs, err := svc.ListImportRuns(1)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, postalcode.ImportOutcomeFailed, runs[0].Outcome)
+	assert.Equal(t, 1, runs[0].InsertedCount)
+	assert.Equal(t, 1, runs[0].DeletedCount)
+}
+
+// ============================================================
+// Validation (Dry-Run) Tests
+// ============================================================
+
+func TestImporter_Validate(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	path := writeTempFile(t, "validate_*.txt", `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류
+01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1
+01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1
+06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|abc|0|500|0|1
+06001|서울특별시|Seoul|강남구|Gangnam-gu|||역삼로|Yeoksam-ro|2|1|0|300|0|1
+48000|부산광역시|Busan|해운대구|Haeundae-gu||||||1|0|10|0|1
+06002|서울특별시|Seoul|강남구
+`)
+
+	report, err := imp.Validate(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "road", report.DataType)
+	assert.Equal(t, 6, report.TotalLines)
+	assert.Equal(t, 2, report.ValidCount)
+	assert.Equal(t, 4, report.RejectedCount)
+	assert.Equal(t, 1, report.DuplicateKeys)
+	assert.Equal(t, 1, report.UniqueZipCodes)
+	assert.Equal(t, map[string]int{"서울특별시": 2}, report.SidoCounts)
+	assert.Equal(t, map[string]int{
+		"start_building_main": 1,
+		"is_underground":      1,
+		"road_name":           1,
+		"_record":             1,
+	}, report.FieldErrors)
+
+	require.Len(t, report.Errors, 4)
+	assert.Equal(t, 4, report.Errors[0].Line)
+	assert.Equal(t, "start_building_main", report.Errors[0].Field)
+	assert.Equal(t, "abc", report.Errors[0].Value)
+	assert.Equal(t, 5, report.Errors[1].Line)
+	assert.Equal(t, "is_underground", report.Errors[1].Field)
+	assert.Equal(t, "2", report.Errors[1].Value)
+	assert.Equal(t, 6, report.Errors[2].Line)
+	assert.Equal(t, "road_name", report.Errors[2].Field)
+	assert.Equal(t, 7, report.Errors[3].Line)
+	assert.Empty(t, report.Errors[3].Field)
+
+	// 검증은 DB를 변경하지 않음
+	count := 0
+	err = imp.(*importer).service.ScanRoads(postalcode.SearchParams{}, 100, func(batch []postalcode.PostalCodeRoad) error {
+		count += len(batch)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestImporter_ValidateLand(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	testDataPath := filepath.Join("..", "..", "tests", "testdata", "sample_land.txt")
+	report, err := imp.ValidateLand(testDataPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, "land", report.DataType)
+	assert.Equal(t, 3, report.ValidCount)
+	assert.Equal(t, 0, report.RejectedCount)
+	assert.Equal(t, 3, report.UniqueZipCodes)
+	assert.NotNil(t, report.Errors)
+}
+
+func TestImporter_Validate_ReportJSON(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	path := writeTempFile(t, "validate_json_*.txt", `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류
+0100|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1
+`)
+
+	report, err := imp.Validate(path)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(report.Errors)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"line":2,"field":"zip_code","value":"0100","reason":"zip code must be 5 digits"}]`, string(data))
+}
+
+// ============================================================
+// Encoding Tests
+// ============================================================
+
+// writeCP949File은 content를 CP949로 인코딩하여 임시 파일로 저장합니다.
+func writeCP949File(t *testing.T, pattern, content string) string {
+	encoded, _, err := transform.String(korean.EUCKR.NewEncoder(), content)
+	require.NoError(t, err)
+	return writeTempFile(t, pattern, encoded)
+}
+
+func TestImporter_ImportFromFile_CP949(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"))
+	require.NoError(t, err)
+	path := writeCP949File(t, "cp949_*.txt", string(content))
+
+	report, err := imp.Validate(path)
+	require.NoError(t, err)
+	assert.Equal(t, "cp949", report.Encoding)
+	assert.Equal(t, 0, report.RejectedCount)
+
+	roads, err := imp.ParseFile(path)
+	require.NoError(t, err)
+	require.Len(t, roads, 2)
+	assert.Equal(t, "서울특별시", roads[0].SidoName)
+	assert.Equal(t, "강북구", roads[0].SigunguName)
+
+	result, err := imp.ImportFromFile(path, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 0, result.ErrorCount)
+}
+
+func TestImporter_ImportLandFromFile_CP949(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "tests", "testdata", "sample_land.txt"))
+	require.NoError(t, err)
+	path := writeCP949File(t, "cp949_land_*.txt", string(content))
+
+	lands, err := imp.ParseLandFile(path)
+	require.NoError(t, err)
+	require.Len(t, lands, 3)
+	assert.Equal(t, "강원특별자치도", lands[0].SidoName)
+	assert.Equal(t, "모전리", lands[0].RiName)
+}
+
+func TestImporter_UTF8BOM(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"))
+	require.NoError(t, err)
+	path := writeTempFile(t, "bom_*.txt", "\xEF\xBB\xBF"+string(content))
+
+	report, err := imp.Validate(path)
+	require.NoError(t, err)
+	assert.Equal(t, "utf-8", report.Encoding)
+	assert.Equal(t, 2, report.ValidCount)
+}
+
+func TestImporter_WithEncoding(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	svc := service.New(repository.New(db))
+
+	content, err := os.ReadFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"))
+	require.NoError(t, err)
+	path := writeCP949File(t, "cp949_forced_*.txt", string(content))
+
+	// CP949 파일을 UTF-8로 강제하면 헤더가 깨져 레이아웃을 인식할 수 없음
+	_, err = New(svc, WithEncoding(EncodingUTF8)).ParseFile(path)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+
+	roads, err := New(svc, WithEncoding(EncodingCP949)).ParseFile(path)
+	require.NoError(t, err)
+	require.Len(t, roads, 2)
+	assert.Equal(t, "서울특별시", roads[0].SidoName)
+}
+
+func TestDetectEncoding(t *testing.T) {
+	cp949, _, err := transform.String(korean.EUCKR.NewEncoder(), "서울특별시|강남구|테헤란로")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		sample  []byte
+		want    Encoding
+		wantErr bool
+	}{
+		{"ascii", []byte("01000|Seoul"), EncodingUTF8, false},
+		{"utf-8", []byte("01000|서울특별시"), EncodingUTF8, false},
+		{"utf-8 truncated rune", []byte("서울")[:5], EncodingUTF8, false},
+		{"cp949", []byte(cp949), EncodingCP949, false},
+		{"cp949 truncated lead byte", []byte(cp949)[:5], EncodingCP949, false},
+		{"binary", []byte{0x80, 0x00, 0xFF, 0x20}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := detectEncoding(tt.sample)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, postalcode.ErrUnsupportedEncoding)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseEncoding(t *testing.T) {
+	for name, want := range map[string]Encoding{
+		"":       EncodingAuto,
+		"auto":   EncodingAuto,
+		"UTF-8":  EncodingUTF8,
+		"utf8":   EncodingUTF8,
+		"EUC-KR": EncodingCP949,
+		"cp949":  EncodingCP949,
+		"MS949":  EncodingCP949,
+	} {
+		got, err := ParseEncoding(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+
+	_, err := ParseEncoding("latin1")
+	assert.ErrorIs(t, err, postalcode.ErrUnsupportedEncoding)
+}
+
+// ============================================================
+// Dataset Import Tests
+// ============================================================
+
+const (
+	testRoadHeader = "우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류\n"
+	testLandHeader = "우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면동명|읍면동명(영문)|리명|산여부|행정동명|지번본번(시작)|지번부번(시작)|지번본번(종료)|지번부번(종료)\n"
+)
+
+// testDatasetFiles는 시도별로 나뉜 데이터셋 파일 내용입니다.
+var testDatasetFiles = map[string]string{
+	"서울특별시.txt": testRoadHeader +
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n" +
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n",
+	"부산광역시.txt": testRoadHeader +
+		"48000|부산광역시|Busan|해운대구|Haeundae-gu|||해운대로|Haeundae-ro|0|1|0|999|0|1\n" +
+		"48001|부산광역시|Busan|해운대구|Haeundae-gu||||||1|0|10|0|1\n",
+	"강원특별자치도_지번.txt": testLandHeader +
+		"25627|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|모전리|0||2|0|878|0\n",
+	"README.md": "not a data file\n",
+}
+
+// writeTestDataset은 testDatasetFiles를 임시 디렉토리에 저장합니다.
+func writeTestDataset(t *testing.T) string {
+	dir := t.TempDir()
+	for name, content := range testDatasetFiles {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+// countRows는 도로명/지번 테이블의 행 수를 반환합니다.
+func countRows(t *testing.T, imp Importer) (roads, lands int) {
+	svc := imp.(*importer).service
+	require.NoError(t, svc.ScanRoads(postalcode.SearchParams{}, 100, func(batch []postalcode.PostalCodeRoad) error {
+		roads += len(batch)
+		return nil
+	}))
+	require.NoError(t, svc.ScanLands(postalcode.SearchParamsLand{}, 100, func(batch []postalcode.PostalCodeLand) error {
+		lands += len(batch)
+		return nil
+	}))
+	return roads, lands
+}
+
+func TestImporter_ImportDataset_Directory(t *testing.T) {
+	imp := setupTestImporter(t)
+	dir := writeTestDataset(t)
+
+	// 기존 데이터는 교체되어야 함
+	_, err := imp.ImportFromFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"), 100, nil)
+	require.NoError(t, err)
+
+	var lastCurrent, lastTotal int
+	result, err := imp.ImportDataset(dir, 1, func(current, total int) {
+		lastCurrent, lastTotal = current, total
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.RoadCount)
+	assert.Equal(t, 1, result.LandCount)
+	assert.Equal(t, 1, result.ErrorCount)
+	assert.Equal(t, 4, lastCurrent)
+	assert.Equal(t, 4, lastTotal)
+
+	require.Len(t, result.Files, 3)
+	assert.Equal(t, filepath.Join(dir, "강원특별자치도_지번.txt"), result.Files[0].FileName)
+	assert.Equal(t, DataTypeLand, result.Files[0].DataType)
+	assert.Equal(t, DataTypeRoad, result.Files[1].DataType)
+	assert.Equal(t, 1, result.Files[1].TotalCount) // 부산광역시.txt
+	assert.Equal(t, 1, result.Files[1].ErrorCount)
+	assert.Equal(t, 2, result.Files[2].TotalCount) // 서울특별시.txt
+	assert.Equal(t, "utf-8", result.Files[2].Encoding)
+
+	// 파일마다 truncate되지 않고 모든 파일의 데이터가 남아 있어야 함
+	roads, lands := countRows(t, imp)
+	assert.Equal(t, 3, roads)
+	assert.Equal(t, 1, lands)
+}
+
+func TestImporter_ImportDataset_Zip(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	zipPath := filepath.Join(t.TempDir(), "dataset.zip")
+	zipFile, err := os.Create(zipPath)
+	require.NoError(t, err)
+	zw := zip.NewWriter(zipFile)
+	for name, content := range testDatasetFiles {
+		w, err := zw.Create("range/" + name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	require.NoError(t, zipFile.Close())
+
+	result, err := imp.ImportDataset(zipPath, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.RoadCount)
+	assert.Equal(t, 1, result.LandCount)
+	require.Len(t, result.Files, 3)
+	assert.Equal(t, "dataset.zip:range/강원특별자치도_지번.txt", result.Files[0].FileName)
+
+	reports, err := imp.ValidateDataset(zipPath)
+	require.NoError(t, err)
+	require.Len(t, reports, 3)
+	assert.Equal(t, DataTypeLand, reports[0].DataType)
+	assert.Equal(t, 1, reports[1].RejectedCount)
+}
+
+func TestImporter_ImportDataset_Glob(t *testing.T) {
+	imp := setupTestImporter(t)
+	dir := writeTestDataset(t)
+
+	result, err := imp.ImportDataset(filepath.Join(dir, "서울*.txt"), 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.RoadCount)
+	assert.Equal(t, 0, result.LandCount)
+	require.Len(t, result.Files, 1)
+
+	_, err = imp.ImportDataset(filepath.Join(dir, "*.csv"), 100, nil)
+	assert.ErrorIs(t, err, postalcode.ErrEmptyFile)
+}
+
+func TestImporter_ImportDataset_UnknownHeaderKeepsData(t *testing.T) {
+	imp := setupTestImporter(t)
+	dir := writeTestDataset(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unknown.txt"), []byte("a|b|c\n1|2|3\n"), 0o644))
+
+	_, err := imp.ImportFromFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"), 100, nil)
+	require.NoError(t, err)
+
+	_, err = imp.ImportDataset(dir, 100, nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+	assert.Contains(t, err.Error(), "unknown.txt")
+
+	// 파싱 단계에서 실패하면 기존 데이터는 유지
+	roads, _ := countRows(t, imp)
+	assert.Equal(t, 2, roads)
+}
+
+func TestIsDataset(t *testing.T) {
+	dir := t.TempDir()
+	assert.True(t, IsDataset(dir))
+	assert.True(t, IsDataset(filepath.Join(dir, "*.txt")))
+	assert.True(t, IsDataset("range.zip"))
+	assert.False(t, IsDataset(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt")))
+}
+
+// ============================================================
+// Column Mapping Tests
+// ============================================================
+
+func TestResolveLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{"road (legacy headers)", testRoadHeader, DataTypeRoad},
+		{"land (legacy headers)", testLandHeader, DataTypeLand},
+		{"road (official headers)", "구역번호|시도|시도영문|시군구|시군구영문|읍면|읍면영문|도로명|도로명영문|지하여부|시작건물번호(주)|시작건물번호(부)|끝건물번호(주)|끝건물번호(부)|범위종류", DataTypeRoad},
+		{"land (official headers)", "구역번호|시도|시도영문|시군구|시군구영문|읍면동|읍면동영문|리명|산여부|행정동|시작주번지|시작부번지|끝주번지|끝부번지", DataTypeLand},
+		{"road (english headers)", "Zip_Code|SIDO_NAME|sigungu_name|road_name|start_building_main", DataTypeRoad},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := resolveLayout(strings.Split(strings.TrimSpace(tt.header), "|"), "", nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, l.dataType)
+			assert.Equal(t, 0, l.columns["zip_code"])
+		})
+	}
+
+	// 타입을 판별할 수 없는 헤더
+	_, err := resolveLayout([]string{"a", "b"}, "", nil)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+
+	// 필수 컬럼 누락
+	_, err = resolveLayout([]string{"우편번호", "시도", "도로명"}, "", nil)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+	assert.Contains(t, err.Error(), "sigungu_name")
+
+	// 기대한 타입과 다른 파일
+	_, err = resolveLayout(strings.Split(strings.TrimSpace(testLandHeader), "|"), DataTypeRoad, nil)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+
+	// 중복 컬럼
+	_, err = resolveLayout([]string{"우편번호", "구역번호", "시도", "시군구", "도로명", "시작건물번호(주)"}, "", nil)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+}
+
+func TestImporter_ReorderedColumns(t *testing.T) {
+	imp := setupTestImporter(t)
+
+	// 컬럼 순서가 바뀌고 알 수 없는 컬럼이 추가된 파일
+	path := writeTempFile(t, "reordered_*.txt", "도로명|비고|구역번호|시도|시군구|시작건물번호(주)|끝건물번호(주)|범위종류\n"+
+		"삼양로|memo|01000|서울특별시|강북구|1|99|1\n")
+
+	roads, err := imp.ParseFile(path)
+	require.NoError(t, err)
+	require.Len(t, roads, 1)
+	assert.Equal(t, "01000", roads[0].ZipCode)
+	assert.Equal(t, "010", roads[0].ZipPrefix)
+	assert.Equal(t, "삼양로", roads[0].RoadName)
+	assert.Equal(t, 1, roads[0].StartBuildingMain)
+	require.NotNil(t, roads[0].EndBuildingMain)
+	assert.Equal(t, 99, *roads[0].EndBuildingMain)
+	assert.Equal(t, int8(1), roads[0].RangeType)
+	assert.Empty(t, roads[0].SidoNameEn)
+
+	// 지번주소 파일을 도로명주소로 import하면 truncate 전에 실패
+	_, err = imp.ImportFromFile(filepath.Join("..", "..", "tests", "testdata", "sample_land.txt"), 100, nil)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+}
+
+func TestImporter_WithColumnMapping(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.ImportRun{}, &postalcode.ImportReject{}))
+	svc := service.New(repository.New(db))
+
+	mappingPath := writeTempFile(t, "mapping_*.json", `{
+  "type": "land",
+  "columns": {"ZIP": "zip_code", "PROVINCE": "sido_name", "CITY": "sigungu_name", "TOWN": "eupmyeondong_name", "FROM": "start_jibun_main"}
+}`)
+	mapping, err := LoadColumnMapping(mappingPath)
+	require.NoError(t, err)
+
+	path := writeTempFile(t, "custom_*.txt", "ZIP|PROVINCE|CITY|TOWN|산여부|FROM\n"+
+		"25627|강원특별자치도|강릉시|강동면|1|2\n")
+
+	imp := New(svc, WithColumnMapping(mapping))
+	lands, err := imp.ParseLandFile(path)
+	require.NoError(t, err)
+	require.Len(t, lands, 1)
+	assert.Equal(t, "25627", lands[0].ZipCode)
+	assert.Equal(t, "강동면", lands[0].EupmyeondongName)
+	assert.True(t, lands[0].IsMountain) // 매핑에 없는 헤더는 기본 규칙으로 인식
+	assert.Equal(t, 2, lands[0].StartJibunMain)
+
+	// 매핑 없이는 필수 컬럼을 찾을 수 없음
+	_, err = New(svc).ParseLandFile(path)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+
+	// 잘못된 매핑 파일
+	badPath := writeTempFile(t, "bad_mapping_*.json", `{"columns": {"ZIP": "zip"}}`)
+	_, err = LoadColumnMapping(badPath)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidFileFormat)
+}
+
+// ============================================================
+// Import History Tests
+// ============================================================
+
+func TestImporter_RecordsImportRun(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	imp := New(svc, WithOperator("tester"), WithDatasetDate("2024-05-01"))
+
+	path := filepath.Join("..", "..", "tests", "testdata", "sample_road.txt")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	sum := sha256.Sum256(data)
+
+	_, err = imp.ImportFromFile(path, 100, nil)
+	require.NoError(t, err)
+
+	run, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, postalcode.ImportDataTypeRoad, run.DataType)
+	assert.Equal(t, postalcode.ImportModeReplace, run.Mode)
+	assert.Equal(t, path, run.FileName)
+	assert.Equal(t, hex.EncodeToString(sum[:]), run.FileSHA256)
+	assert.Equal(t, "tester", run.Operator)
+	assert.Equal(t, 2, run.InsertedCount)
+	assert.Equal(t, 0, run.RejectedCount)
+	assert.NotNil(t, run.FinishedAt)
+	assert.Equal(t, "2024-05-01+"+run.FileSHA256[:8], run.DatasetVersion())
+
+	// 파싱 실패는 failed로 기록되고 현재 데이터셋은 유지
+	_, err = imp.ImportFromFile(filepath.Join("..", "..", "tests", "testdata", "sample_land.txt"), 100, nil)
+	require.Error(t, err)
+
+	runs, err := svc.ListImportRuns(10)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, postalcode.ImportOutcomeFailed, runs[0].Outcome)
+	assert.Contains(t, runs[0].ErrorMessage, "file parsing failed")
+
+	current, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, run.ID, current.ID)
+}
+
+func TestImporter_HistoryOptional(t *testing.T) {
+	path := filepath.Join("..", "..", "tests", "testdata", "sample_road.txt")
+
+	// import_runs 테이블이 없으면 경고 없이 이력 기록을 건너뜀
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&postalcode.PostalCodeRoad{}))
+
+	var events []postalcode.Event
+	imp := New(service.New(repository.New(db)), WithEventHandler(postalcode.EventHandlerFunc(func(e postalcode.Event) {
+		events = append(events, e)
+	})))
+	result, err := imp.ImportFromFile(path, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+	for _, e := range events {
+		assert.NotEqual(t, postalcode.EventWarning, e.Type, e.Message)
+	}
+	finished := events[len(events)-1]
+	assert.Equal(t, postalcode.EventImportFinished, finished.Type)
+	assert.Zero(t, finished.RunID)
+	assert.False(t, db.Migrator().HasTable(&postalcode.ImportRun{}))
+
+	// WithoutHistory는 테이블이 있어도 기록하지 않으며, 재개할 수 없음
+	svc := setupTestImporter(t).(*importer).service
+	_, err = New(svc, WithoutHistory()).ImportFromFile(path, 100, nil)
+	require.NoError(t, err)
+	runs, err := svc.ListImportRuns(10)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+
+	_, err = New(svc, WithoutHistory(), WithResume()).ImportFromFile(path, 100, nil)
+	assert.ErrorIs(t, err, postalcode.ErrNoResumableImport)
+}
+
+func TestImporter_RecordsDiffAndDatasetRuns(t *testing.T) {
+	imp := setupTestImporter(t)
+	svc := imp.(*importer).service
+	dir := writeTestDataset(t)
+
+	_, err := imp.ImportDataset(dir, 100, nil)
+	require.NoError(t, err)
+
+	run, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, postalcode.ImportDataTypeDataset, run.DataType)
+	assert.Equal(t, 4, run.InsertedCount)
+	assert.Equal(t, 1, run.RejectedCount)
+	assert.Len(t, run.FileSHA256, 64)
+
+	// 같은 데이터셋은 같은 체크섬
+	_, err = imp.ImportDataset(dir, 100, nil)
+	require.NoError(t, err)
+	again, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.NotEqual(t, run.ID, again.ID)
+	assert.Equal(t, run.FileSHA256, again.FileSHA256)
+
+	// diff 모드는 추가/변경/삭제 건수를 기록
+	next := writeTempFile(t, "history_diff_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|150|0|1\n"+
+		"07000|서울특별시|Seoul|영등포구|Yeongdeungpo-gu|||국회대로|Gukhoe-daero|0|1|0|99|0|1\n")
+	_, err = New(svc, WithForceDiff()).DiffImportFromFile(next, 100, nil) // 기존 3건 중 2건 삭제 (안전 장치 한도 초과)
+	require.NoError(t, err)
+
+	run, err = svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, postalcode.ImportModeDiff, run.Mode)
+	assert.Equal(t, 1, run.InsertedCount)
+	assert.Equal(t, 1, run.UpdatedCount)
+	assert.Equal(t, 2, run.DeletedCount)
+}
+
+// ============================================================
+// Resume Tests
+// ============================================================
+
+// interruptingService는 지정한 배치 수 이후 BatchUpsert에서 panic하여 import 중단을 흉내냅니다.
+type interruptingService struct {
+	service.Service
+	batches int
+}
+
+func (s *interruptingService) BatchUpsert(roads []postalcode.PostalCodeRoad) error {
+	if s.batches == 0 {
+		panic("interrupted")
+	}
+	s.batches--
+	return s.Service.BatchUpsert(roads)
+}
+
+// interruptImport는 batches개 배치를 커밋한 뒤 중단되는 도로명주소 import를 실행합니다.
+func interruptImport(t *testing.T, svc service.Service, path string, batchSize, batches int) {
+	imp := New(&interruptingService{Service: svc, batches: batches})
+	assert.PanicsWithValue(t, "interrupted", func() {
+		_, _ = imp.ImportFromFile(path, batchSize, nil)
+	})
+}
+
+func TestImporter_Resume(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	path := writeTempFile(t, "resume_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|1|0|99|0|1\n"+
+		"0100|서울특별시|Seoul|강북구|Gangbuk-gu|||오류로|Oryu-ro|0|1|0|99|0|1\n"+
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n"+
+		"06001|서울특별시|Seoul|강남구|Gangnam-gu|||역삼로|Yeoksam-ro|0|1|0|300|0|1\n"+
+		"48000|부산광역시|Busan|해운대구|Haeundae-gu|||해운대로|Haeundae-ro|0|1|0|999|0|1\n")
+
+	// 첫 배치(2건) 커밋 후 중단
+	interruptImport(t, svc, path, 2, 1)
+
+	runs, err := svc.ListImportRuns(1)
+	require.NoError(t, err)
+	interrupted := runs[0]
+	assert.Equal(t, postalcode.ImportOutcomeRunning, interrupted.Outcome)
+	assert.Equal(t, 2, interrupted.CheckpointRows)
+	assert.True(t, interrupted.Resumable())
+
+	// 재개: truncate 없이 나머지 3건만 저장
+	var lastCurrent, lastTotal int
+	result, err := New(svc, WithResume()).ImportFromFile(path, 2, func(current, total int) {
+		lastCurrent, lastTotal = current, total
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.ResumedCount)
+	assert.Equal(t, 3, result.TotalCount)
+	assert.Equal(t, 1, result.ErrorCount)
+	assert.Equal(t, 5, lastCurrent)
+	assert.Equal(t, 5, lastTotal)
+
+	roads, _ := countRows(t, New(svc))
+	assert.Equal(t, 5, roads)
+
+	current, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	require.NotNil(t, current.ResumedFromID)
+	assert.Equal(t, interrupted.ID, *current.ResumedFromID)
+	assert.Equal(t, 5, current.CheckpointRows)
+
+	// 성공 이후에는 재개할 실행이 없음
+	_, err = New(svc, WithResume()).ImportFromFile(path, 2, nil)
+	assert.ErrorIs(t, err, postalcode.ErrNoResumableImport)
+	_, err = New(svc, WithResume()).ImportLandFromFile(filepath.Join("..", "..", "tests", "testdata", "sample_land.txt"), 2, nil)
+	assert.ErrorIs(t, err, postalcode.ErrNoResumableImport)
+}
+
+func TestImporter_Resume_FileMismatch(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	path := filepath.Join("..", "..", "tests", "testdata", "sample_road.txt")
+
+	interruptImport(t, svc, path, 1, 1)
+
+	// 다른 파일로는 재개할 수 없고 기존 데이터도 유지
+	other := writeTempFile(t, "resume_other_*.txt", testRoadHeader+
+		"48000|부산광역시|Busan|해운대구|Haeundae-gu|||해운대로|Haeundae-ro|0|1|0|999|0|1\n")
+	_, err := New(svc, WithResume()).ImportFromFile(other, 1, nil)
+	assert.ErrorIs(t, err, postalcode.ErrResumeMismatch)
+
+	roads, _ := countRows(t, New(svc))
+	assert.Equal(t, 1, roads)
+
+	// 실패한 재개 시도는 체크포인트가 없으므로 원래 실행은 여전히 재개 가능
+	result, err := New(svc, WithResume()).ImportFromFile(path, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ResumedCount)
+	assert.Equal(t, 1, result.TotalCount)
+}
+
+func TestImporter_Resume_InterruptedAfterTruncate(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	path := writeTempFile(t, "resume_truncate_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"01001|서울특별시|Seoul|강북구|Gangbuk-gu|||도봉로|Dobong-ro|0|1|0|99|0|1\n"+
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|1|0|500|0|1\n")
+
+	// 첫 실행은 배치 1개(1건) 커밋 후 중단, 두 번째 실행은 truncate 직후 첫 배치 전에 중단
+	interruptImport(t, svc, path, 1, 1)
+	interruptImport(t, svc, path, 1, 0)
+
+	roads, _ := countRows(t, New(svc))
+	assert.Equal(t, 0, roads)
+
+	runs, err := svc.ListImportRuns(1)
+	require.NoError(t, err)
+	truncated := runs[0]
+	assert.True(t, truncated.Truncated)
+	assert.Equal(t, 0, truncated.CheckpointRows)
+	assert.True(t, truncated.Resumable())
+
+	// 비워진 테이블에 마지막으로 반영된 실행은 두 번째 실행이므로, 첫 실행의 체크포인트(1건)를 건너뛰지 않고 처음부터 저장
+	result, err := New(svc, WithResume()).ImportFromFile(path, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.ResumedCount)
+	assert.Equal(t, 3, result.TotalCount)
+
+	roads, _ = countRows(t, New(svc))
+	assert.Equal(t, 3, roads)
+
+	current, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	require.NotNil(t, current.ResumedFromID)
+	assert.Equal(t, truncated.ID, *current.ResumedFromID)
+}
+
+// ============================================================
+// Reject Quarantine Tests
+// ============================================================
+
+// failingService는 BatchUpsert가 항상 실패하는 Service입니다.
+type failingService struct {
+	service.Service
+}
+
+func (s *failingService) BatchUpsert(roads []postalcode.PostalCodeRoad) error {
+	return errors.New("deadlock found")
+}
+
+func TestImporter_RejectFileAndTable(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	rejectPath := filepath.Join(t.TempDir(), "rejects", "road.rejects.txt")
+	imp := New(svc, WithRejectFile(rejectPath), WithRejectTable())
+
+	path := writeTempFile(t, "rejects_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"0100|서울특별시|Seoul|강북구|Gangbuk-gu|||오류로|Oryu-ro|0|1|0|99|0|1\n"+
+		"01002|서울특별시\n"+
+		"06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|0|X|0|500|0|1\n")
+
+	result, err := imp.ImportFromFile(path, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.TotalCount)
+	assert.Equal(t, 3, result.ErrorCount)
+
+	// 원본 헤더 + line + reason 컬럼, 라인 순서
+	data, err := os.ReadFile(rejectPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, strings.TrimSuffix(testRoadHeader, "\n")+"|line|reason", lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], "0100|서울특별시|Seoul|강북구|Gangbuk-gu|||오류로|Oryu-ro|0|1|0|99|0|1|3|zip code must be 5 digits"))
+	assert.True(t, strings.HasPrefix(lines[2], "01002|서울특별시|4|필드 수 부족"))
+	assert.Contains(t, lines[3], `|5|숫자가 아닌 값 (start_building_main="X")`)
+
+	// 격리 테이블
+	run, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	rejects, err := svc.ListImportRejects(run.ID)
+	require.NoError(t, err)
+	require.Len(t, rejects, 3)
+	assert.Equal(t, "zip_code", rejects[0].Field)
+	assert.Equal(t, "0100", rejects[0].Value)
+	assert.Equal(t, path, rejects[0].FileName)
+	assert.Equal(t, "01002|서울특별시", rejects[1].Record)
+
+	// 거부 파일을 수정하여 해당 행만 append로 다시 import (line/reason 컬럼은 무시)
+	fixed := strings.Replace(string(data), "0100|서울특별시|Seoul|강북구", "01001|서울특별시|Seoul|강북구", 1)
+	fixedPath := writeTempFile(t, "rejects_fixed_*.txt", fixed)
+	result, err = New(svc).AppendFromFile(fixedPath, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.TotalCount)
+	assert.Equal(t, 2, result.ErrorCount)
+
+	roads, _ := countRows(t, imp)
+	assert.Equal(t, 2, roads) // 기존 01000 유지 + 01001 추가
+
+	runs, err := svc.ListImportRuns(1)
+	require.NoError(t, err)
+	assert.Equal(t, postalcode.ImportModeAppend, runs[0].Mode)
+}
+
+func TestImporter_RejectFile_FailedBatch(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	rejectPath := filepath.Join(t.TempDir(), "rejects.txt")
+	imp := New(&failingService{Service: svc}, WithRejectFile(rejectPath))
+
+	result, err := imp.ImportFromFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"), 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.TotalCount)
+	assert.Equal(t, 2, result.ErrorCount)
+
+	data, err := os.ReadFile(rejectPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasSuffix(lines[1], "|2|배치 저장 실패: deadlock found"))
+	assert.True(t, strings.HasSuffix(lines[2], "|3|배치 저장 실패: deadlock found"))
+}
+
+func TestDatasetRejectPath(t *testing.T) {
+	assert.Equal(t, "", datasetRejectPath("", "a/b.txt"))
+	assert.Equal(t, filepath.Join("rejects", "서울특별시.txt"), datasetRejectPath("rejects", filepath.Join("data", "서울특별시.txt")))
+	assert.Equal(t, filepath.Join("rejects", "서울특별시.txt"), datasetRejectPath("rejects", "range.zip:range/서울특별시.txt"))
+}
+
+// ============================================================
+// Event Tests
+// ============================================================
+
+// eventRecorder는 받은 이벤트를 순서대로 기록합니다.
+// 읽기 진행 이벤트는 파일 크기에 따라 달라지므로 기록하지 않습니다.
+type eventRecorder struct {
+	events []postalcode.Event
+}
+
+func (r *eventRecorder) HandleEvent(event postalcode.Event) {
+	if event.Type != postalcode.EventReadProgress {
+		r.events = append(r.events, event)
+	}
+}
+
+func (r *eventRecorder) types() []postalcode.EventType {
+	types := make([]postalcode.EventType, len(r.events))
+	for i, event := range r.events {
+		types[i] = event.Type
+	}
+	return types
+}
+
+func TestImporter_Events(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	recorder := &eventRecorder{}
+	imp := New(svc, WithEventHandler(recorder))
+
+	path := writeTempFile(t, "events_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"0100|서울특별시|Seoul|강북구|Gangbuk-gu|||오류로|Oryu-ro|0|1|0|99|0|1\n"+
+		"01002|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|101|0|199|0|1\n")
+
+	result, err := imp.ImportFromFile(path, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalCount)
+
+	assert.Equal(t, []postalcode.EventType{
+		postalcode.EventRowRejected,
+		postalcode.EventTruncateStarted,
+		postalcode.EventTruncateCompleted,
+		postalcode.EventBatchStarted,
+		postalcode.EventBatchCompleted,
+		postalcode.EventBatchStarted,
+		postalcode.EventBatchCompleted,
+		postalcode.EventImportFinished,
+	}, recorder.types())
+
+	rejected := recorder.events[0]
+	assert.Equal(t, DataTypeRoad, rejected.DataType)
+	assert.Equal(t, path, rejected.File)
+	assert.Equal(t, 3, rejected.Line)
+	assert.Equal(t, "zip_code", rejected.Field)
+	assert.Equal(t, "0100", rejected.Value)
+
+	batch := recorder.events[5]
+	assert.Equal(t, 1, batch.Start)
+	assert.Equal(t, 2, batch.End)
+
+	finished := recorder.events[7]
+	assert.Equal(t, DataTypeRoad, finished.DataType)
+	assert.NotZero(t, finished.RunID)
+	assert.NoError(t, finished.Err)
+}
+
+func TestImporter_Events_BatchFailed(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	recorder := &eventRecorder{}
+	imp := New(&failingService{Service: svc}, WithEventHandler(recorder))
+
+	_, err := imp.AppendFromFile(filepath.Join("..", "..", "tests", "testdata", "sample_road.txt"), 100, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []postalcode.EventType{
+		postalcode.EventBatchStarted,
+		postalcode.EventBatchFailed,
+		postalcode.EventImportFinished,
+	}, recorder.types())
+	assert.Equal(t, 0, recorder.events[1].Start)
+	assert.Equal(t, 2, recorder.events[1].End)
+	assert.EqualError(t, recorder.events[1].Err, "deadlock found")
+}
+
+// ============================================================
+// Progress Tests
+// ============================================================
+
+// phases는 보고된 진행 상황의 단계를 연속 중복 없이 반환합니다.
+func phases(progress []postalcode.ImportProgress) []postalcode.ImportPhase {
+	var result []postalcode.ImportPhase
+	for _, p := range progress {
+		if len(result) == 0 || result[len(result)-1] != p.Phase {
+			result = append(result, p.Phase)
+		}
+	}
+	return result
+}
+
+func TestImporter_ProgressHandler(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	var progress []postalcode.ImportProgress
+	imp := New(svc, WithProgressHandler(func(p postalcode.ImportProgress) {
+		progress = append(progress, p)
+	}))
+
+	path := writeTempFile(t, "progress_*.txt", testRoadHeader+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|1|0|99|0|1\n"+
+		"0100|서울특별시|Seoul|강북구|Gangbuk-gu|||오류로|Oryu-ro|0|1|0|99|0|1\n"+
+		"01002|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|101|0|199|0|1\n")
+
+	// ProgressFunc는 저장 단계만 (처리 건수, 전체 건수)로 받음
+	var calls [][2]int
+	_, err := imp.ImportFromFile(path, 1, func(current, total int) {
+		calls = append(calls, [2]int{current, total})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][2]int{{1, 2}, {2, 2}}, calls)
+
+	assert.Equal(t, []postalcode.ImportPhase{
+		postalcode.ImportPhaseRead,
+		postalcode.ImportPhaseTruncate,
+		postalcode.ImportPhaseWrite,
+		postalcode.ImportPhaseDone,
+	}, phases(progress))
+
+	last := progress[len(progress)-1]
+	assert.Equal(t, DataTypeRoad, last.DataType)
+	assert.Equal(t, path, last.File)
+	assert.Equal(t, 3, last.RowsRead)
+	assert.Equal(t, 1, last.RowsRejected)
+	assert.Equal(t, 2, last.RowsWritten)
+	assert.Equal(t, 2, last.RowsTotal)
+	assert.Equal(t, last.BytesTotal, last.BytesRead)
+	assert.Greater(t, last.BytesRead, int64(0))
+	assert.Equal(t, float64(100), last.Percent())
+
+	write := progress[len(progress)-2]
+	assert.Equal(t, postalcode.ImportPhaseWrite, write.Phase)
+	assert.Equal(t, float64(100), write.Percent())
+	assert.Equal(t, time.Duration(0), write.ETA)
+}
+
+func TestImporter_ProgressHandler_FailedBatchAndDiff(t *testing.T) {
+	svc := setupTestImporter(t).(*importer).service
+	var progress []postalcode.ImportProgress
+	handler := WithProgressHandler(func(p postalcode.ImportProgress) {
+		progress = append(progress, p)
+	})
+	testDataPath := filepath.Join("..", "..", "tests", "testdata", "sample_road.txt")
+
+	// 저장 실패 배치의 행은 거부 건수에 포함
+	_, err := New(&failingService{Service: svc}, handler).AppendFromFile(testDataPath, 1, nil)
+	require.NoError(t, err)
+	last := progress[len(progress)-1]
+	assert.Equal(t, 2, last.RowsRejected)
+	assert.Equal(t, 2, last.RowsWritten)
+
+	// diff 모드는 비교 단계를 거침
+	progress = nil
+	_, err = New(svc, handler).DiffImportFromFile(testDataPath, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []postalcode.ImportPhase{
+		postalcode.ImportPhaseRead,
+		postalcode.ImportPhaseCompare,
+		postalcode.ImportPhaseWrite,
+		postalcode.ImportPhaseDone,
+	}, phases(progress))
+}
+
 // ============================================================
 // Edge Cases and Error Handling
 // ============================================================
@@ -0,0 +1,487 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// recordField는 필드 무관 오류(필드 수 부족, CSV 파싱 에러 등)를 집계할 때 사용하는 이름입니다.
+const recordField = "_record"
+
+// row는 파일의 한 데이터 라인입니다.
+type row struct {
+	line    int
+	values  []string
+	columns map[string]int
+}
+
+// get은 필드의 원본 값을 공백 제거 후 반환합니다.
+func (r *row) get(field string) string {
+	idx, ok := r.columns[field]
+	if !ok || idx >= len(r.values) {
+		return ""
+	}
+	return strings.TrimSpace(r.values[idx])
+}
+
+// record는 라인의 원본 필드를 파이프('|')로 연결한 문자열입니다.
+func (r *row) record() string {
+	return strings.Join(r.values, "|")
+}
+
+// fieldError는 필드 단위 ImportError를 생성합니다.
+func (r *row) fieldError(field, message string) *postalcode.ImportError {
+	return &postalcode.ImportError{
+		Line:    r.line,
+		Field:   field,
+		Value:   r.get(field),
+		Message: message,
+		Record:  r.record(),
+	}
+}
+
+// intField는 필수 정수 필드를 파싱합니다. 빈 값은 0입니다.
+func (r *row) intField(field string) (int, *postalcode.ImportError) {
+	raw := r.get(field)
+	if raw == "" {
+		return 0, nil
+	}
+	val, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, r.fieldError(field, "숫자가 아닌 값")
+	}
+	return val, nil
+}
+
+// optionalIntField는 선택 정수 필드를 파싱합니다.
+// 빈 값은 nil이며, zeroAsNil이면 "0"도 nil로 취급합니다. (부번 컬럼)
+func (r *row) optionalIntField(field string, zeroAsNil bool) (*int, *postalcode.ImportError) {
+	raw := r.get(field)
+	if raw == "" || (zeroAsNil && raw == "0") {
+		return nil, nil
+	}
+	val, err := strconv.Atoi(raw)
+	if err != nil {
+		return nil, r.fieldError(field, "숫자가 아닌 값")
+	}
+	return &val, nil
+}
+
+// flagField는 0/1 플래그 필드를 파싱합니다. 빈 값은 false입니다.
+func (r *row) flagField(field string) (bool, *postalcode.ImportError) {
+	switch r.get(field) {
+	case "", "0":
+		return false, nil
+	case "1":
+		return true, nil
+	default:
+		return false, r.fieldError(field, "0 또는 1이어야 합니다")
+	}
+}
+
+// rowReader는 파이프('|') 구분 파일을 한 라인씩 읽습니다.
+type rowReader struct {
+	reader  *csv.Reader
+	layout  *layout
+	pending []string // 헤더 없는 파일의 첫 라인 (첫 데이터 라인으로 반환)
+}
+
+// newRowReader는 헤더를 읽어 레이아웃을 결정하고 데이터 라인을 읽을 준비를 합니다.
+// dataType이 비어 있으면 헤더로 도로명/지번/건물/사서함/다량배달처를 감지합니다.
+// 헤더가 없는 도로명주소 건물 DB 파일은 첫 라인의 형식으로 감지하여 고정 필드 순서를 사용합니다.
+func newRowReader(r io.Reader, dataType string, mapping *ColumnMapping) (*rowReader, error) {
+	// CSV 리더 생성 (파이프 구분자)
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.Comma = '|'
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	// 헤더 읽기
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("failed to read header: %w", postalcode.ErrEmptyFile)
+		}
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	// 헤더 없는 건물 DB 파일은 첫 라인이 데이터
+	if isBuildingDBRecord(header) && (dataType == "" || dataType == DataTypeBuilding) {
+		layout, err := resolveLayout(buildingDBHeader, DataTypeBuilding, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &rowReader{reader: reader, layout: layout, pending: header}, nil
+	}
+
+	// 헤더 이름으로 컬럼 위치 결정
+	layout, err := resolveLayout(header, dataType, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rowReader{reader: reader, layout: layout}, nil
+}
+
+// isBuildingDBRecord는 record가 헤더 없는 도로명주소 건물 DB 파일의 데이터 라인인지 확인합니다.
+// 첫 필드가 10자리 법정동코드이고 16번째 필드가 25자리 건물관리번호이면 건물 DB로 판단합니다.
+func isBuildingDBRecord(record []string) bool {
+	if len(record) < len(buildingDBHeader) {
+		return false
+	}
+	return isDigits(strings.TrimSpace(record[0]), 10) && isDigits(strings.TrimSpace(record[15]), 25)
+}
+
+// isDigits는 s가 n자리 숫자인지 확인합니다.
+func isDigits(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// next는 다음 데이터 라인을 반환합니다.
+// 라인 단위 형식 오류는 *postalcode.ImportError로 반환되며 다음 라인을 계속 읽을 수 있습니다.
+func (rr *rowReader) next() (*row, error) {
+	if record := rr.pending; record != nil {
+		rr.pending = nil
+		return &row{line: 1, values: record, columns: rr.layout.columns}, nil
+	}
+
+	record, err := rr.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &postalcode.ImportError{Line: parseErr.StartLine, Message: "CSV 파싱 에러", Err: parseErr.Err}
+		}
+		return nil, err
+	}
+
+	line, _ := rr.reader.FieldPos(0)
+	r := &row{line: line, values: record, columns: rr.layout.columns}
+
+	// 필드 수 검증
+	if len(record) < rr.layout.width {
+		return nil, &postalcode.ImportError{
+			Line:    line,
+			Message: fmt.Sprintf("필드 수 부족 (필요: %d, 실제: %d)", rr.layout.width, len(record)),
+			Record:  r.record(),
+		}
+	}
+
+	return r, nil
+}
+
+// forEachRow는 reader의 모든 데이터 라인에 대해 fn을 호출하고 헤더로 결정된 레이아웃을 반환합니다.
+// 형식 오류가 있는 라인은 onReject로 전달하고 건너뜁니다.
+func forEachRow(r io.Reader, dataType string, mapping *ColumnMapping, fn func(*row) error, onReject func(*postalcode.ImportError)) (*layout, error) {
+	rr, err := newRowReader(r, dataType, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		row, err := rr.next()
+		if err == io.EOF {
+			return rr.layout, nil
+		}
+		if err != nil {
+			var importErr *postalcode.ImportError
+			if errors.As(err, &importErr) {
+				onReject(importErr)
+				continue
+			}
+			return nil, err
+		}
+
+		if err := fn(row); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseRoadRow는 데이터 라인을 PostalCodeRoad로 변환합니다.
+func parseRoadRow(r *row) (postalcode.PostalCodeRoad, *postalcode.ImportError) {
+	zipCode := r.get("zip_code")
+	zipPrefix := ""
+	if len(zipCode) >= 3 {
+		zipPrefix = zipCode[:3]
+	}
+
+	road := postalcode.PostalCodeRoad{
+		ZipCode:        zipCode,
+		ZipPrefix:      zipPrefix,
+		SidoName:       r.get("sido_name"),
+		SidoNameEn:     r.get("sido_name_en"),
+		SigunguName:    r.get("sigungu_name"),
+		SigunguNameEn:  r.get("sigungu_name_en"),
+		EupmyeonName:   r.get("eupmyeon_name"),
+		EupmyeonNameEn: r.get("eupmyeon_name_en"),
+		RoadName:       r.get("road_name"),
+		RoadNameEn:     r.get("road_name_en"),
+	}
+
+	var rerr *postalcode.ImportError
+
+	// 지하여부 파싱
+	if road.IsUnderground, rerr = r.flagField("is_underground"); rerr != nil {
+		return road, rerr
+	}
+
+	// 시작건물번호(주) 파싱
+	if road.StartBuildingMain, rerr = r.intField("start_building_main"); rerr != nil {
+		return road, rerr
+	}
+
+	// 시작건물번호(부) 파싱
+	if road.StartBuildingSub, rerr = r.optionalIntField("start_building_sub", true); rerr != nil {
+		return road, rerr
+	}
+
+	// 끝건물번호(주) 파싱
+	if road.EndBuildingMain, rerr = r.optionalIntField("end_building_main", false); rerr != nil {
+		return road, rerr
+	}
+
+	// 끝건물번호(부) 파싱
+	if road.EndBuildingSub, rerr = r.optionalIntField("end_building_sub", true); rerr != nil {
+		return road, rerr
+	}
+
+	// 범위종류 파싱
+	rangeType, rerr := r.intField("range_type")
+	if rerr != nil {
+		return road, rerr
+	}
+	road.RangeType = int8(rangeType)
+
+	return road, nil
+}
+
+// parseLandRow는 데이터 라인을 PostalCodeLand로 변환합니다.
+func parseLandRow(r *row) (postalcode.PostalCodeLand, *postalcode.ImportError) {
+	zipCode := r.get("zip_code")
+	zipPrefix := ""
+	if len(zipCode) >= 3 {
+		zipPrefix = zipCode[:3]
+	}
+
+	land := postalcode.PostalCodeLand{
+		ZipCode:            zipCode,
+		ZipPrefix:          zipPrefix,
+		SidoName:           r.get("sido_name"),
+		SidoNameEn:         r.get("sido_name_en"),
+		SigunguName:        r.get("sigungu_name"),
+		SigunguNameEn:      r.get("sigungu_name_en"),
+		EupmyeondongName:   r.get("eupmyeondong_name"),
+		EupmyeondongNameEn: r.get("eupmyeondong_name_en"),
+		RiName:             r.get("ri_name"),
+		HaengjeongdongName: r.get("haengjeongdong_name"),
+	}
+
+	var rerr *postalcode.ImportError
+
+	// 산여부 파싱
+	if land.IsMountain, rerr = r.flagField("is_mountain"); rerr != nil {
+		return land, rerr
+	}
+
+	// 시작주번지 파싱
+	if land.StartJibunMain, rerr = r.intField("start_jibun_main"); rerr != nil {
+		return land, rerr
+	}
+
+	// 시작부번지 파싱
+	if land.StartJibunSub, rerr = r.optionalIntField("start_jibun_sub", true); rerr != nil {
+		return land, rerr
+	}
+
+	// 끝주번지 파싱
+	if land.EndJibunMain, rerr = r.optionalIntField("end_jibun_main", false); rerr != nil {
+		return land, rerr
+	}
+
+	// 끝부번지 파싱
+	if land.EndJibunSub, rerr = r.optionalIntField("end_jibun_sub", true); rerr != nil {
+		return land, rerr
+	}
+
+	return land, nil
+}
+
+// parseBuildingRow는 데이터 라인을 PostalCodeBuilding으로 변환합니다.
+func parseBuildingRow(r *row) (postalcode.PostalCodeBuilding, *postalcode.ImportError) {
+	zipCode := r.get("zip_code")
+	zipPrefix := ""
+	if len(zipCode) >= 3 {
+		zipPrefix = zipCode[:3]
+	}
+
+	building := postalcode.PostalCodeBuilding{
+		BuildingManagementNumber: r.get("building_management_number"),
+		ZipCode:                  zipCode,
+		ZipPrefix:                zipPrefix,
+		LegalDongCode:            r.get("legal_dong_code"),
+		SidoName:                 r.get("sido_name"),
+		SigunguName:              r.get("sigungu_name"),
+		EupmyeondongName:         r.get("eupmyeondong_name"),
+		RiName:                   r.get("ri_name"),
+		HaengjeongdongName:       r.get("haengjeongdong_name"),
+		RoadCode:                 r.get("road_code"),
+		RoadName:                 r.get("road_name"),
+		BuildingName:             r.get("building_name"),
+		BuildingLedgerName:       r.get("building_ledger_name"),
+		DetailBuildingName:       r.get("detail_building_name"),
+	}
+
+	var rerr *postalcode.ImportError
+
+	// 산여부 파싱
+	if building.IsMountain, rerr = r.flagField("is_mountain"); rerr != nil {
+		return building, rerr
+	}
+
+	// 지번본번 파싱
+	if building.JibunMain, rerr = r.intField("jibun_main"); rerr != nil {
+		return building, rerr
+	}
+
+	// 지번부번 파싱
+	if building.JibunSub, rerr = r.optionalIntField("jibun_sub", true); rerr != nil {
+		return building, rerr
+	}
+
+	// 지하여부 파싱 (0: 지상, 1: 지하, 2: 공중)
+	underground, rerr := r.intField("is_underground")
+	if rerr != nil {
+		return building, rerr
+	}
+	building.IsUnderground = underground == 1
+
+	// 건물본번 파싱
+	if building.BuildingMain, rerr = r.intField("building_main"); rerr != nil {
+		return building, rerr
+	}
+
+	// 건물부번 파싱
+	if building.BuildingSub, rerr = r.optionalIntField("building_sub", true); rerr != nil {
+		return building, rerr
+	}
+
+	// 공동주택여부 파싱
+	if building.IsApartment, rerr = r.flagField("is_apartment"); rerr != nil {
+		return building, rerr
+	}
+
+	return building, nil
+}
+
+// parsePOBoxRow는 데이터 라인을 PostalCodePOBox로 변환합니다.
+func parsePOBoxRow(r *row) (postalcode.PostalCodePOBox, *postalcode.ImportError) {
+	zipCode := r.get("zip_code")
+	zipPrefix := ""
+	if len(zipCode) >= 3 {
+		zipPrefix = zipCode[:3]
+	}
+
+	poBox := postalcode.PostalCodePOBox{
+		ZipCode:          zipCode,
+		ZipPrefix:        zipPrefix,
+		SidoName:         r.get("sido_name"),
+		SigunguName:      r.get("sigungu_name"),
+		EupmyeondongName: r.get("eupmyeondong_name"),
+		RiName:           r.get("ri_name"),
+		POBoxName:        r.get("po_box_name"),
+	}
+
+	var rerr *postalcode.ImportError
+
+	// 시작사서함번호(주) 파싱
+	if poBox.StartNumberMain, rerr = r.intField("start_po_box_main"); rerr != nil {
+		return poBox, rerr
+	}
+
+	// 시작사서함번호(부) 파싱
+	if poBox.StartNumberSub, rerr = r.optionalIntField("start_po_box_sub", true); rerr != nil {
+		return poBox, rerr
+	}
+
+	// 끝사서함번호(주) 파싱
+	if poBox.EndNumberMain, rerr = r.optionalIntField("end_po_box_main", false); rerr != nil {
+		return poBox, rerr
+	}
+
+	// 끝사서함번호(부) 파싱
+	if poBox.EndNumberSub, rerr = r.optionalIntField("end_po_box_sub", true); rerr != nil {
+		return poBox, rerr
+	}
+
+	return poBox, nil
+}
+
+// parseBulkDeliveryRow는 데이터 라인을 PostalCodeBulkDelivery로 변환합니다.
+// 읍면동 컬럼이 없는 파일은 읍면 컬럼 값을 사용합니다.
+func parseBulkDeliveryRow(r *row) (postalcode.PostalCodeBulkDelivery, *postalcode.ImportError) {
+	zipCode := r.get("zip_code")
+	zipPrefix := ""
+	if len(zipCode) >= 3 {
+		zipPrefix = zipCode[:3]
+	}
+
+	delivery := postalcode.PostalCodeBulkDelivery{
+		ZipCode:          zipCode,
+		ZipPrefix:        zipPrefix,
+		SidoName:         r.get("sido_name"),
+		SigunguName:      r.get("sigungu_name"),
+		EupmyeondongName: r.get("eupmyeondong_name"),
+		RoadName:         r.get("road_name"),
+		DeliveryName:     r.get("delivery_name"),
+	}
+	if delivery.EupmyeondongName == "" {
+		delivery.EupmyeondongName = r.get("eupmyeon_name")
+	}
+
+	var rerr *postalcode.ImportError
+
+	// 지하여부 파싱
+	if delivery.IsUnderground, rerr = r.flagField("is_underground"); rerr != nil {
+		return delivery, rerr
+	}
+
+	// 건물본번 파싱
+	if delivery.BuildingMain, rerr = r.intField("building_main"); rerr != nil {
+		return delivery, rerr
+	}
+
+	// 건물부번 파싱
+	if delivery.BuildingSub, rerr = r.optionalIntField("building_sub", true); rerr != nil {
+		return delivery, rerr
+	}
+
+	return delivery, nil
+}
+
+// validationReject는 service 검증 에러를 라인 정보가 포함된 ImportError로 변환합니다.
+func validationReject(r *row, err error) *postalcode.ImportError {
+	var verr *postalcode.ValidationError
+	if errors.As(err, &verr) {
+		return r.fieldError(verr.Field, verr.Message)
+	}
+	return &postalcode.ImportError{Line: r.line, Message: err.Error(), Record: r.record(), Err: err}
+}
@@ -0,0 +1,263 @@
+package importer
+
+import (
+	"fmt"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// recordType은 데이터 타입마다 다른 부분(파싱, 검증, 저장, 중복 키)을 모은 것입니다.
+// 읽기, truncate, 배치 저장, 체크포인트/재개, 거부 라인 격리 등 나머지 import 과정은
+// 모든 타입이 importRecords/saveRecords로 공유합니다.
+type recordType[T any] struct {
+	dataType string                                    // DataTypeRoad 등 (헤더, 이벤트, 실행 이력의 데이터 타입)
+	parse    func(r *row) (T, *postalcode.ImportError) // 라인 하나를 레코드로 변환
+	validate func(record *T) error                     // service 검증 규칙
+	upsert   func(records []T) error                   // 유니크 키 기준 배치 upsert
+	key      func(record *T) string                    // 유니크 키 (배치 안 중복 제거, 검증 리포트의 중복 키 집계)
+	zipSido  func(record *T) (zipCode, sido string)    // 검증 리포트의 우편번호/시도 집계용
+}
+
+// loaded는 파일에서 읽은 유효한 레코드와 거부된 라인입니다.
+type loaded[T any] struct {
+	typ     recordType[T]
+	rows    []T
+	lines   []sourceLine // rows와 같은 순서의 원본 라인
+	rejects []*postalcode.ImportError
+	info    sourceInfo
+}
+
+// loadRecords는 파일을 파싱하고 service 검증 규칙을 적용합니다.
+// 형식 오류나 검증 실패 라인은 rejects에 모으고 나머지만 rows로 반환합니다.
+func loadRecords[T any](imp *importer, typ recordType[T], src source, tr *progressTracker) (*loaded[T], error) {
+	result := &loaded[T]{typ: typ}
+	reject := func(rerr *postalcode.ImportError) {
+		tr.rowRejected()
+		result.rejects = append(result.rejects, rerr)
+	}
+
+	var err error
+	result.info, err = imp.readSource(src, typ.dataType, tr, func(r *row) error {
+		record, rerr := typ.parse(r)
+		if rerr != nil {
+			reject(rerr)
+			return nil
+		}
+		if err := typ.validate(&record); err != nil {
+			reject(validationReject(r, err))
+			return nil
+		}
+		result.rows = append(result.rows, record)
+		result.lines = append(result.lines, sourceLine{line: r.line, record: r.record()})
+		return nil
+	}, reject)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// importRecords는 source를 파싱/검증한 뒤 저장합니다.
+// mode가 postalcode.ImportModeReplace이면 테이블을 교체하고, postalcode.ImportModeAppend이면 upsert만 합니다.
+func importRecords[T any](imp *importer, typ recordType[T], src source, mode string, batchSize int, progressFn postalcode.ProgressFunc) (result *postalcode.ImportResult, err error) {
+	startTime := time.Now()
+	replace := mode == postalcode.ImportModeReplace
+
+	// 재개할 실행 확인 (파일 일치 여부는 파싱 후 SHA-256으로 검증)
+	var resumeFrom *postalcode.ImportRun
+	if replace {
+		if resumeFrom, err = imp.findResumeRun(typ.dataType); err != nil {
+			return nil, err
+		}
+	}
+
+	run := imp.beginRun(typ.dataType, mode, src.name)
+	defer func() { imp.finishRun(run, result, err) }()
+
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	// 파일 파싱 및 검증 (truncate 전에 수행하여 파일 오류 시 기존 데이터 보존)
+	tr := imp.newProgressTracker(progressFn)
+	file, err := loadRecords(imp, typ, src, tr)
+	if err != nil {
+		return nil, fmt.Errorf("file parsing failed: %w", err)
+	}
+	run.FileSHA256 = file.info.checksum
+	imp.emitRejects(typ.dataType, src.name, file.rejects)
+
+	skip, err := imp.resumeOffset(run, resumeFrom, len(file.rows))
+	if err != nil {
+		return nil, err
+	}
+
+	// 기존 데이터 truncate (새로운 데이터로 완전히 교체, 재개 시에는 생략)
+	if replace && resumeFrom == nil {
+		tr.setPhase(postalcode.ImportPhaseTruncate)
+		imp.markTruncated(run)
+		if err := imp.truncate(typ.dataType); err != nil {
+			return nil, err
+		}
+	}
+
+	tr.startWrite(len(file.rows), skip)
+	totalCount, duplicates, failures := saveRecords(imp, typ, file.rows[skip:], batchSize, func(done, failed int) {
+		if replace {
+			imp.checkpoint(run, skip+done)
+		}
+
+		// 진행 상황 보고
+		tr.batchDone(skip+done, failed)
+	})
+
+	// 거부된 라인 격리 (파싱/검증 실패 + 저장 실패 배치)
+	rejects := append(file.rejects, batchRejects(failures, file.lines[skip:])...)
+	imp.quarantine(run, imp.rejectFile, typ.dataType, src.name, file.info.header, rejects)
+	tr.finish()
+
+	duration := time.Since(startTime)
+	return &postalcode.ImportResult{
+		TotalCount:     totalCount,
+		ErrorCount:     len(rejects),
+		Duration:       duration.String(),
+		ResumedCount:   skip,
+		DuplicateCount: duplicates,
+	}, nil
+}
+
+// saveRecords는 레코드를 배치 단위로 upsert합니다.
+// 배치 안에서 유니크 키가 같은 행은 마지막 행만 저장하고(dedupeBatch) 나머지는 중복 건수로 집계합니다.
+// 배치가 끝날 때마다 지금까지 처리한 건수와 그 배치에서 저장에 실패한 건수로 onBatch를 호출하며,
+// 실제로 저장된 행 수, 중복으로 합쳐진 행 수, 실패한 배치를 반환합니다.
+func saveRecords[T any](imp *importer, typ recordType[T], records []T, batchSize int, onBatch func(done, failed int)) (saved, duplicates int, failures []batchFailure) {
+	for i := 0; i < len(records); i += batchSize {
+		end := i + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		batch := dedupeBatch(typ, records[i:end])
+
+		// DB에 저장
+		failed := 0
+		if err := imp.saveBatch(typ.dataType, i, end, func() error { return typ.upsert(batch) }); err != nil {
+			failures = append(failures, batchFailure{start: i, end: end, err: err})
+			failed = end - i
+		} else {
+			saved += len(batch)
+			duplicates += end - i - len(batch)
+		}
+
+		onBatch(end, failed)
+	}
+	return saved, duplicates, failures
+}
+
+// dedupeBatch는 유니크 키(typ.key)가 같은 행이 여러 개면 마지막 행만 남긴 배치를 반환합니다.
+// 같은 배치를 upsert했을 때 뒤의 행이 앞의 행을 갱신하는 결과와 같으며,
+// 저장 건수가 테이블에 실제로 남는 행 수와 맞도록 모든 dialect에서 저장 전에 적용합니다.
+func dedupeBatch[T any](typ recordType[T], batch []T) []T {
+	keys := make([]string, len(batch))
+	last := make(map[string]int, len(batch))
+	for i := range batch {
+		keys[i] = typ.key(&batch[i])
+		last[keys[i]] = i
+	}
+	if len(last) == len(batch) {
+		return batch
+	}
+
+	unique := make([]T, 0, len(last))
+	for i, key := range keys {
+		if last[key] == i {
+			unique = append(unique, batch[i])
+		}
+	}
+	return unique
+}
+
+// parseRecords는 source를 파싱합니다. 형식 오류가 있는 라인은 건너뜁니다. (검증 규칙은 적용하지 않음)
+func parseRecords[T any](imp *importer, typ recordType[T], src source) ([]T, error) {
+	var records []T
+	var parseErrors []*postalcode.ImportError
+	reject := func(rerr *postalcode.ImportError) {
+		parseErrors = append(parseErrors, rerr)
+	}
+
+	_, err := imp.readSource(src, typ.dataType, nil, func(r *row) error {
+		record, rerr := typ.parse(r)
+		if rerr != nil {
+			reject(rerr)
+			return nil
+		}
+		records = append(records, record)
+		return nil
+	}, reject)
+	if err != nil {
+		return nil, err
+	}
+
+	// 파싱 에러가 있으면 알림
+	imp.emitRejects(typ.dataType, src.name, parseErrors)
+
+	return records, nil
+}
+
+// validateRecords는 source를 파싱/검증하여 리포트를 생성합니다.
+// typ.key가 같은 행은 중복 키로 집계합니다.
+func validateRecords[T any](imp *importer, typ recordType[T], src source) (*postalcode.ValidationReport, error) {
+	startTime := time.Now()
+
+	file, err := loadRecords(imp, typ, src, nil)
+	if err != nil {
+		return nil, fmt.Errorf("file parsing failed: %w", err)
+	}
+
+	report := newValidationReport(src.name, typ.dataType, file.rejects)
+	report.Encoding = string(file.info.encoding)
+	keys := make(map[string]bool, len(file.rows))
+	zipCodes := make(map[string]bool)
+	for i := range file.rows {
+		record := &file.rows[i]
+		key := typ.key(record)
+		if keys[key] {
+			report.DuplicateKeys++
+		}
+		keys[key] = true
+		zipCode, sido := typ.zipSido(record)
+		zipCodes[zipCode] = true
+		report.SidoCounts[sido]++
+	}
+
+	report.ValidCount = len(file.rows)
+	report.TotalLines = report.ValidCount + report.RejectedCount
+	report.UniqueZipCodes = len(zipCodes)
+	report.Duration = time.Since(startTime).String()
+	return report, nil
+}
+
+// ============================================================
+// 데이터셋용 타입 소거
+// ============================================================
+
+// loadedFile은 데이터셋 import에서 타입과 무관하게 다루는 파일 하나의 파싱 결과입니다. (*loaded[T])
+type loadedFile interface {
+	rowCount() int
+	sourceInfo() sourceInfo
+	rejected() []*postalcode.ImportError
+
+	// save는 모든 행을 저장하고 저장 건수(배치 안 중복 제외)와 거부 라인(파싱/검증 실패 + 저장 실패 배치)을 반환합니다.
+	save(imp *importer, batchSize int, onBatch func(done, failed int)) (saved int, rejects []*postalcode.ImportError)
+}
+
+func (l *loaded[T]) rowCount() int                       { return len(l.rows) }
+func (l *loaded[T]) sourceInfo() sourceInfo              { return l.info }
+func (l *loaded[T]) rejected() []*postalcode.ImportError { return l.rejects }
+
+func (l *loaded[T]) save(imp *importer, batchSize int, onBatch func(done, failed int)) (int, []*postalcode.ImportError) {
+	saved, _, failures := saveRecords(imp, l.typ, l.rows, batchSize, onBatch)
+	return saved, append(l.rejects, batchRejects(failures, l.lines)...)
+}
@@ -0,0 +1,187 @@
+package importer
+
+import (
+	"io"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// readReportInterval은 읽기 단계에서 진행 상황을 보고하는 라인 간격입니다.
+const readReportInterval = 10000
+
+// progressTracker는 import 한 번의 진행 상황을 집계하여 ProgressHandler에 보고합니다.
+// 보고할 핸들러가 없으면 nil이며, 모든 메서드는 nil receiver에서 아무것도 하지 않습니다.
+type progressTracker struct {
+	handlers   []postalcode.ProgressHandler
+	progress   postalcode.ImportProgress
+	start      time.Time
+	phaseStart time.Time
+	phaseBase  int // 단계 시작 시점의 처리 행 수 (속도 계산용)
+}
+
+// newProgressTracker는 WithProgressHandler로 지정한 핸들러와 메서드 인자로 받은 progressFn에 보고하는 tracker를 생성합니다.
+func (imp *importer) newProgressTracker(progressFn postalcode.ProgressFunc) *progressTracker {
+	var handlers []postalcode.ProgressHandler
+	if imp.progress != nil {
+		handlers = append(handlers, imp.progress)
+	}
+	if progressFn != nil {
+		handlers = append(handlers, progressFn.Handler())
+	}
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	return &progressTracker{handlers: handlers, start: now, phaseStart: now}
+}
+
+// setPhase는 단계를 전환하고 바로 보고합니다.
+func (t *progressTracker) setPhase(phase postalcode.ImportPhase) {
+	if t == nil {
+		return
+	}
+	t.progress.Phase = phase
+	t.phaseStart = time.Now()
+	t.phaseBase = t.processed()
+	t.report()
+}
+
+// startFile은 파일 하나의 읽기를 시작합니다.
+func (t *progressTracker) startFile(dataType, name string, size int64) {
+	if t == nil {
+		return
+	}
+	t.progress.DataType = dataType
+	t.progress.File = name
+	t.progress.BytesRead = 0
+	t.progress.BytesTotal = size
+	if t.progress.Phase != postalcode.ImportPhaseRead {
+		t.setPhase(postalcode.ImportPhaseRead)
+		return
+	}
+
+	// 속도와 남은 시간은 파일마다 새로 계산
+	t.phaseStart = time.Now()
+	t.phaseBase = t.progress.RowsRead
+}
+
+// setFile은 저장 단계에서 현재 파일을 바꿉니다. (데이터셋 import)
+func (t *progressTracker) setFile(dataType, name string) {
+	if t == nil {
+		return
+	}
+	t.progress.DataType = dataType
+	t.progress.File = name
+}
+
+// update는 현재 상태를 보고합니다. (읽기 진행률 단계마다 호출)
+func (t *progressTracker) update() {
+	if t == nil {
+		return
+	}
+	t.report()
+}
+
+// rowRead는 데이터 라인 하나를 읽었음을 기록합니다.
+func (t *progressTracker) rowRead() {
+	if t == nil {
+		return
+	}
+	t.progress.RowsRead++
+	if t.progress.RowsRead%readReportInterval == 0 {
+		t.report()
+	}
+}
+
+// rowRejected는 파싱/검증 실패로 라인 하나가 거부되었음을 기록합니다.
+func (t *progressTracker) rowRejected() {
+	if t == nil {
+		return
+	}
+	t.progress.RowsRejected++
+}
+
+// startWrite는 저장할 전체 행 수와 이미 처리된 행 수(재개 시 건너뛴 행)로 저장 단계를 시작합니다.
+// 첫 배치가 끝날 때 보고하므로 여기서는 보고하지 않습니다.
+func (t *progressTracker) startWrite(total, written int) {
+	if t == nil {
+		return
+	}
+	t.progress.Phase = postalcode.ImportPhaseWrite
+	t.progress.RowsTotal = total
+	t.progress.RowsWritten = written
+	t.phaseStart = time.Now()
+	t.phaseBase = written
+}
+
+// batchDone은 배치 하나가 끝났음을 기록하고 보고합니다.
+// written은 지금까지 처리한 행 수, failed는 이번 배치에서 저장에 실패한 행 수입니다.
+func (t *progressTracker) batchDone(written, failed int) {
+	if t == nil {
+		return
+	}
+	t.progress.RowsWritten = written
+	t.progress.RowsRejected += failed
+	t.report()
+}
+
+// finish는 완료 단계를 보고합니다.
+func (t *progressTracker) finish() {
+	if t == nil {
+		return
+	}
+	t.progress.ETA = 0
+	t.setPhase(postalcode.ImportPhaseDone)
+}
+
+// processed는 현재 단계에서 진행을 나타내는 행 수입니다.
+func (t *progressTracker) processed() int {
+	if t.progress.Phase == postalcode.ImportPhaseRead {
+		return t.progress.RowsRead
+	}
+	return t.progress.RowsWritten
+}
+
+// report는 경과 시간, 처리 속도, 남은 시간을 계산하여 모든 핸들러에 보고합니다.
+func (t *progressTracker) report() {
+	now := time.Now()
+	p := &t.progress
+	p.Elapsed = now.Sub(t.start)
+	p.RowsPerSecond = 0
+	p.ETA = 0
+
+	if elapsed := now.Sub(t.phaseStart).Seconds(); elapsed > 0 {
+		p.RowsPerSecond = float64(t.processed()-t.phaseBase) / elapsed
+	}
+
+	switch p.Phase {
+	case postalcode.ImportPhaseRead:
+		// 읽은 바이트 비율로 남은 시간 추정
+		if p.BytesTotal > 0 && p.BytesRead > 0 && p.BytesRead < p.BytesTotal {
+			elapsed := now.Sub(t.phaseStart)
+			p.ETA = time.Duration(float64(elapsed) * float64(p.BytesTotal-p.BytesRead) / float64(p.BytesRead))
+		}
+	case postalcode.ImportPhaseWrite:
+		if p.RowsPerSecond > 0 && p.RowsWritten < p.RowsTotal {
+			p.ETA = time.Duration(float64(p.RowsTotal-p.RowsWritten) / p.RowsPerSecond * float64(time.Second))
+		}
+	}
+
+	for _, handler := range t.handlers {
+		handler(*p)
+	}
+}
+
+// countingReader는 읽은 바이트 수를 tracker에 기록하는 reader입니다.
+type countingReader struct {
+	r       io.Reader
+	tracker *progressTracker
+}
+
+func (c *countingReader) Read(buf []byte) (int, error) {
+	n, err := c.r.Read(buf)
+	c.tracker.progress.BytesRead += int64(n)
+	return n, err
+}
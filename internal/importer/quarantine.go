@@ -0,0 +1,148 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// 거부 라인 파일에 원본 컬럼 뒤로 추가하는 컬럼입니다.
+// 기본 헤더 규칙에 없는 이름이므로 거부 파일을 수정해 다시 import할 때는 무시됩니다.
+const (
+	rejectLineColumn   = "line"
+	rejectReasonColumn = "reason"
+)
+
+// sourceLine은 유효한 행의 원본 라인 정보입니다. (저장 실패 시 격리용)
+type sourceLine struct {
+	line   int
+	record string
+}
+
+// batchFailure는 저장에 실패한 배치의 범위 [start, end)와 에러입니다.
+type batchFailure struct {
+	start, end int
+	err        error
+}
+
+// batchRejects는 저장에 실패한 배치의 모든 행을 ImportError로 변환합니다.
+// lines는 저장에 사용한 슬라이스와 같은 순서의 원본 라인입니다.
+func batchRejects(failures []batchFailure, lines []sourceLine) []*postalcode.ImportError {
+	var rejects []*postalcode.ImportError
+	for _, f := range failures {
+		for _, line := range lines[f.start:f.end] {
+			rejects = append(rejects, &postalcode.ImportError{
+				Line:    line.line,
+				Message: "배치 저장 실패",
+				Record:  line.record,
+				Err:     f.err,
+			})
+		}
+	}
+	return rejects
+}
+
+// quarantine은 거부된 라인을 WithRejectFile 경로와 WithRejectTable 격리 테이블에 기록합니다.
+//
+// 격리는 import 결과에 영향을 주지 않습니다. 기록에 실패하면 경고 이벤트만 알립니다.
+func (imp *importer) quarantine(run *postalcode.ImportRun, path, dataType, fileName string, header []string, rejects []*postalcode.ImportError) {
+	if len(rejects) == 0 {
+		return
+	}
+
+	sorted := make([]*postalcode.ImportError, len(rejects))
+	copy(sorted, rejects)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Line < sorted[j].Line })
+
+	if path != "" {
+		if err := writeRejectFile(path, header, sorted); err != nil {
+			imp.warn("거부 라인 파일 기록 실패", err)
+		} else {
+			imp.emit(postalcode.Event{Type: postalcode.EventRejectsWritten, DataType: dataType, File: path, Count: len(sorted)})
+		}
+	}
+
+	if imp.rejectTable {
+		records := make([]postalcode.ImportReject, len(sorted))
+		for i, rerr := range sorted {
+			records[i] = postalcode.ImportReject{
+				RunID:    run.ID,
+				DataType: dataType,
+				FileName: fileName,
+				Line:     rerr.Line,
+				Field:    rerr.Field,
+				Value:    rerr.Value,
+				Reason:   rejectReason(rerr),
+				Record:   rerr.Record,
+			}
+		}
+		if err := imp.service.SaveImportRejects(records); err != nil {
+			imp.warn("거부 라인 테이블 기록 실패", err)
+		}
+	}
+}
+
+// writeRejectFile은 거부 라인을 원본과 같은 파이프('|') 구분 형식으로 기록합니다.
+// 원본 헤더 뒤에 라인 번호와 거부 사유 컬럼을 추가하며, 내용은 UTF-8로 기록합니다.
+func writeRejectFile(path string, header []string, rejects []*postalcode.ImportError) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(file)
+	fmt.Fprintln(w, strings.Join(append(append([]string{}, header...), rejectLineColumn, rejectReasonColumn), "|"))
+	for _, rerr := range rejects {
+		record := rerr.Record
+		if record == "" {
+			// 읽을 수 없었던 라인은 컬럼 위치만 맞춤
+			record = strings.Repeat("|", len(header)-1)
+		}
+		fmt.Fprintf(w, "%s|%s|%s\n", record, strconv.Itoa(rerr.Line), sanitizeField(rejectReason(rerr)))
+	}
+
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// rejectReason은 라인 번호를 제외한 거부 사유 문자열입니다.
+func rejectReason(rerr *postalcode.ImportError) string {
+	reason := rerr.Message
+	if rerr.Field != "" {
+		reason = fmt.Sprintf("%s (%s=%q)", reason, rerr.Field, rerr.Value)
+	}
+	if rerr.Err != nil {
+		reason = fmt.Sprintf("%s: %v", reason, rerr.Err)
+	}
+	return reason
+}
+
+// sanitizeField는 파이프 구분 필드에 쓸 수 없는 구분자와 줄바꿈을 치환합니다.
+func sanitizeField(value string) string {
+	return strings.NewReplacer("|", "/", "\r", " ", "\n", " ").Replace(value)
+}
+
+// datasetRejectPath는 데이터셋 import에서 파일별 거부 라인 파일 경로입니다.
+// WithRejectFile 경로를 디렉토리로 사용하며, 원본 파일 이름을 그대로 사용합니다.
+func datasetRejectPath(dir, sourceName string) string {
+	if dir == "" {
+		return ""
+	}
+	name := sourceName
+	if idx := strings.LastIndex(name, ":"); idx >= 0 {
+		name = name[idx+1:] // ZIP 멤버 (archive.zip:dir/file.txt)
+	}
+	return filepath.Join(dir, filepath.Base(name))
+}
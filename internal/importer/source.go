@@ -0,0 +1,212 @@
+package importer
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// 데이터 타입 (파일 헤더로 감지)
+const (
+	DataTypeRoad = "road" // 도로명주소 범위 파일
+	DataTypeLand = "land" // 지번주소 범위 파일
+
+	DataTypeBuilding = "building" // 도로명주소 건물 DB 파일
+	DataTypePOBox    = "pobox"    // 사서함 우편번호 파일
+	DataTypeBulk     = "bulk"     // 다량배달처 우편번호 파일
+)
+
+// dataFileExt는 디렉토리/ZIP 내에서 import 대상으로 취급하는 파일 확장자입니다.
+const dataFileExt = ".txt"
+
+// source는 import 대상 파일 하나입니다. (일반 파일 또는 ZIP 멤버)
+type source struct {
+	name string
+	size int64 // 전체 바이트 수 (모르면 0)
+	open func() (io.ReadCloser, error)
+}
+
+// readerSourceName은 reader source의 표시 이름입니다.
+const readerSourceName = "-"
+
+// fileSource는 일반 파일 source를 생성합니다.
+func fileSource(path string) source {
+	return source{
+		name: path,
+		open: func() (io.ReadCloser, error) {
+			return os.Open(path)
+		},
+	}
+}
+
+// readerSource는 reader를 한 번만 읽을 수 있는 source로 감쌉니다.
+func readerSource(r io.Reader, size int64) source {
+	return source{
+		name: readerSourceName,
+		size: size,
+		open: func() (io.ReadCloser, error) {
+			return io.NopCloser(r), nil
+		},
+	}
+}
+
+// sourceSize는 source의 전체 바이트 수를 반환합니다.
+// 크기가 지정되지 않았으면 열린 파일의 크기를 사용하고, 알 수 없으면 0을 반환합니다.
+func sourceSize(src source, rc io.ReadCloser) int64 {
+	if src.size > 0 {
+		return src.size
+	}
+	if file, ok := rc.(*os.File); ok {
+		if info, err := file.Stat(); err == nil && info.Mode().IsRegular() {
+			return info.Size()
+		}
+	}
+	return 0
+}
+
+// readProgress는 읽은 바이트 수를 추적하여 10% 단위로 읽기 진행률을 알리는 reader입니다.
+type readProgress struct {
+	r          io.Reader
+	size       int64
+	read       int64
+	nextStep   int64
+	onProgress func(read, size int64)
+}
+
+// newReadProgress는 size 바이트를 읽으며 10%마다 onProgress를 호출하는 readProgress를 생성합니다.
+func newReadProgress(r io.Reader, size int64, onProgress func(read, size int64)) *readProgress {
+	return &readProgress{r: r, size: size, nextStep: 10, onProgress: onProgress}
+}
+
+func (p *readProgress) Read(buf []byte) (int, error) {
+	n, err := p.r.Read(buf)
+	p.read += int64(n)
+
+	if percent := p.read * 100 / p.size; percent >= p.nextStep {
+		p.onProgress(p.read, p.size)
+		p.nextStep = percent/10*10 + 10
+	}
+	return n, err
+}
+
+// IsDataset은 path가 여러 파일로 구성된 데이터셋(ZIP 아카이브, 디렉토리, glob 패턴)인지 확인합니다.
+func IsDataset(path string) bool {
+	if hasGlobMeta(path) || strings.EqualFold(filepath.Ext(path), ".zip") {
+		return true
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// hasGlobMeta는 path에 glob 메타 문자가 있는지 확인합니다.
+func hasGlobMeta(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+// listSources는 ZIP 아카이브, 디렉토리, glob 패턴 또는 단일 파일에서 import 대상 파일 목록을 만듭니다.
+// 디렉토리와 ZIP은 .txt 파일만 대상으로 하며, 결과는 이름순으로 정렬됩니다.
+// 반환된 closer는 모든 source 사용이 끝난 뒤 호출해야 합니다.
+func listSources(path string) ([]source, io.Closer, error) {
+	if hasGlobMeta(path) {
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid glob pattern: %w", err)
+		}
+		var sources []source
+		for _, match := range matches {
+			if info, err := os.Stat(match); err == nil && !info.IsDir() {
+				sources = append(sources, fileSource(match))
+			}
+		}
+		return nonEmpty(path, sources, noopCloser{})
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read directory: %w", err)
+		}
+		var sources []source
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.EqualFold(filepath.Ext(entry.Name()), dataFileExt) {
+				sources = append(sources, fileSource(filepath.Join(path, entry.Name())))
+			}
+		}
+		return nonEmpty(path, sources, noopCloser{})
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".zip") {
+		archive, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open zip archive: %w", err)
+		}
+		var sources []source
+		for _, member := range archive.File {
+			member := member
+			if member.FileInfo().IsDir() || !strings.EqualFold(filepath.Ext(member.Name), dataFileExt) {
+				continue
+			}
+			sources = append(sources, source{
+				name: filepath.Base(path) + ":" + member.Name,
+				size: int64(member.UncompressedSize64),
+				open: member.Open,
+			})
+		}
+		sort.Slice(sources, func(i, j int) bool { return sources[i].name < sources[j].name })
+		if len(sources) == 0 {
+			archive.Close()
+		}
+		return nonEmpty(path, sources, archive)
+	}
+
+	return []source{fileSource(path)}, noopCloser{}, nil
+}
+
+// noopCloser는 닫을 리소스가 없는 source 목록에 사용하는 io.Closer입니다.
+type noopCloser struct{}
+
+func (noopCloser) Close() error { return nil }
+
+// nonEmpty는 source가 하나도 없으면 에러를 반환합니다.
+func nonEmpty(path string, sources []source, closer io.Closer) ([]source, io.Closer, error) {
+	if len(sources) == 0 {
+		return nil, nil, fmt.Errorf("%w: no %s files in %s", postalcode.ErrEmptyFile, dataFileExt, path)
+	}
+	return sources, closer, nil
+}
+
+// DetectDataType은 파일 헤더로 도로명주소/지번주소/건물/사서함/다량배달처 여부를 판별합니다.
+func (imp *importer) DetectDataType(filePath string) (string, error) {
+	return imp.detectDataType(fileSource(filePath))
+}
+
+// detectDataType은 source의 헤더를 읽어 도로명주소/지번주소/건물/사서함/다량배달처 파일인지 판별합니다.
+func (imp *importer) detectDataType(src source) (string, error) {
+	rc, err := src.open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer rc.Close()
+
+	reader, _, err := decodeReader(rc, imp.encoding)
+	if err != nil {
+		return "", err
+	}
+
+	rr, err := newRowReader(reader, "", imp.mapping)
+	if err != nil {
+		return "", err
+	}
+	return rr.layout.dataType, nil
+}
@@ -0,0 +1,345 @@
+// Package migration은 dialect별 버전 SQL 마이그레이션을 적용하고 되돌립니다.
+//
+// 마이그레이션 파일은 dialect 디렉토리 아래의 "<버전>_<이름>.up.sql"/"<버전>_<이름>.down.sql" 쌍이며,
+// 적용 기록은 schema_migrations 테이블에 저장합니다. 마이그레이션 하나는 한 트랜잭션에서 실행합니다.
+// (MySQL은 DDL이 암묵적으로 커밋되므로 실패한 마이그레이션의 앞부분 문장이 남을 수 있음)
+package migration
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"gorm.io/gorm"
+)
+
+// Migration은 버전 SQL 마이그레이션 하나입니다.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string // 적용 SQL (여러 문장 가능)
+	Down    string // 되돌리기 SQL (여러 문장 가능)
+}
+
+// Migrator는 DB에 마이그레이션을 적용하고 되돌립니다.
+type Migrator interface {
+	// Up은 적용하지 않은 모든 마이그레이션을 버전 순으로 적용하고, 적용한 마이그레이션을 반환합니다.
+	Up() ([]Migration, error)
+
+	// Down은 마지막으로 적용한 마이그레이션부터 n개를 되돌리고, 되돌린 마이그레이션을 반환합니다.
+	Down(n int) ([]Migration, error)
+
+	// Status는 모든 마이그레이션의 적용 상태를 버전 순으로 반환합니다.
+	// 적용 기록은 있지만 파일이 없는 마이그레이션도 Missing으로 포함합니다.
+	Status() ([]postalcode.MigrationStatus, error)
+
+	// Migrations는 이 DB dialect의 마이그레이션 목록을 버전 순으로 반환합니다.
+	Migrations() []Migration
+}
+
+// migrator는 Migrator 인터페이스 구현입니다.
+type migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// New는 fsys의 DB dialect 디렉토리(mysql, postgres, sqlite)에 있는 마이그레이션으로 Migrator를 생성합니다.
+func New(db *gorm.DB, fsys fs.FS) (Migrator, error) {
+	migrations, err := Load(fsys, db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &migrator{db: db, migrations: migrations}, nil
+}
+
+// fileNamePattern은 마이그레이션 파일 이름 형식입니다. (예: 0001_create_postal_code_roads.up.sql)
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Load는 fsys의 dialect 디렉토리에서 마이그레이션을 읽어 버전 순으로 반환합니다.
+// 파일 이름이 형식에 맞지 않거나, up/down 쌍이 없거나, 버전이 중복되면 ErrInvalidMigration을 반환합니다.
+func Load(fsys fs.FS, dialect string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dialect)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s migrations: %w", dialect, err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	found := make(map[int64]map[string]bool)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("%w: %s/%s: file name must be <version>_<name>.up.sql or .down.sql", postalcode.ErrInvalidMigration, dialect, entry.Name())
+		}
+		version, _ := strconv.ParseInt(match[1], 10, 64)
+		name, direction := match[2], match[3]
+
+		content, err := fs.ReadFile(fsys, path.Join(dialect, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+			found[version] = make(map[string]bool)
+		}
+		if m.Name != name || found[version][direction] {
+			return nil, fmt.Errorf("%w: %s: duplicate version %d", postalcode.ErrInvalidMigration, dialect, version)
+		}
+		found[version][direction] = true
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for version, m := range byVersion {
+		if !found[version]["up"] || !found[version]["down"] {
+			return nil, fmt.Errorf("%w: %s: migration %d_%s must have both up and down files", postalcode.ErrInvalidMigration, dialect, version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrations는 이 DB dialect의 마이그레이션 목록을 버전 순으로 반환합니다.
+func (m *migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// applied는 schema_migrations 테이블을 준비하고 적용 기록을 버전 순으로 반환합니다.
+func (m *migrator) applied() ([]postalcode.SchemaMigration, error) {
+	if err := m.db.AutoMigrate(&postalcode.SchemaMigration{}); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	var rows []postalcode.SchemaMigration
+	if err := m.db.Order("version").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	return rows, nil
+}
+
+// Up은 적용하지 않은 모든 마이그레이션을 버전 순으로 적용합니다.
+// 마지막으로 적용한 버전보다 낮은 버전이 추가된 경우에도 적용합니다.
+func (m *migrator) Up() ([]Migration, error) {
+	rows, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		done[row.Version] = true
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations {
+		if done[migration.Version] {
+			continue
+		}
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := execAll(tx, migration.Up); err != nil {
+				return err
+			}
+			return tx.Create(&postalcode.SchemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now().UTC()}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("migration %04d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		applied = append(applied, migration)
+	}
+	return applied, nil
+}
+
+// Down은 마지막으로 적용한 마이그레이션부터 n개를 되돌립니다.
+// 되돌릴 마이그레이션의 파일이 없으면 ErrMigrationNotFound를 반환합니다.
+func (m *migrator) Down(n int) ([]Migration, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	rows, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	var reverted []Migration
+	for i := len(rows) - 1; i >= 0 && len(reverted) < n; i-- {
+		migration, ok := byVersion[rows[i].Version]
+		if !ok {
+			return reverted, fmt.Errorf("%w: %04d_%s", postalcode.ErrMigrationNotFound, rows[i].Version, rows[i].Name)
+		}
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := execAll(tx, migration.Down); err != nil {
+				return err
+			}
+			return tx.Delete(&postalcode.SchemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("rollback %04d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		reverted = append(reverted, migration)
+	}
+	return reverted, nil
+}
+
+// Status는 모든 마이그레이션의 적용 상태를 버전 순으로 반환합니다.
+func (m *migrator) Status() ([]postalcode.MigrationStatus, error) {
+	rows, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[int64]*postalcode.MigrationStatus)
+	for _, migration := range m.migrations {
+		statuses[migration.Version] = &postalcode.MigrationStatus{Version: migration.Version, Name: migration.Name}
+	}
+	for i := range rows {
+		status := statuses[rows[i].Version]
+		if status == nil {
+			status = &postalcode.MigrationStatus{Version: rows[i].Version, Name: rows[i].Name, Missing: true}
+			statuses[rows[i].Version] = status
+		}
+		status.Applied = true
+		status.AppliedAt = &rows[i].AppliedAt
+	}
+
+	result := make([]postalcode.MigrationStatus, 0, len(statuses))
+	for _, status := range statuses {
+		result = append(result, *status)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// execAll은 sql의 문장을 하나씩 실행합니다. (MySQL 드라이버는 기본적으로 여러 문장을 한 번에 실행하지 않음)
+func execAll(tx *gorm.DB, sql string) error {
+	for _, stmt := range SplitStatements(sql) {
+		if err := tx.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SplitStatements는 SQL 스크립트를 세미콜론으로 나눈 문장 목록을 반환합니다.
+// "--" 주석을 제거하며, 작은따옴표/큰따옴표/백틱 안의 세미콜론과 "--"는 문장의 일부로 취급합니다.
+// 주석만 있는 스크립트는 빈 목록을 반환합니다.
+func SplitStatements(sql string) []string {
+	var statements []string
+	var current strings.Builder
+	var quote byte
+
+	flush := func() {
+		if stmt := strings.TrimSpace(current.String()); stmt != "" {
+			statements = append(statements, stmt)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			// 따옴표 두 개('')는 이스케이프이며, 닫고 바로 여는 것과 결과가 같음
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+			current.WriteByte('\n')
+			continue
+		case c == ';':
+			flush()
+			continue
+		}
+		current.WriteByte(c)
+	}
+	flush()
+	return statements
+}
+
+// namePattern은 새 마이그레이션 이름 형식입니다.
+var namePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Create는 dir 아래의 모든 dialect 디렉토리에 다음 버전의 빈 up/down 파일을 만들고 경로를 반환합니다.
+// 다음 버전은 모든 dialect의 가장 큰 버전 + 1이며, 버전 번호는 4자리로 채웁니다.
+func Create(dir, name string) ([]string, error) {
+	if !namePattern.MatchString(name) {
+		return nil, fmt.Errorf("%w: name must be lowercase letters, digits and underscores: %q", postalcode.ErrInvalidMigration, name)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	var dialects []string
+	var last int64
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dialects = append(dialects, entry.Name())
+		migrations, err := Load(os.DirFS(dir), entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if n := len(migrations); n > 0 && migrations[n-1].Version > last {
+			last = migrations[n-1].Version
+		}
+	}
+	if len(dialects) == 0 {
+		return nil, fmt.Errorf("no dialect directories in %s", dir)
+	}
+
+	version := last + 1
+	var paths []string
+	for _, dialect := range dialects {
+		for _, direction := range []string{"up", "down"} {
+			p := filepath.Join(dir, dialect, fmt.Sprintf("%04d_%s.%s.sql", version, name, direction))
+			content := fmt.Sprintf("-- %s (%s, %s)\n", name, dialect, direction)
+			if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+				return paths, fmt.Errorf("failed to create migration file: %w", err)
+			}
+			paths = append(paths, p)
+		}
+	}
+	return paths, nil
+}
+
+// IsManaged는 DB 스키마를 버전 마이그레이션으로 관리하는지(schema_migrations에 적용 기록이 있는지) 확인합니다.
+// 관리 중인 DB는 AutoMigrate로 스키마를 바꾸지 않아야 합니다.
+func IsManaged(db *gorm.DB) (bool, error) {
+	if !db.Migrator().HasTable(&postalcode.SchemaMigration{}) {
+		return false, nil
+	}
+	var count int64
+	if err := db.Model(&postalcode.SchemaMigration{}).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
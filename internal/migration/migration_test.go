@@ -0,0 +1,284 @@
+package migration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+
+	// 메모리 DB는 연결마다 별도의 DB이므로 연결을 하나만 사용
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	return db
+}
+
+func TestLoad_EmbeddedDialects(t *testing.T) {
+	var versions [][]string
+	for _, dialect := range []string{"mysql", "postgres", "sqlite"} {
+		loaded, err := Load(migrations.FS, dialect)
+		require.NoError(t, err, dialect)
+		require.NotEmpty(t, loaded, dialect)
+
+		var names []string
+		for _, m := range loaded {
+			names = append(names, m.Name)
+			for _, stmt := range append(SplitStatements(m.Up), SplitStatements(m.Down)...) {
+				assert.Regexp(t, `^(CREATE|DROP|ALTER|COMMENT)\b`, stmt, "%s %04d_%s", dialect, m.Version, m.Name)
+			}
+		}
+		versions = append(versions, names)
+	}
+
+	// 버전 번호는 모든 dialect에서 같음
+	assert.Equal(t, versions[0], versions[1])
+	assert.Equal(t, versions[0], versions[2])
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	_, err := Load(fstest.MapFS{"sqlite/0001_a.up.sql": {Data: []byte("")}}, "sqlite")
+	assert.ErrorIs(t, err, postalcode.ErrInvalidMigration)
+
+	_, err = Load(fstest.MapFS{"sqlite/create_a.up.sql": {Data: []byte("")}}, "sqlite")
+	assert.ErrorIs(t, err, postalcode.ErrInvalidMigration)
+
+	_, err = Load(fstest.MapFS{
+		"sqlite/0001_a.up.sql": {}, "sqlite/0001_a.down.sql": {},
+		"sqlite/0001_b.up.sql": {}, "sqlite/0001_b.down.sql": {},
+	}, "sqlite")
+	assert.ErrorIs(t, err, postalcode.ErrInvalidMigration)
+
+	_, err = Load(fstest.MapFS{}, "oracle")
+	assert.Error(t, err)
+}
+
+func TestSplitStatements(t *testing.T) {
+	sql := `-- 주석; 무시
+CREATE TABLE a (
+    name VARCHAR(10) COMMENT '이름; -- 주석 아님' -- 줄 끝 주석
+);
+INSERT INTO a VALUES ('it''s');
+
+-- 마지막 문장은 세미콜론 없음
+DROP TABLE "b;c"`
+
+	assert.Equal(t, []string{
+		"CREATE TABLE a (\n    name VARCHAR(10) COMMENT '이름; -- 주석 아님' \n)",
+		"INSERT INTO a VALUES ('it''s')",
+		`DROP TABLE "b;c"`,
+	}, SplitStatements(sql))
+	assert.Empty(t, SplitStatements("-- 빈 마이그레이션\n-- 설명만 있음\n"))
+}
+
+func TestMigrator_UpDownStatus(t *testing.T) {
+	db := setupTestDB(t)
+	m, err := New(db, migrations.FS)
+	require.NoError(t, err)
+	total := len(m.Migrations())
+
+	managed, err := IsManaged(db)
+	require.NoError(t, err)
+	assert.False(t, managed)
+
+	statuses, err := m.Status()
+	require.NoError(t, err)
+	require.Len(t, statuses, total)
+	assert.False(t, statuses[0].Applied)
+
+	applied, err := m.Up()
+	require.NoError(t, err)
+	assert.Len(t, applied, total)
+	assert.True(t, db.Migrator().HasTable(&postalcode.PostalCodeRoad{}))
+	assert.True(t, db.Migrator().HasTable(&postalcode.PostalCodeBulkDelivery{}))
+
+	// 다시 실행하면 적용할 마이그레이션이 없음
+	applied, err = m.Up()
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+
+	managed, err = IsManaged(db)
+	require.NoError(t, err)
+	assert.True(t, managed)
+
+	// SQL 마이그레이션으로 만든 스키마는 모델과 같음
+	drifts, err := Verify(db)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+
+	// 다량배달처 테이블 마이그레이션부터 마지막까지 되돌리기
+	bulk := -1
+	for i, migration := range m.Migrations() {
+		if migration.Name == "create_postal_code_bulk_deliveries" {
+			bulk = i
+		}
+	}
+	require.NotEqual(t, -1, bulk)
+	steps := total - bulk
+	reverted, err := m.Down(steps)
+	require.NoError(t, err)
+	require.Len(t, reverted, steps)
+	assert.Equal(t, m.Migrations()[total-1].Version, reverted[0].Version)
+	assert.Equal(t, m.Migrations()[bulk].Version, reverted[steps-1].Version)
+	assert.False(t, db.Migrator().HasTable(&postalcode.PostalCodeBulkDelivery{}))
+	assert.True(t, db.Migrator().HasTable(&postalcode.PostalCodePOBox{}))
+
+	statuses, err = m.Status()
+	require.NoError(t, err)
+	assert.True(t, statuses[bulk-1].Applied)
+	assert.NotNil(t, statuses[bulk-1].AppliedAt)
+	assert.False(t, statuses[bulk].Applied)
+	assert.False(t, statuses[total-1].Applied)
+
+	// 다량배달처 테이블과 그 뒤에 추가한 import_runs.truncated 컬럼이 없음
+	drifts, err = Verify(db)
+	require.NoError(t, err)
+	require.Len(t, drifts, 2)
+	assert.Equal(t, postalcode.DriftMissingColumn, drifts[0].Kind)
+	assert.Equal(t, "import_runs", drifts[0].Table)
+	assert.Equal(t, "truncated", drifts[0].Name)
+	assert.Equal(t, postalcode.DriftMissingTable, drifts[1].Kind)
+	assert.Equal(t, "postal_code_bulk_deliveries", drifts[1].Table)
+
+	applied, err = m.Up()
+	require.NoError(t, err)
+	assert.Len(t, applied, steps)
+
+	// 전체 되돌리기
+	reverted, err = m.Down(total + 10)
+	require.NoError(t, err)
+	assert.Len(t, reverted, total)
+	assert.False(t, db.Migrator().HasTable(&postalcode.PostalCodeRoad{}))
+}
+
+func TestMigrator_DownMissingFile(t *testing.T) {
+	db := setupTestDB(t)
+	fsys := fstest.MapFS{
+		"sqlite/0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INTEGER);")},
+		"sqlite/0001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+		"sqlite/0002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id INTEGER); CREATE INDEX idx_b ON b (id);")},
+		"sqlite/0002_create_b.down.sql": {Data: []byte("DROP TABLE b;")},
+	}
+	m, err := New(db, fsys)
+	require.NoError(t, err)
+	_, err = m.Up()
+	require.NoError(t, err)
+
+	// 0002 파일이 없어진 경우
+	delete(fsys, "sqlite/0002_create_b.up.sql")
+	delete(fsys, "sqlite/0002_create_b.down.sql")
+	m, err = New(db, fsys)
+	require.NoError(t, err)
+
+	statuses, err := m.Status()
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.True(t, statuses[1].Applied)
+	assert.True(t, statuses[1].Missing)
+
+	_, err = m.Down(1)
+	assert.ErrorIs(t, err, postalcode.ErrMigrationNotFound)
+	assert.True(t, db.Migrator().HasTable("b"))
+}
+
+func TestMigrator_UpFailureRollsBack(t *testing.T) {
+	db := setupTestDB(t)
+	m, err := New(db, fstest.MapFS{
+		"sqlite/0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INTEGER);")},
+		"sqlite/0001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+		"sqlite/0002_broken.up.sql":     {Data: []byte("CREATE TABLE b (id INTEGER); CREATE TABLE a (id INTEGER);")},
+		"sqlite/0002_broken.down.sql":   {Data: []byte("DROP TABLE b;")},
+	})
+	require.NoError(t, err)
+
+	applied, err := m.Up()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "0002_broken")
+	assert.Len(t, applied, 1)
+
+	// SQLite는 DDL도 트랜잭션으로 되돌려짐
+	assert.False(t, db.Migrator().HasTable("b"))
+	statuses, err := m.Status()
+	require.NoError(t, err)
+	assert.True(t, statuses[0].Applied)
+	assert.False(t, statuses[1].Applied)
+}
+
+func TestVerify_DetectsDrift(t *testing.T) {
+	db := setupTestDB(t)
+
+	// AutoMigrate로 만든 스키마는 차이가 없음
+	require.NoError(t, db.AutoMigrate(Models()...))
+	drifts, err := Verify(db)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+
+	// 컬럼 누락, 타입/NULL 차이, 추가 컬럼, 인덱스 누락/불일치
+	require.NoError(t, db.Migrator().DropTable(&postalcode.PostalCodeRoad{}))
+	require.NoError(t, db.Exec(`CREATE TABLE postal_code_roads (
+		id INTEGER PRIMARY KEY AUTOINCREMENT, zip_code INTEGER NOT NULL, zip_prefix CHAR(3) NOT NULL,
+		sido_name VARCHAR(40), sido_name_en VARCHAR(40), sigungu_name VARCHAR(40) NOT NULL, sigungu_name_en VARCHAR(40),
+		eupmyeon_name VARCHAR(40), eupmyeon_name_en VARCHAR(40), road_name VARCHAR(80) NOT NULL,
+		is_underground NUMERIC, start_building_main INTEGER NOT NULL, start_building_sub INTEGER, end_building_main INTEGER,
+		end_building_sub INTEGER, range_type INTEGER NOT NULL, created_at DATETIME, updated_at DATETIME, legacy_code VARCHAR(10))`).Error)
+	require.NoError(t, db.Exec("CREATE INDEX idx_zipcode ON postal_code_roads (zip_code, zip_prefix)").Error)
+	require.NoError(t, db.Exec("CREATE INDEX idx_postal_unique ON postal_code_roads (zip_code, sido_name, sigungu_name, road_name, start_building_main)").Error)
+
+	drifts, err = Verify(db, &postalcode.PostalCodeRoad{})
+	require.NoError(t, err)
+
+	kinds := make(map[string][]string)
+	for _, drift := range drifts {
+		assert.Equal(t, "postal_code_roads", drift.Table)
+		kinds[drift.Kind] = append(kinds[drift.Kind], drift.Name)
+	}
+	assert.Equal(t, []string{"road_name_en"}, kinds[postalcode.DriftMissingColumn])
+	assert.Equal(t, []string{"zip_code"}, kinds[postalcode.DriftColumnType])
+	assert.Equal(t, []string{"sido_name"}, kinds[postalcode.DriftNullable])
+	assert.Equal(t, []string{"legacy_code"}, kinds[postalcode.DriftExtraColumn])
+	assert.ElementsMatch(t, []string{"idx_zipcode", "idx_postal_unique"}, kinds[postalcode.DriftIndexMismatch])
+	assert.ElementsMatch(t, []string{"idx_zip_prefix", "idx_sido", "idx_sigungu", "idx_road"}, kinds[postalcode.DriftMissingIndex])
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	for _, dialect := range []string{"mysql", "sqlite"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, dialect), 0o755))
+	}
+	for _, name := range []string{"0001_create_a.up.sql", "0001_create_a.down.sql"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "mysql", name), nil, 0o644))
+	}
+	for _, name := range []string{"0001_create_a.up.sql", "0001_create_a.down.sql", "0002_create_b.up.sql", "0002_create_b.down.sql"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "sqlite", name), nil, 0o644))
+	}
+
+	paths, err := Create(dir, "add_index")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "mysql", "0003_add_index.up.sql"),
+		filepath.Join(dir, "mysql", "0003_add_index.down.sql"),
+		filepath.Join(dir, "sqlite", "0003_add_index.up.sql"),
+		filepath.Join(dir, "sqlite", "0003_add_index.down.sql"),
+	}, paths)
+
+	loaded, err := Load(os.DirFS(dir), "mysql")
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	assert.Equal(t, int64(3), loaded[1].Version)
+	assert.Empty(t, SplitStatements(loaded[1].Up))
+
+	_, err = Create(dir, "Add Index")
+	assert.ErrorIs(t, err, postalcode.ErrInvalidMigration)
+}
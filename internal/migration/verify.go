@@ -0,0 +1,147 @@
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// Models는 버전 마이그레이션이 관리하는 테이블의 모델입니다.
+func Models() []interface{} {
+	return []interface{}{
+		&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.ImportRun{}, &postalcode.ImportReject{},
+		&postalcode.PostalCodeBuilding{}, &postalcode.PostalCodePOBox{}, &postalcode.PostalCodeBulkDelivery{},
+	}
+}
+
+// Verify는 모델 정의와 실제 DB 스키마를 비교하여 차이를 반환합니다. models가 없으면 Models()를 비교합니다.
+// 테이블/컬럼 존재 여부, 컬럼 타입 호환성(문자열/정수/불리언/시간/실수), NOT NULL, 모델에 정의한 인덱스의 컬럼과 유니크 여부를 확인합니다.
+// DB에만 있는 인덱스(PostgreSQL trigram 인덱스 등)는 차이로 보지 않습니다.
+func Verify(db *gorm.DB, models ...interface{}) ([]postalcode.SchemaDrift, error) {
+	if len(models) == 0 {
+		models = Models()
+	}
+	// SQLite 드라이버의 GetIndexes는 Debug()로 실행되므로 로그를 버림
+	db = db.Session(&gorm.Session{Logger: logger.Discard})
+
+	var drifts []postalcode.SchemaDrift
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, fmt.Errorf("failed to parse model: %w", err)
+		}
+		tableDrifts, err := verifyTable(db, model, stmt.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect table %s: %w", stmt.Schema.Table, err)
+		}
+		drifts = append(drifts, tableDrifts...)
+	}
+	return drifts, nil
+}
+
+// verifyTable은 테이블 하나의 컬럼과 인덱스를 모델과 비교합니다.
+func verifyTable(db *gorm.DB, model interface{}, sch *schema.Schema) ([]postalcode.SchemaDrift, error) {
+	table := sch.Table
+	if !db.Migrator().HasTable(table) {
+		return []postalcode.SchemaDrift{{Table: table, Kind: postalcode.DriftMissingTable, Detail: "table not found"}}, nil
+	}
+
+	columnTypes, err := db.Migrator().ColumnTypes(model)
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]gorm.ColumnType, len(columnTypes))
+	for _, column := range columnTypes {
+		columns[strings.ToLower(column.Name())] = column
+	}
+
+	var drifts []postalcode.SchemaDrift
+	for _, name := range sch.DBNames {
+		field := sch.FieldsByDBName[name]
+		column, ok := columns[name]
+		if !ok {
+			drifts = append(drifts, postalcode.SchemaDrift{Table: table, Kind: postalcode.DriftMissingColumn, Name: name,
+				Detail: fmt.Sprintf("column %s not found", name)})
+			continue
+		}
+
+		dbType := strings.ToLower(column.DatabaseTypeName())
+		if !compatibleType(field.GORMDataType, dbType) {
+			drifts = append(drifts, postalcode.SchemaDrift{Table: table, Kind: postalcode.DriftColumnType, Name: name,
+				Detail: fmt.Sprintf("column %s is %s, model field %s is %s", name, dbType, field.Name, field.GORMDataType)})
+		}
+		if nullable, ok := column.Nullable(); ok && nullable && field.NotNull && !field.PrimaryKey {
+			drifts = append(drifts, postalcode.SchemaDrift{Table: table, Kind: postalcode.DriftNullable, Name: name,
+				Detail: fmt.Sprintf("column %s allows NULL, model requires NOT NULL", name)})
+		}
+	}
+	for _, column := range columnTypes {
+		if _, ok := sch.FieldsByDBName[strings.ToLower(column.Name())]; !ok {
+			drifts = append(drifts, postalcode.SchemaDrift{Table: table, Kind: postalcode.DriftExtraColumn, Name: column.Name(),
+				Detail: fmt.Sprintf("column %s is not in the model", column.Name())})
+		}
+	}
+
+	indexes, err := db.Migrator().GetIndexes(model)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]gorm.Index, len(indexes))
+	for _, index := range indexes {
+		byName[index.Name()] = index
+	}
+	for _, want := range sch.ParseIndexes() {
+		index, ok := byName[want.Name]
+		if !ok {
+			drifts = append(drifts, postalcode.SchemaDrift{Table: table, Kind: postalcode.DriftMissingIndex, Name: want.Name,
+				Detail: fmt.Sprintf("index %s not found", want.Name)})
+			continue
+		}
+
+		wantColumns := make([]string, 0, len(want.Fields))
+		for _, option := range want.Fields {
+			if option.Field != nil {
+				wantColumns = append(wantColumns, option.DBName)
+			}
+		}
+		if got := index.Columns(); strings.Join(got, ",") != strings.Join(wantColumns, ",") {
+			drifts = append(drifts, postalcode.SchemaDrift{Table: table, Kind: postalcode.DriftIndexMismatch, Name: want.Name,
+				Detail: fmt.Sprintf("index %s is on (%s), model defines (%s)", want.Name, strings.Join(got, ", "), strings.Join(wantColumns, ", "))})
+		}
+		if unique, ok := index.Unique(); ok && unique != (want.Class == "UNIQUE") {
+			drifts = append(drifts, postalcode.SchemaDrift{Table: table, Kind: postalcode.DriftIndexMismatch, Name: want.Name,
+				Detail: fmt.Sprintf("index %s unique=%t, model defines unique=%t", want.Name, unique, want.Class == "UNIQUE")})
+		}
+	}
+	return drifts, nil
+}
+
+// typeFamilies는 모델 필드 타입별로 호환되는 DB 타입 이름에 포함되는 문자열입니다.
+// 정확한 타입 대신 계열을 비교하므로 dialect별 표기 차이(varchar/character varying, int4/integer 등)는 차이로 보지 않습니다.
+// MySQL의 불리언은 tinyint(1), SQLite는 numeric으로 만들어집니다.
+var typeFamilies = map[schema.DataType][]string{
+	schema.String: {"char", "text", "clob"},
+	schema.Int:    {"int", "serial"},
+	schema.Uint:   {"int", "serial"},
+	schema.Bool:   {"bool", "tinyint", "bit", "numeric"},
+	schema.Float:  {"float", "double", "real", "numeric", "decimal"},
+	schema.Time:   {"date", "time"},
+}
+
+// compatibleType은 DB 컬럼 타입이 모델 필드 타입과 같은 계열인지 확인합니다. 알 수 없는 필드 타입은 호환으로 봅니다.
+func compatibleType(dataType schema.DataType, dbType string) bool {
+	families, ok := typeFamilies[dataType]
+	if !ok {
+		return true
+	}
+	for _, family := range families {
+		if strings.Contains(dbType, family) {
+			return true
+		}
+	}
+	return false
+}
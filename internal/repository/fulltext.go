@@ -0,0 +1,353 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"gorm.io/gorm"
+)
+
+// roadTextColumns는 도로명주소 통합 검색어(SearchParams.Query)의 검색 대상 컬럼입니다. (idx_road_fulltext와 같은 순서)
+var roadTextColumns = []string{"road_name", "sigungu_name", "eupmyeon_name"}
+
+// landTextColumns는 지번주소 통합 검색어(SearchParamsLand.Query)의 검색 대상 컬럼입니다. (idx_land_fulltext와 같은 순서)
+var landTextColumns = []string{"sigungu_name", "eupmyeondong_name", "ri_name"}
+
+// textCandidateLimit는 SQLite 역색인으로 좁힌 후보 ID를 IN 조건으로 넘기는 최대 개수입니다.
+// 후보가 이보다 많은 검색어(한 글자 단어 등)는 역색인이 거의 도움이 되지 않으므로 LIKE 조건만 사용합니다.
+const textCandidateLimit = 10000
+
+// booleanOperators는 MySQL BOOLEAN MODE 연산자 문자입니다. 통합 검색어에서는 제거합니다.
+const booleanOperators = `+-<>()~*"@`
+
+// QueryTerms는 통합 검색어를 공백으로 나눈 검색 단어를 반환합니다.
+// MySQL BOOLEAN MODE 연산자 문자는 제거하고, 대소문자만 다른 같은 단어는 한 번만 반환합니다.
+// 모든 Repository 구현은 단어마다 검색 대상 컬럼 중 하나에 (대소문자 구분 없이) 포함된 행만 반환합니다.
+func QueryTerms(q string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Fields(q) {
+		term := strings.Map(func(r rune) rune {
+			if strings.ContainsRune(booleanOperators, r) {
+				return -1
+			}
+			return r
+		}, field)
+		key := strings.ToLower(term)
+		if term == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// matchesQuery는 terms가 모두 values 중 하나에 포함되는지 확인합니다. (applyQuery와 같은 조건)
+func matchesQuery(terms []string, values ...string) bool {
+	for _, term := range terms {
+		found := false
+		for _, value := range values {
+			if like(value, term) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// applyQuery는 통합 검색어 조건을 쿼리에 추가합니다.
+// 단어마다 검색 대상 컬럼의 LIKE 조건을 OR로 묶으며, WithFullTextSearch 옵션이 있으면 먼저 후보를 좁힌 뒤 LIKE 조건으로 다시 확인합니다.
+// MySQL은 두 글자 이상인 단어를 FULLTEXT 인덱스(MATCH ... AGAINST)로 찾고, SQLite는 내장 역색인으로 후보 ID를 찾습니다.
+func (r *gormRepository) applyQuery(query *gorm.DB, q string, columns []string, textIndex func() (idIndex, error)) *gorm.DB {
+	terms := QueryTerms(q)
+	if len(terms) == 0 {
+		return query
+	}
+
+	if r.opts.fullText {
+		switch r.db.Dialector.Name() {
+		case "mysql":
+			if against := booleanQuery(terms); against != "" {
+				query = query.Where(fmt.Sprintf("MATCH(%s) AGAINST (? IN BOOLEAN MODE)", strings.Join(columns, ", ")), against)
+			}
+		case "sqlite":
+			idx, err := textIndex()
+			if err != nil {
+				_ = query.AddError(err)
+				return query
+			}
+			if ids := idx.textCandidates(terms); len(ids) <= textCandidateLimit {
+				query = query.Where("id IN ?", ids)
+			}
+		}
+	}
+
+	conditions := make([]string, len(columns))
+	for i, column := range columns {
+		conditions[i] = r.like(column)
+	}
+	condition := "(" + strings.Join(conditions, " OR ") + ")"
+	for _, term := range terms {
+		args := make([]interface{}, len(columns))
+		for i := range args {
+			args[i] = "%" + term + "%"
+		}
+		query = query.Where(condition, args...)
+	}
+	return query
+}
+
+// booleanQuery는 검색 단어를 MySQL BOOLEAN MODE 검색식으로 만듭니다. 모든 단어가 있어야 합니다.
+// ngram parser는 단어를 2글자 조각(ngram_token_size)의 구문으로 검색합니다.
+// 한 글자 단어는 2글자 조각으로 찾을 수 없으므로(단어 끝 글자 등) 검색식에서 빼고 LIKE 조건으로만 확인하며,
+// 모든 단어가 한 글자이면 빈 문자열을 반환합니다.
+func booleanQuery(terms []string) string {
+	var parts []string
+	for _, term := range terms {
+		if len([]rune(term)) > 1 {
+			parts = append(parts, `+"`+term+`"`)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// ============================================================
+// 내장 역색인 (SQLite / 메모리)
+// ============================================================
+
+// textKeys는 values를 역색인 키(소문자로 바꾼 2글자 조각)로 나눕니다. 한 글자 값은 그 글자를 키로 사용합니다.
+func textKeys(values ...string) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	add := func(key string) {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	for _, value := range values {
+		runes := []rune(strings.ToLower(value))
+		if len(runes) == 1 {
+			add(string(runes))
+		}
+		for i := 0; i+1 < len(runes); i++ {
+			add(string(runes[i : i+2]))
+		}
+	}
+	return keys
+}
+
+// addText는 values의 역색인 키에 id를 추가합니다.
+func (idx idIndex) addText(id uint, values ...string) {
+	for _, key := range textKeys(values...) {
+		idx.add(key, id)
+	}
+}
+
+// removeText는 values의 역색인 키에서 id를 제거합니다.
+func (idx idIndex) removeText(id uint, values ...string) {
+	for _, key := range textKeys(values...) {
+		idx.remove(key, id)
+	}
+}
+
+// textCandidates는 역색인으로 terms를 모두 포함할 수 있는 행 ID를 오름차순으로 반환합니다.
+// 단어의 2글자 조각이 모두 있는 행(한 글자 단어는 그 글자가 들어간 키의 행)이므로,
+// 조각이 서로 다른 컬럼에 있는 행도 포함될 수 있어 실제 포함 여부는 따로 확인해야 합니다.
+func (idx idIndex) textCandidates(terms []string) []uint {
+	var ids []uint
+	for i, term := range terms {
+		termIDs := idx.termCandidates(term)
+		if i == 0 {
+			ids = termIDs
+		} else {
+			ids = intersectIDs(ids, termIDs)
+		}
+		if len(ids) == 0 {
+			return []uint{}
+		}
+	}
+	return ids
+}
+
+// termCandidates는 검색 단어 하나를 포함할 수 있는 행 ID를 오름차순으로 반환합니다.
+func (idx idIndex) termCandidates(term string) []uint {
+	runes := []rune(strings.ToLower(term))
+	if len(runes) == 1 {
+		var ids []uint
+		for key, keyIDs := range idx {
+			if strings.ContainsRune(key, runes[0]) {
+				ids = append(ids, keyIDs...)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		return uniqueIDs(ids)
+	}
+
+	var ids []uint
+	for i := 0; i+1 < len(runes); i++ {
+		keyIDs := idx[string(runes[i:i+2])]
+		if i == 0 {
+			ids = keyIDs
+		} else {
+			ids = intersectIDs(ids, keyIDs)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+	}
+	return ids
+}
+
+// intersectIDs는 두 오름차순 ID 목록의 교집합을 새 목록으로 반환합니다.
+func intersectIDs(a, b []uint) []uint {
+	ids := make([]uint, 0, len(a))
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			ids = append(ids, a[i])
+			i++
+			j++
+		}
+	}
+	return ids
+}
+
+// uniqueIDs는 오름차순 ID 목록에서 중복을 제거합니다.
+func uniqueIDs(ids []uint) []uint {
+	unique := ids[:0]
+	for i, id := range ids {
+		if i == 0 || id != ids[i-1] {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// textIndexes는 SQLite gormRepository의 통합 검색어 역색인입니다.
+// 테이블별로 첫 검색 때 검색 대상 컬럼을 읽어 만들고, 이 Repository를 통해 쓰면 버렸다가 다음 검색 때 다시 만듭니다.
+// 다른 프로세스나 다른 Repository가 같은 DB에 쓴 내용은 검색마다 textStamp를 비교하여 바뀌었으면 다시 만듭니다.
+type textIndexes struct {
+	mu         sync.Mutex
+	roads      idIndex
+	roadsStamp textStamp
+	lands      idIndex
+	landsStamp textStamp
+}
+
+// textStamp는 역색인을 만든 시점의 테이블 상태입니다.
+// SQLite는 truncate 후 ID를 다시 1부터 쓰므로 테이블의 MAX(id)만으로는 같은 건수의 재import를 구분할 수 없어,
+// import 실행마다 증가하는 import_runs의 MAX(id)도 함께 비교합니다.
+type textStamp struct {
+	maxID    int64 // 테이블의 MAX(id)
+	maxRunID int64 // import_runs의 MAX(id) (테이블이 없으면 0)
+}
+
+func (t *textIndexes) resetRoads() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.roads = nil
+}
+
+func (t *textIndexes) resetLands() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lands = nil
+}
+
+// roadTextIndex는 도로명주소 역색인을 반환합니다. 없거나 테이블 상태가 바뀌었으면 테이블을 읽어 만듭니다.
+func (r *gormRepository) roadTextIndex() (idIndex, error) {
+	r.text.mu.Lock()
+	defer r.text.mu.Unlock()
+
+	stamp, err := r.textStamp(&postalcode.PostalCodeRoad{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check road text index: %w", err)
+	}
+	if r.text.roads == nil || r.text.roadsStamp != stamp {
+		idx, err := r.loadTextIndex(&postalcode.PostalCodeRoad{}, roadTextColumns)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build road text index: %w", err)
+		}
+		r.text.roads, r.text.roadsStamp = idx, stamp
+	}
+	return r.text.roads, nil
+}
+
+// landTextIndex는 지번주소 역색인을 반환합니다. 없거나 테이블 상태가 바뀌었으면 테이블을 읽어 만듭니다.
+func (r *gormRepository) landTextIndex() (idIndex, error) {
+	r.text.mu.Lock()
+	defer r.text.mu.Unlock()
+
+	stamp, err := r.textStamp(&postalcode.PostalCodeLand{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check land text index: %w", err)
+	}
+	if r.text.lands == nil || r.text.landsStamp != stamp {
+		idx, err := r.loadTextIndex(&postalcode.PostalCodeLand{}, landTextColumns)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build land text index: %w", err)
+		}
+		r.text.lands, r.text.landsStamp = idx, stamp
+	}
+	return r.text.lands, nil
+}
+
+// textStamp는 model 테이블과 import_runs의 현재 상태를 조회합니다. (rowid 조회이므로 테이블 크기와 관계없이 빠름)
+func (r *gormRepository) textStamp(model interface{}) (textStamp, error) {
+	var stamp textStamp
+	if err := r.db.Model(model).Select("COALESCE(MAX(id), 0)").Scan(&stamp.maxID).Error; err != nil {
+		return stamp, err
+	}
+	if r.db.Migrator().HasTable(&postalcode.ImportRun{}) {
+		if err := r.db.Model(&postalcode.ImportRun{}).Select("COALESCE(MAX(id), 0)").Scan(&stamp.maxRunID).Error; err != nil {
+			return stamp, err
+		}
+	}
+	return stamp, nil
+}
+
+// loadTextIndex는 model 테이블의 ID와 columns 값을 ID 순으로 읽어 역색인을 만듭니다.
+func (r *gormRepository) loadTextIndex(model interface{}, columns []string) (idIndex, error) {
+	rows, err := r.db.Model(model).Select(append([]string{"id"}, columns...)).Order("id").Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var id uint
+	columnValues := make([]sql.NullString, len(columns))
+	dest := []interface{}{&id}
+	for i := range columnValues {
+		dest = append(dest, &columnValues[i])
+	}
+	values := make([]string, len(columns))
+
+	idx := make(idIndex)
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		for i, value := range columnValues {
+			values[i] = value.String
+		}
+		// ID 순으로 읽으므로 목록 끝에 추가해도 오름차순이 유지됨
+		for _, key := range textKeys(values...) {
+			idx[key] = append(idx[key], id)
+		}
+	}
+	return idx, rows.Err()
+}
@@ -0,0 +1,1253 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"gorm.io/gorm"
+)
+
+// memoryRepository는 DB 없이 메모리에 데이터를 보관하는 Repository 구현입니다.
+// 조회 결과(정렬, 부분 매칭, 페이징, 총 개수)는 gormRepository와 같으며,
+// 우편번호/prefix는 정확히 일치하는 인덱스, 시도/시군구/도로명/읍면동/리는 값별 인덱스로 후보 행을 좁히며,
+// WithFullTextSearch 옵션이 있으면 통합 검색어를 bigram 역색인으로 좁힙니다.
+// 쓰기 메서드도 모두 지원하므로 Importer로 배포 파일을 직접 읽어 채울 수 있습니다.
+type memoryRepository struct {
+	mu sync.RWMutex
+
+	roads      roadTable
+	lands      landTable
+	buildings  buildingTable
+	poBoxes    poBoxTable
+	deliveries bulkDeliveryTable
+
+	importRuns    []postalcode.ImportRun
+	importRejects []postalcode.ImportReject
+}
+
+// NewMemory는 비어 있는 메모리 Repository를 생성합니다.
+// 읽기 전용 조회 서비스에서 Importer로 배포 파일을 읽어 채우거나 NewMemoryFromDB로 DB 데이터를 복사하여 사용합니다.
+func NewMemory(opts ...Option) Repository {
+	o := newOptions(opts)
+	m := &memoryRepository{}
+	m.roads.fullText = o.fullText
+	m.lands.fullText = o.fullText
+	m.roads.reset()
+	m.lands.reset()
+	m.buildings.reset()
+	m.poBoxes.reset()
+	m.deliveries.reset()
+	return m
+}
+
+// NewMemoryFromDB는 DB의 우편번호 테이블과 import 이력을 한 번 읽어 메모리 Repository를 생성합니다.
+// 행 ID와 타임스탬프는 DB 값을 그대로 유지하며, 없는 테이블(사서함 등 선택 테이블)은 건너뜁니다.
+func NewMemoryFromDB(db *gorm.DB, opts ...Option) (Repository, error) {
+	m := NewMemory(opts...).(*memoryRepository)
+	migrator := db.Migrator()
+
+	if migrator.HasTable(&postalcode.PostalCodeRoad{}) {
+		var batch []postalcode.PostalCodeRoad
+		err := db.Order("id").FindInBatches(&batch, memoryLoadBatchSize, func(tx *gorm.DB, _ int) error {
+			for i := range batch {
+				m.roads.insert(batch[i])
+			}
+			return nil
+		}).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load postal_code_roads: %w", err)
+		}
+	}
+
+	if migrator.HasTable(&postalcode.PostalCodeLand{}) {
+		var batch []postalcode.PostalCodeLand
+		err := db.Order("id").FindInBatches(&batch, memoryLoadBatchSize, func(tx *gorm.DB, _ int) error {
+			for i := range batch {
+				m.lands.insert(batch[i])
+			}
+			return nil
+		}).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load postal_code_lands: %w", err)
+		}
+	}
+
+	if migrator.HasTable(&postalcode.PostalCodeBuilding{}) {
+		var batch []postalcode.PostalCodeBuilding
+		err := db.Order("id").FindInBatches(&batch, memoryLoadBatchSize, func(tx *gorm.DB, _ int) error {
+			for i := range batch {
+				m.buildings.insert(batch[i])
+			}
+			return nil
+		}).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load postal_code_buildings: %w", err)
+		}
+	}
+
+	if migrator.HasTable(&postalcode.PostalCodePOBox{}) {
+		var batch []postalcode.PostalCodePOBox
+		err := db.Order("id").FindInBatches(&batch, memoryLoadBatchSize, func(tx *gorm.DB, _ int) error {
+			for i := range batch {
+				m.poBoxes.insert(batch[i])
+			}
+			return nil
+		}).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load postal_code_po_boxes: %w", err)
+		}
+	}
+
+	if migrator.HasTable(&postalcode.PostalCodeBulkDelivery{}) {
+		var batch []postalcode.PostalCodeBulkDelivery
+		err := db.Order("id").FindInBatches(&batch, memoryLoadBatchSize, func(tx *gorm.DB, _ int) error {
+			for i := range batch {
+				m.deliveries.insert(batch[i])
+			}
+			return nil
+		}).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load postal_code_bulk_deliveries: %w", err)
+		}
+	}
+
+	// 데이터셋 버전(X-Dataset-Version) 계산에 필요한 import 이력
+	if migrator.HasTable(&postalcode.ImportRun{}) {
+		if err := db.Order("id").Find(&m.importRuns).Error; err != nil {
+			return nil, fmt.Errorf("failed to load import runs: %w", err)
+		}
+	}
+
+	return m, nil
+}
+
+// memoryLoadBatchSize는 NewMemoryFromDB가 한 번에 읽는 행 수입니다.
+const memoryLoadBatchSize = 5000
+
+// ============================================================
+// 공통 헬퍼
+// ============================================================
+
+// idIndex는 컬럼 값별 행 ID 목록(오름차순)입니다.
+type idIndex map[string][]uint
+
+// add는 key 목록에 id를 정렬 순서를 유지하며 추가합니다.
+func (idx idIndex) add(key string, id uint) {
+	idx[key] = insertID(idx[key], id)
+}
+
+// remove는 key 목록에서 id를 제거합니다.
+func (idx idIndex) remove(key string, id uint) {
+	ids := removeID(idx[key], id)
+	if len(ids) == 0 {
+		delete(idx, key)
+		return
+	}
+	idx[key] = ids
+}
+
+// containing은 substr을 포함하는 모든 값의 ID를 합쳐 오름차순으로 반환합니다. (LIKE '%substr%')
+func (idx idIndex) containing(substr string) []uint {
+	var ids []uint
+	for key, keyIDs := range idx {
+		if like(key, substr) {
+			ids = append(ids, keyIDs...)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// insertID는 오름차순 ID 목록에 id를 추가합니다.
+func insertID(ids []uint, id uint) []uint {
+	i := sort.Search(len(ids), func(i int) bool { return ids[i] >= id })
+	if i < len(ids) && ids[i] == id {
+		return ids
+	}
+	ids = append(ids, 0)
+	copy(ids[i+1:], ids[i:])
+	ids[i] = id
+	return ids
+}
+
+// removeID는 오름차순 ID 목록에서 id를 제거합니다.
+func removeID(ids []uint, id uint) []uint {
+	i := sort.Search(len(ids), func(i int) bool { return ids[i] >= id })
+	if i == len(ids) || ids[i] != id {
+		return ids
+	}
+	return append(ids[:i], ids[i+1:]...)
+}
+
+// like는 SQL의 "value LIKE '%substr%'"와 같이 대소문자를 구분하지 않는 부분 매칭입니다.
+func like(value, substr string) bool {
+	return strings.Contains(strings.ToLower(value), strings.ToLower(substr))
+}
+
+// pageBounds는 n개의 결과에 limit/offset을 적용한 범위를 반환합니다. (limit이 0 이하이면 제한 없음)
+func pageBounds(n, limit, offset int) (int, int) {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > n {
+		offset = n
+	}
+	end := n
+	if limit > 0 && offset+limit < n {
+		end = offset + limit
+	}
+	return offset, end
+}
+
+// searchBounds는 Search 계열 메서드의 페이징(기본 10개, page 기반 offset)을 적용한 범위를 반환합니다.
+func searchBounds(n, page, limit int) (int, int) {
+	offset := (page - 1) * limit
+	if limit <= 0 {
+		limit = 10 // 기본 10개
+	}
+	return pageBounds(n, limit, offset)
+}
+
+// ============================================================
+// 도로명주소 테이블
+// ============================================================
+
+// roadUniqueKey는 도로명주소 유니크 키입니다. (idx_postal_unique)
+type roadUniqueKey struct {
+	zipCode, sidoName, sigunguName, roadName string
+	startBuildingMain                        int
+}
+
+// roadTable은 도로명주소 행과 인덱스입니다.
+type roadTable struct {
+	fullText  bool
+	nextID    uint
+	rows      map[uint]*postalcode.PostalCodeRoad
+	ids       []uint
+	unique    map[roadUniqueKey]uint
+	byZip     idIndex
+	byPrefix  idIndex
+	bySido    idIndex
+	bySigungu idIndex
+	byRoad    idIndex
+	byText    idIndex // 통합 검색어 역색인 (fullText일 때만)
+}
+
+func (t *roadTable) reset() {
+	*t = roadTable{
+		fullText:  t.fullText,
+		rows:      make(map[uint]*postalcode.PostalCodeRoad),
+		unique:    make(map[roadUniqueKey]uint),
+		byZip:     make(idIndex),
+		byPrefix:  make(idIndex),
+		bySido:    make(idIndex),
+		bySigungu: make(idIndex),
+		byRoad:    make(idIndex),
+	}
+	if t.fullText {
+		t.byText = make(idIndex)
+	}
+}
+
+func roadKeyOf(road *postalcode.PostalCodeRoad) roadUniqueKey {
+	return roadUniqueKey{road.ZipCode, road.SidoName, road.SigunguName, road.RoadName, road.StartBuildingMain}
+}
+
+// insert는 행을 추가합니다. ID가 0이면 새 ID를 부여하고, 0이 아니면 그대로 유지합니다.
+func (t *roadTable) insert(road postalcode.PostalCodeRoad) uint {
+	if road.ID == 0 {
+		t.nextID++
+		road.ID = t.nextID
+	} else if road.ID > t.nextID {
+		t.nextID = road.ID
+	}
+	t.rows[road.ID] = &road
+	t.ids = insertID(t.ids, road.ID)
+	t.index(&road)
+	return road.ID
+}
+
+// delete는 행을 삭제합니다.
+func (t *roadTable) delete(id uint) {
+	road, ok := t.rows[id]
+	if !ok {
+		return
+	}
+	t.unindex(road)
+	t.ids = removeID(t.ids, id)
+	delete(t.rows, id)
+}
+
+func (t *roadTable) index(road *postalcode.PostalCodeRoad) {
+	t.unique[roadKeyOf(road)] = road.ID
+	t.byZip.add(road.ZipCode, road.ID)
+	t.byPrefix.add(road.ZipPrefix, road.ID)
+	t.bySido.add(road.SidoName, road.ID)
+	t.bySigungu.add(road.SigunguName, road.ID)
+	t.byRoad.add(road.RoadName, road.ID)
+	if t.fullText {
+		t.byText.addText(road.ID, road.RoadName, road.SigunguName, road.EupmyeonName)
+	}
+}
+
+func (t *roadTable) unindex(road *postalcode.PostalCodeRoad) {
+	delete(t.unique, roadKeyOf(road))
+	t.byZip.remove(road.ZipCode, road.ID)
+	t.byPrefix.remove(road.ZipPrefix, road.ID)
+	t.bySido.remove(road.SidoName, road.ID)
+	t.bySigungu.remove(road.SigunguName, road.ID)
+	t.byRoad.remove(road.RoadName, road.ID)
+	if t.fullText {
+		t.byText.removeText(road.ID, road.RoadName, road.SigunguName, road.EupmyeonName)
+	}
+}
+
+// candidates는 가장 좁은 인덱스로 검색 후보 ID를 오름차순으로 반환합니다.
+func (t *roadTable) candidates(params postalcode.SearchParams, terms []string) []uint {
+	switch {
+	case params.ZipCode != "":
+		return t.byZip[params.ZipCode]
+	case params.ZipPrefix != "":
+		return t.byPrefix[params.ZipPrefix]
+	case len(terms) > 0 && t.fullText:
+		return t.byText.textCandidates(terms)
+	case params.RoadName != "":
+		return t.byRoad.containing(params.RoadName)
+	case params.SigunguName != "":
+		return t.bySigungu.containing(params.SigunguName)
+	case params.SidoName != "":
+		return t.bySido.containing(params.SidoName)
+	}
+	return t.ids
+}
+
+// matches는 행이 검색 조건을 모두 만족하는지 확인합니다. (applyRoadFilters와 같은 조건)
+func (t *roadTable) matches(road *postalcode.PostalCodeRoad, params postalcode.SearchParams, terms []string) bool {
+	return (params.ZipCode == "" || road.ZipCode == params.ZipCode) &&
+		(params.ZipPrefix == "" || road.ZipPrefix == params.ZipPrefix) &&
+		(params.SidoName == "" || like(road.SidoName, params.SidoName)) &&
+		(params.SigunguName == "" || like(road.SigunguName, params.SigunguName)) &&
+		(params.RoadName == "" || like(road.RoadName, params.RoadName)) &&
+		matchesQuery(terms, road.RoadName, road.SigunguName, road.EupmyeonName)
+}
+
+// filter는 검색 조건에 맞는 행을 ID 순으로 반환합니다. afterID보다 큰 ID만, max가 0보다 크면 최대 max개까지 반환합니다.
+func (t *roadTable) filter(params postalcode.SearchParams, afterID uint, max int) []postalcode.PostalCodeRoad {
+	terms := QueryTerms(params.Query)
+	ids := t.candidates(params, terms)
+	start := sort.Search(len(ids), func(i int) bool { return ids[i] > afterID })
+
+	roads := []postalcode.PostalCodeRoad{}
+	for _, id := range ids[start:] {
+		if road := t.rows[id]; t.matches(road, params, terms) {
+			roads = append(roads, *road)
+			if max > 0 && len(roads) == max {
+				break
+			}
+		}
+	}
+	return roads
+}
+
+// upsert는 유니크 키가 같은 행이 있으면 ID와 생성 시각을 유지한 채 갱신하고, 없으면 추가합니다.
+func (t *roadTable) upsert(road *postalcode.PostalCodeRoad, now time.Time) {
+	if id, ok := t.unique[roadKeyOf(road)]; ok {
+		existing := t.rows[id]
+		t.unindex(existing)
+		road.ID, road.CreatedAt, road.UpdatedAt = id, existing.CreatedAt, now
+		*existing = *road
+		t.index(existing)
+		return
+	}
+	road.ID = 0
+	road.CreatedAt, road.UpdatedAt = now, now
+	road.ID = t.insert(*road)
+}
+
+// ============================================================
+// 지번주소 테이블
+// ============================================================
+
+// landUniqueKey는 지번주소 유니크 키입니다. (idx_land_unique)
+type landUniqueKey struct {
+	zipCode, sidoName, sigunguName, eupmyeondongName, riName string
+	isMountain                                               bool
+	startJibunMain                                           int
+}
+
+// landTable은 지번주소 행과 인덱스입니다.
+type landTable struct {
+	fullText       bool
+	nextID         uint
+	rows           map[uint]*postalcode.PostalCodeLand
+	ids            []uint
+	unique         map[landUniqueKey]uint
+	byZip          idIndex
+	byPrefix       idIndex
+	bySido         idIndex
+	bySigungu      idIndex
+	byEupmyeondong idIndex
+	byRi           idIndex
+	byText         idIndex // 통합 검색어 역색인 (fullText일 때만)
+}
+
+func (t *landTable) reset() {
+	*t = landTable{
+		fullText:       t.fullText,
+		rows:           make(map[uint]*postalcode.PostalCodeLand),
+		unique:         make(map[landUniqueKey]uint),
+		byZip:          make(idIndex),
+		byPrefix:       make(idIndex),
+		bySido:         make(idIndex),
+		bySigungu:      make(idIndex),
+		byEupmyeondong: make(idIndex),
+		byRi:           make(idIndex),
+	}
+	if t.fullText {
+		t.byText = make(idIndex)
+	}
+}
+
+func landKeyOf(land *postalcode.PostalCodeLand) landUniqueKey {
+	return landUniqueKey{land.ZipCode, land.SidoName, land.SigunguName, land.EupmyeondongName, land.RiName, land.IsMountain, land.StartJibunMain}
+}
+
+// insert는 행을 추가합니다. ID가 0이면 새 ID를 부여하고, 0이 아니면 그대로 유지합니다.
+func (t *landTable) insert(land postalcode.PostalCodeLand) uint {
+	if land.ID == 0 {
+		t.nextID++
+		land.ID = t.nextID
+	} else if land.ID > t.nextID {
+		t.nextID = land.ID
+	}
+	t.rows[land.ID] = &land
+	t.ids = insertID(t.ids, land.ID)
+	t.index(&land)
+	return land.ID
+}
+
+// delete는 행을 삭제합니다.
+func (t *landTable) delete(id uint) {
+	land, ok := t.rows[id]
+	if !ok {
+		return
+	}
+	t.unindex(land)
+	t.ids = removeID(t.ids, id)
+	delete(t.rows, id)
+}
+
+func (t *landTable) index(land *postalcode.PostalCodeLand) {
+	t.unique[landKeyOf(land)] = land.ID
+	t.byZip.add(land.ZipCode, land.ID)
+	t.byPrefix.add(land.ZipPrefix, land.ID)
+	t.bySido.add(land.SidoName, land.ID)
+	t.bySigungu.add(land.SigunguName, land.ID)
+	t.byEupmyeondong.add(land.EupmyeondongName, land.ID)
+	t.byRi.add(land.RiName, land.ID)
+	if t.fullText {
+		t.byText.addText(land.ID, land.SigunguName, land.EupmyeondongName, land.RiName)
+	}
+}
+
+func (t *landTable) unindex(land *postalcode.PostalCodeLand) {
+	delete(t.unique, landKeyOf(land))
+	t.byZip.remove(land.ZipCode, land.ID)
+	t.byPrefix.remove(land.ZipPrefix, land.ID)
+	t.bySido.remove(land.SidoName, land.ID)
+	t.bySigungu.remove(land.SigunguName, land.ID)
+	t.byEupmyeondong.remove(land.EupmyeondongName, land.ID)
+	t.byRi.remove(land.RiName, land.ID)
+	if t.fullText {
+		t.byText.removeText(land.ID, land.SigunguName, land.EupmyeondongName, land.RiName)
+	}
+}
+
+// candidates는 가장 좁은 인덱스로 검색 후보 ID를 오름차순으로 반환합니다.
+func (t *landTable) candidates(params postalcode.SearchParamsLand, terms []string) []uint {
+	switch {
+	case params.ZipCode != "":
+		return t.byZip[params.ZipCode]
+	case params.ZipPrefix != "":
+		return t.byPrefix[params.ZipPrefix]
+	case len(terms) > 0 && t.fullText:
+		return t.byText.textCandidates(terms)
+	case params.RiName != "":
+		return t.byRi.containing(params.RiName)
+	case params.EupmyeondongName != "":
+		return t.byEupmyeondong.containing(params.EupmyeondongName)
+	case params.SigunguName != "":
+		return t.bySigungu.containing(params.SigunguName)
+	case params.SidoName != "":
+		return t.bySido.containing(params.SidoName)
+	}
+	return t.ids
+}
+
+// matches는 행이 검색 조건을 모두 만족하는지 확인합니다. (applyLandFilters와 같은 조건)
+func (t *landTable) matches(land *postalcode.PostalCodeLand, params postalcode.SearchParamsLand, terms []string) bool {
+	return (params.ZipCode == "" || land.ZipCode == params.ZipCode) &&
+		(params.ZipPrefix == "" || land.ZipPrefix == params.ZipPrefix) &&
+		(params.SidoName == "" || like(land.SidoName, params.SidoName)) &&
+		(params.SigunguName == "" || like(land.SigunguName, params.SigunguName)) &&
+		(params.EupmyeondongName == "" || like(land.EupmyeondongName, params.EupmyeondongName)) &&
+		(params.RiName == "" || like(land.RiName, params.RiName)) &&
+		matchesQuery(terms, land.SigunguName, land.EupmyeondongName, land.RiName)
+}
+
+// filter는 검색 조건에 맞는 행을 ID 순으로 반환합니다. afterID보다 큰 ID만, max가 0보다 크면 최대 max개까지 반환합니다.
+func (t *landTable) filter(params postalcode.SearchParamsLand, afterID uint, max int) []postalcode.PostalCodeLand {
+	terms := QueryTerms(params.Query)
+	ids := t.candidates(params, terms)
+	start := sort.Search(len(ids), func(i int) bool { return ids[i] > afterID })
+
+	lands := []postalcode.PostalCodeLand{}
+	for _, id := range ids[start:] {
+		if land := t.rows[id]; t.matches(land, params, terms) {
+			lands = append(lands, *land)
+			if max > 0 && len(lands) == max {
+				break
+			}
+		}
+	}
+	return lands
+}
+
+// upsert는 유니크 키가 같은 행이 있으면 ID와 생성 시각을 유지한 채 갱신하고, 없으면 추가합니다.
+func (t *landTable) upsert(land *postalcode.PostalCodeLand, now time.Time) {
+	if id, ok := t.unique[landKeyOf(land)]; ok {
+		existing := t.rows[id]
+		t.unindex(existing)
+		land.ID, land.CreatedAt, land.UpdatedAt = id, existing.CreatedAt, now
+		*existing = *land
+		t.index(existing)
+		return
+	}
+	land.ID = 0
+	land.CreatedAt, land.UpdatedAt = now, now
+	land.ID = t.insert(*land)
+}
+
+// ============================================================
+// 건물 / 사서함 / 다량배달처 테이블
+// ============================================================
+
+// buildingTable은 건물 행입니다. (건물관리번호 유니크)
+type buildingTable struct {
+	nextID uint
+	rows   map[uint]*postalcode.PostalCodeBuilding
+	ids    []uint
+	unique map[string]uint
+}
+
+func (t *buildingTable) reset() {
+	*t = buildingTable{rows: make(map[uint]*postalcode.PostalCodeBuilding), unique: make(map[string]uint)}
+}
+
+// insert는 행을 추가합니다. ID가 0이면 새 ID를 부여합니다.
+func (t *buildingTable) insert(building postalcode.PostalCodeBuilding) {
+	if building.ID == 0 {
+		t.nextID++
+		building.ID = t.nextID
+	} else if building.ID > t.nextID {
+		t.nextID = building.ID
+	}
+	t.rows[building.ID] = &building
+	t.ids = insertID(t.ids, building.ID)
+	t.unique[building.BuildingManagementNumber] = building.ID
+}
+
+// upsert는 건물관리번호가 같은 행이 있으면 갱신하고, 없으면 추가합니다.
+func (t *buildingTable) upsert(building postalcode.PostalCodeBuilding, now time.Time) {
+	if id, ok := t.unique[building.BuildingManagementNumber]; ok {
+		existing := t.rows[id]
+		building.ID, building.CreatedAt, building.UpdatedAt = id, existing.CreatedAt, now
+		*existing = building
+		return
+	}
+	building.ID = 0
+	building.CreatedAt, building.UpdatedAt = now, now
+	t.insert(building)
+}
+
+// poBoxUniqueKey는 사서함 유니크 키입니다. (idx_pobox_unique)
+type poBoxUniqueKey struct {
+	zipCode, poBoxName string
+	startNumberMain    int
+}
+
+// poBoxTable은 사서함 행입니다.
+type poBoxTable struct {
+	nextID uint
+	rows   map[uint]*postalcode.PostalCodePOBox
+	ids    []uint
+	unique map[poBoxUniqueKey]uint
+	byZip  idIndex
+}
+
+func (t *poBoxTable) reset() {
+	*t = poBoxTable{rows: make(map[uint]*postalcode.PostalCodePOBox), unique: make(map[poBoxUniqueKey]uint), byZip: make(idIndex)}
+}
+
+// insert는 행을 추가합니다. ID가 0이면 새 ID를 부여합니다.
+func (t *poBoxTable) insert(poBox postalcode.PostalCodePOBox) {
+	if poBox.ID == 0 {
+		t.nextID++
+		poBox.ID = t.nextID
+	} else if poBox.ID > t.nextID {
+		t.nextID = poBox.ID
+	}
+	t.rows[poBox.ID] = &poBox
+	t.ids = insertID(t.ids, poBox.ID)
+	t.unique[poBoxUniqueKey{poBox.ZipCode, poBox.POBoxName, poBox.StartNumberMain}] = poBox.ID
+	t.byZip.add(poBox.ZipCode, poBox.ID)
+}
+
+// upsert는 유니크 키가 같은 행이 있으면 갱신하고, 없으면 추가합니다.
+func (t *poBoxTable) upsert(poBox postalcode.PostalCodePOBox, now time.Time) {
+	if id, ok := t.unique[poBoxUniqueKey{poBox.ZipCode, poBox.POBoxName, poBox.StartNumberMain}]; ok {
+		existing := t.rows[id]
+		poBox.ID, poBox.CreatedAt, poBox.UpdatedAt = id, existing.CreatedAt, now
+		*existing = poBox
+		return
+	}
+	poBox.ID = 0
+	poBox.CreatedAt, poBox.UpdatedAt = now, now
+	t.insert(poBox)
+}
+
+// bulkUniqueKey는 다량배달처 유니크 키입니다. (idx_bulk_unique)
+type bulkUniqueKey struct {
+	zipCode, deliveryName string
+}
+
+// bulkDeliveryTable은 다량배달처 행입니다.
+type bulkDeliveryTable struct {
+	nextID uint
+	rows   map[uint]*postalcode.PostalCodeBulkDelivery
+	ids    []uint
+	unique map[bulkUniqueKey]uint
+	byZip  idIndex
+}
+
+func (t *bulkDeliveryTable) reset() {
+	*t = bulkDeliveryTable{rows: make(map[uint]*postalcode.PostalCodeBulkDelivery), unique: make(map[bulkUniqueKey]uint), byZip: make(idIndex)}
+}
+
+// insert는 행을 추가합니다. ID가 0이면 새 ID를 부여합니다.
+func (t *bulkDeliveryTable) insert(delivery postalcode.PostalCodeBulkDelivery) {
+	if delivery.ID == 0 {
+		t.nextID++
+		delivery.ID = t.nextID
+	} else if delivery.ID > t.nextID {
+		t.nextID = delivery.ID
+	}
+	t.rows[delivery.ID] = &delivery
+	t.ids = insertID(t.ids, delivery.ID)
+	t.unique[bulkUniqueKey{delivery.ZipCode, delivery.DeliveryName}] = delivery.ID
+	t.byZip.add(delivery.ZipCode, delivery.ID)
+}
+
+// upsert는 유니크 키가 같은 행이 있으면 갱신하고, 없으면 추가합니다.
+func (t *bulkDeliveryTable) upsert(delivery postalcode.PostalCodeBulkDelivery, now time.Time) {
+	if id, ok := t.unique[bulkUniqueKey{delivery.ZipCode, delivery.DeliveryName}]; ok {
+		existing := t.rows[id]
+		delivery.ID, delivery.CreatedAt, delivery.UpdatedAt = id, existing.CreatedAt, now
+		*existing = delivery
+		return
+	}
+	delivery.ID = 0
+	delivery.CreatedAt, delivery.UpdatedAt = now, now
+	t.insert(delivery)
+}
+
+// ============================================================
+// 도로명주소 관련 메서드
+// ============================================================
+
+// FindByZipCode는 우편번호로 조회합니다.
+func (m *memoryRepository) FindByZipCode(zipCode string) ([]postalcode.PostalCodeRoad, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.roads.filter(postalcode.SearchParams{ZipCode: zipCode}, 0, 0), nil
+}
+
+// FindByZipPrefix는 우편번호 앞 3자리로 조회합니다.
+func (m *memoryRepository) FindByZipPrefix(zipPrefix string, limit, offset int) ([]postalcode.PostalCodeRoad, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	roads := m.roads.filter(postalcode.SearchParams{ZipPrefix: zipPrefix}, 0, 0)
+	start, end := pageBounds(len(roads), limit, offset)
+	return roads[start:end], int64(len(roads)), nil
+}
+
+// Search는 여러 조건으로 검색합니다.
+func (m *memoryRepository) Search(params postalcode.SearchParams) ([]postalcode.PostalCodeRoad, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	roads := m.roads.filter(params, 0, 0)
+	start, end := searchBounds(len(roads), params.Page, params.Limit)
+	return roads[start:end], int64(len(roads)), nil
+}
+
+// Create는 새로운 우편번호 데이터를 생성합니다.
+func (m *memoryRepository) Create(road *postalcode.PostalCodeRoad) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.roads.unique[roadKeyOf(road)]; ok {
+		return fmt.Errorf("duplicate entry for idx_postal_unique: %s", road.ZipCode)
+	}
+	now := time.Now()
+	road.CreatedAt, road.UpdatedAt = now, now
+	road.ID = m.roads.insert(*road)
+	return nil
+}
+
+// BatchCreate는 여러 우편번호 데이터를 배치로 생성합니다. (유니크 키 기준 upsert)
+func (m *memoryRepository) BatchCreate(roads []postalcode.PostalCodeRoad) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for i := range roads {
+		m.roads.upsert(&roads[i], now)
+	}
+	return nil
+}
+
+// Update는 우편번호 데이터를 업데이트합니다. (ID가 없으면 생성)
+func (m *memoryRepository) Update(road *postalcode.PostalCodeRoad) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	if existing, ok := m.roads.rows[road.ID]; ok {
+		m.roads.unindex(existing)
+		road.UpdatedAt = now
+		*existing = *road
+		m.roads.index(existing)
+		return nil
+	}
+	if road.CreatedAt.IsZero() {
+		road.CreatedAt = now
+	}
+	road.UpdatedAt = now
+	road.ID = m.roads.insert(*road)
+	return nil
+}
+
+// Delete는 우편번호 데이터를 삭제합니다.
+func (m *memoryRepository) Delete(id uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.roads.delete(id)
+	return nil
+}
+
+// BatchDelete는 여러 우편번호 데이터를 ID로 일괄 삭제합니다.
+func (m *memoryRepository) BatchDelete(ids []uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, id := range ids {
+		m.roads.delete(id)
+	}
+	return nil
+}
+
+// ScanRoads는 검색 조건에 맞는 모든 데이터를 ID 순으로 batchSize씩 순회합니다.
+// fn 실행 중에는 잠금을 풀어 두므로 fn 안에서 Repository를 수정할 수 있습니다.
+func (m *memoryRepository) ScanRoads(params postalcode.SearchParams, batchSize int, fn func([]postalcode.PostalCodeRoad) error) error {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	var lastID uint
+	for {
+		m.mu.RLock()
+		roads := m.roads.filter(params, lastID, batchSize)
+		m.mu.RUnlock()
+		if len(roads) == 0 {
+			return nil
+		}
+
+		if err := fn(roads); err != nil {
+			return err
+		}
+
+		lastID = roads[len(roads)-1].ID
+		if len(roads) < batchSize {
+			return nil
+		}
+	}
+}
+
+// TruncateRoad는 도로명주소 테이블의 모든 데이터를 삭제합니다. (ID도 1부터 다시 부여)
+func (m *memoryRepository) TruncateRoad() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.roads.reset()
+	return nil
+}
+
+// ============================================================
+// 지번주소 관련 메서드
+// ============================================================
+
+// FindLandByZipCode는 우편번호로 지번주소를 조회합니다.
+func (m *memoryRepository) FindLandByZipCode(zipCode string) ([]postalcode.PostalCodeLand, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.lands.filter(postalcode.SearchParamsLand{ZipCode: zipCode}, 0, 0), nil
+}
+
+// FindLandByZipPrefix는 우편번호 앞 3자리로 지번주소를 조회합니다.
+func (m *memoryRepository) FindLandByZipPrefix(zipPrefix string, limit, offset int) ([]postalcode.PostalCodeLand, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	lands := m.lands.filter(postalcode.SearchParamsLand{ZipPrefix: zipPrefix}, 0, 0)
+	start, end := pageBounds(len(lands), limit, offset)
+	return lands[start:end], int64(len(lands)), nil
+}
+
+// SearchLand는 여러 조건으로 지번주소를 검색합니다.
+func (m *memoryRepository) SearchLand(params postalcode.SearchParamsLand) ([]postalcode.PostalCodeLand, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	lands := m.lands.filter(params, 0, 0)
+	start, end := searchBounds(len(lands), params.Page, params.Limit)
+	return lands[start:end], int64(len(lands)), nil
+}
+
+// CreateLand는 새로운 지번주소 데이터를 생성합니다.
+func (m *memoryRepository) CreateLand(land *postalcode.PostalCodeLand) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.lands.unique[landKeyOf(land)]; ok {
+		return fmt.Errorf("duplicate entry for idx_land_unique: %s", land.ZipCode)
+	}
+	now := time.Now()
+	land.CreatedAt, land.UpdatedAt = now, now
+	land.ID = m.lands.insert(*land)
+	return nil
+}
+
+// BatchCreateLand는 여러 지번주소 데이터를 배치로 생성합니다. (유니크 키 기준 upsert)
+func (m *memoryRepository) BatchCreateLand(lands []postalcode.PostalCodeLand) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for i := range lands {
+		m.lands.upsert(&lands[i], now)
+	}
+	return nil
+}
+
+// UpdateLand는 지번주소 데이터를 업데이트합니다. (ID가 없으면 생성)
+func (m *memoryRepository) UpdateLand(land *postalcode.PostalCodeLand) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	if existing, ok := m.lands.rows[land.ID]; ok {
+		m.lands.unindex(existing)
+		land.UpdatedAt = now
+		*existing = *land
+		m.lands.index(existing)
+		return nil
+	}
+	if land.CreatedAt.IsZero() {
+		land.CreatedAt = now
+	}
+	land.UpdatedAt = now
+	land.ID = m.lands.insert(*land)
+	return nil
+}
+
+// DeleteLand는 지번주소 데이터를 삭제합니다.
+func (m *memoryRepository) DeleteLand(id uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lands.delete(id)
+	return nil
+}
+
+// BatchDeleteLand는 여러 지번주소 데이터를 ID로 일괄 삭제합니다.
+func (m *memoryRepository) BatchDeleteLand(ids []uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, id := range ids {
+		m.lands.delete(id)
+	}
+	return nil
+}
+
+// ScanLands는 검색 조건에 맞는 모든 지번주소 데이터를 ID 순으로 batchSize씩 순회합니다.
+// fn 실행 중에는 잠금을 풀어 두므로 fn 안에서 Repository를 수정할 수 있습니다.
+func (m *memoryRepository) ScanLands(params postalcode.SearchParamsLand, batchSize int, fn func([]postalcode.PostalCodeLand) error) error {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	var lastID uint
+	for {
+		m.mu.RLock()
+		lands := m.lands.filter(params, lastID, batchSize)
+		m.mu.RUnlock()
+		if len(lands) == 0 {
+			return nil
+		}
+
+		if err := fn(lands); err != nil {
+			return err
+		}
+
+		lastID = lands[len(lands)-1].ID
+		if len(lands) < batchSize {
+			return nil
+		}
+	}
+}
+
+// TruncateLand는 지번주소 테이블의 모든 데이터를 삭제합니다. (ID도 1부터 다시 부여)
+func (m *memoryRepository) TruncateLand() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lands.reset()
+	return nil
+}
+
+// ============================================================
+// 건물 관련 메서드
+// ============================================================
+
+// SearchBuildings는 건물명 등 여러 조건으로 건물을 검색합니다.
+// 건물명은 공백을 무시하고 시군구용 건물명 또는 건축물대장 건물명에 부분 매칭합니다.
+func (m *memoryRepository) SearchBuildings(params postalcode.SearchParamsBuilding) ([]postalcode.PostalCodeBuilding, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	name := strings.Join(strings.Fields(params.BuildingName), "")
+
+	buildings := []postalcode.PostalCodeBuilding{}
+	for _, id := range m.buildings.ids {
+		building := m.buildings.rows[id]
+		if name != "" && !like(strings.ReplaceAll(building.BuildingName, " ", ""), name) &&
+			!like(strings.ReplaceAll(building.BuildingLedgerName, " ", ""), name) {
+			continue
+		}
+		if (params.ZipCode != "" && building.ZipCode != params.ZipCode) ||
+			(params.SidoName != "" && !like(building.SidoName, params.SidoName)) ||
+			(params.SigunguName != "" && !like(building.SigunguName, params.SigunguName)) {
+			continue
+		}
+		buildings = append(buildings, *building)
+	}
+
+	// 시군구용 건물명이 있는 건물 중 이름이 짧은 순: 정확히 일치하는 건물이 먼저 오도록
+	sort.SliceStable(buildings, func(i, j int) bool {
+		a, b := buildings[i].BuildingName, buildings[j].BuildingName
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		return len(a) < len(b)
+	})
+
+	start, end := searchBounds(len(buildings), params.Page, params.Limit)
+	return buildings[start:end], int64(len(buildings)), nil
+}
+
+// BatchCreateBuilding은 여러 건물 데이터를 배치로 생성합니다. (건물관리번호 기준 upsert)
+func (m *memoryRepository) BatchCreateBuilding(buildings []postalcode.PostalCodeBuilding) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for i := range buildings {
+		m.buildings.upsert(buildings[i], now)
+	}
+	return nil
+}
+
+// TruncateBuilding은 건물 테이블의 모든 데이터를 삭제합니다.
+func (m *memoryRepository) TruncateBuilding() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.buildings.reset()
+	return nil
+}
+
+// ============================================================
+// 사서함 관련 메서드
+// ============================================================
+
+// FindPOBoxByZipCode는 우편번호로 사서함을 조회합니다.
+func (m *memoryRepository) FindPOBoxByZipCode(zipCode string) ([]postalcode.PostalCodePOBox, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	poBoxes := []postalcode.PostalCodePOBox{}
+	for _, id := range m.poBoxes.byZip[zipCode] {
+		poBoxes = append(poBoxes, *m.poBoxes.rows[id])
+	}
+	return poBoxes, nil
+}
+
+// SearchPOBoxes는 여러 조건으로 사서함을 검색합니다.
+func (m *memoryRepository) SearchPOBoxes(params postalcode.SearchParamsPOBox) ([]postalcode.PostalCodePOBox, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := m.poBoxes.ids
+	if params.ZipCode != "" {
+		ids = m.poBoxes.byZip[params.ZipCode]
+	}
+
+	poBoxes := []postalcode.PostalCodePOBox{}
+	for _, id := range ids {
+		poBox := m.poBoxes.rows[id]
+		if (params.SidoName != "" && !like(poBox.SidoName, params.SidoName)) ||
+			(params.SigunguName != "" && !like(poBox.SigunguName, params.SigunguName)) ||
+			(params.POBoxName != "" && !like(poBox.POBoxName, params.POBoxName)) {
+			continue
+		}
+		poBoxes = append(poBoxes, *poBox)
+	}
+
+	sort.SliceStable(poBoxes, func(i, j int) bool {
+		if poBoxes[i].ZipCode != poBoxes[j].ZipCode {
+			return poBoxes[i].ZipCode < poBoxes[j].ZipCode
+		}
+		return poBoxes[i].StartNumberMain < poBoxes[j].StartNumberMain
+	})
+
+	start, end := searchBounds(len(poBoxes), params.Page, params.Limit)
+	return poBoxes[start:end], int64(len(poBoxes)), nil
+}
+
+// BatchCreatePOBox는 여러 사서함 데이터를 배치로 생성합니다. (유니크 키 기준 upsert)
+func (m *memoryRepository) BatchCreatePOBox(poBoxes []postalcode.PostalCodePOBox) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for i := range poBoxes {
+		m.poBoxes.upsert(poBoxes[i], now)
+	}
+	return nil
+}
+
+// TruncatePOBox는 사서함 테이블의 모든 데이터를 삭제합니다.
+func (m *memoryRepository) TruncatePOBox() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.poBoxes.reset()
+	return nil
+}
+
+// ============================================================
+// 다량배달처 관련 메서드
+// ============================================================
+
+// FindBulkDeliveryByZipCode는 우편번호로 다량배달처를 조회합니다.
+func (m *memoryRepository) FindBulkDeliveryByZipCode(zipCode string) ([]postalcode.PostalCodeBulkDelivery, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	deliveries := []postalcode.PostalCodeBulkDelivery{}
+	for _, id := range m.deliveries.byZip[zipCode] {
+		deliveries = append(deliveries, *m.deliveries.rows[id])
+	}
+	return deliveries, nil
+}
+
+// SearchBulkDeliveries는 여러 조건으로 다량배달처를 검색합니다.
+// 다량배달처명은 공백을 무시하고 부분 매칭합니다.
+func (m *memoryRepository) SearchBulkDeliveries(params postalcode.SearchParamsBulkDelivery) ([]postalcode.PostalCodeBulkDelivery, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := m.deliveries.ids
+	if params.ZipCode != "" {
+		ids = m.deliveries.byZip[params.ZipCode]
+	}
+	name := strings.Join(strings.Fields(params.DeliveryName), "")
+
+	deliveries := []postalcode.PostalCodeBulkDelivery{}
+	for _, id := range ids {
+		delivery := m.deliveries.rows[id]
+		if (params.SidoName != "" && !like(delivery.SidoName, params.SidoName)) ||
+			(params.SigunguName != "" && !like(delivery.SigunguName, params.SigunguName)) ||
+			(name != "" && !like(strings.ReplaceAll(delivery.DeliveryName, " ", ""), name)) {
+			continue
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+
+	// 이름이 짧은 순: 정확히 일치하는 다량배달처가 먼저 오도록
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return len(deliveries[i].DeliveryName) < len(deliveries[j].DeliveryName)
+	})
+
+	start, end := searchBounds(len(deliveries), params.Page, params.Limit)
+	return deliveries[start:end], int64(len(deliveries)), nil
+}
+
+// BatchCreateBulkDelivery는 여러 다량배달처 데이터를 배치로 생성합니다. (유니크 키 기준 upsert)
+func (m *memoryRepository) BatchCreateBulkDelivery(deliveries []postalcode.PostalCodeBulkDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for i := range deliveries {
+		m.deliveries.upsert(deliveries[i], now)
+	}
+	return nil
+}
+
+// TruncateBulkDelivery는 다량배달처 테이블의 모든 데이터를 삭제합니다.
+func (m *memoryRepository) TruncateBulkDelivery() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.deliveries.reset()
+	return nil
+}
+
+// ExistsAddressZipCode는 우편번호가 도로명주소 또는 지번주소 테이블에 있는지 확인합니다.
+func (m *memoryRepository) ExistsAddressZipCode(zipCode string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.roads.byZip[zipCode]) > 0 || len(m.lands.byZip[zipCode]) > 0, nil
+}
+
+// ============================================================
+// Import 이력 관련 메서드
+// ============================================================
+
+// CreateImportRun은 import 실행 이력을 생성합니다.
+func (m *memoryRepository) CreateImportRun(run *postalcode.ImportRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	run.ID = 1
+	if n := len(m.importRuns); n > 0 {
+		run.ID = m.importRuns[n-1].ID + 1
+	}
+	m.importRuns = append(m.importRuns, *run)
+	return nil
+}
+
+// UpdateImportRun은 import 실행 이력을 갱신합니다. (없으면 추가)
+func (m *memoryRepository) UpdateImportRun(run *postalcode.ImportRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.importRuns {
+		if m.importRuns[i].ID == run.ID {
+			m.importRuns[i] = *run
+			return nil
+		}
+	}
+	m.importRuns = append(m.importRuns, *run)
+	sort.SliceStable(m.importRuns, func(i, j int) bool { return m.importRuns[i].ID < m.importRuns[j].ID })
+	return nil
+}
+
+// ListImportRuns는 최근 import 실행 이력을 최신순으로 조회합니다.
+func (m *memoryRepository) ListImportRuns(limit int) ([]postalcode.ImportRun, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	runs := []postalcode.ImportRun{}
+	for i := len(m.importRuns) - 1; i >= 0; i-- {
+		if limit > 0 && len(runs) == limit {
+			break
+		}
+		runs = append(runs, m.importRuns[i])
+	}
+	return runs, nil
+}
+
+// FindLatestImportRun은 마지막으로 성공한 import 실행을 조회합니다.
+func (m *memoryRepository) FindLatestImportRun() (*postalcode.ImportRun, error) {
+	return m.findLastImportRun(func(run *postalcode.ImportRun) bool {
+		return run.Outcome == postalcode.ImportOutcomeSuccess
+	})
+}
+
+// FindLastAppliedImportRun은 테이블에 데이터를 반영한 마지막 실행을 조회합니다.
+func (m *memoryRepository) FindLastAppliedImportRun(dataTypes ...string) (*postalcode.ImportRun, error) {
+	return m.findLastImportRun(func(run *postalcode.ImportRun) bool {
+		for _, dataType := range dataTypes {
+			if run.DataType == dataType {
+				return run.Applied()
+			}
+		}
+		return false
+	})
+}
+
+// BatchCreateImportRejects는 거부된 라인을 격리 테이블에 일괄 저장합니다.
+func (m *memoryRepository) BatchCreateImportRejects(rejects []postalcode.ImportReject) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for i := range rejects {
+		rejects[i].ID = uint(len(m.importRejects) + 1)
+		rejects[i].CreatedAt = now
+		m.importRejects = append(m.importRejects, rejects[i])
+	}
+	return nil
+}
+
+// ListImportRejects는 import 실행의 거부 라인을 라인 순서로 조회합니다.
+func (m *memoryRepository) ListImportRejects(runID uint) ([]postalcode.ImportReject, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	rejects := []postalcode.ImportReject{}
+	for _, reject := range m.importRejects {
+		if reject.RunID == runID {
+			rejects = append(rejects, reject)
+		}
+	}
+	sort.SliceStable(rejects, func(i, j int) bool { return rejects[i].Line < rejects[j].Line })
+	return rejects, nil
+}
+
+// findLastImportRun은 조건에 맞는 가장 최근 실행을 조회합니다.
+func (m *memoryRepository) findLastImportRun(match func(*postalcode.ImportRun) bool) (*postalcode.ImportRun, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for i := len(m.importRuns) - 1; i >= 0; i-- {
+		if match(&m.importRuns[i]) {
+			run := m.importRuns[i]
+			return &run, nil
+		}
+	}
+	return nil, postalcode.ErrNotFound
+}
@@ -1,9 +1,15 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
 	postalcode "github.com/oursportsnation/korean-postalcode"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 )
 
 // Repository는 우편번호 데이터 접근 인터페이스입니다.
@@ -30,6 +36,13 @@ type Repository interface {
 	// Delete는 우편번호 데이터를 삭제합니다.
 	Delete(id uint) error
 
+	// BatchDelete는 여러 우편번호 데이터를 ID로 일괄 삭제합니다.
+	BatchDelete(ids []uint) error
+
+	// ScanRoads는 검색 조건에 맞는 모든 데이터를 ID 순으로 batchSize씩 순회합니다.
+	// 페이징 없이 전체 데이터를 처리해야 하는 경우(diff import 등)에 사용합니다.
+	ScanRoads(params postalcode.SearchParams, batchSize int, fn func([]postalcode.PostalCodeRoad) error) error
+
 	// TruncateRoad는 도로명주소 테이블의 모든 데이터를 삭제합니다.
 	TruncateRoad() error
 
@@ -55,24 +68,289 @@ type Repository interface {
 	// DeleteLand는 지번주소 데이터를 삭제합니다.
 	DeleteLand(id uint) error
 
+	// BatchDeleteLand는 여러 지번주소 데이터를 ID로 일괄 삭제합니다.
+	BatchDeleteLand(ids []uint) error
+
+	// ScanLands는 검색 조건에 맞는 모든 지번주소 데이터를 ID 순으로 batchSize씩 순회합니다.
+	ScanLands(params postalcode.SearchParamsLand, batchSize int, fn func([]postalcode.PostalCodeLand) error) error
+
 	// TruncateLand는 지번주소 테이블의 모든 데이터를 삭제합니다.
 	TruncateLand() error
+
+	// 건물 관련 메서드
+	// SearchBuildings는 건물명 등 여러 조건으로 건물을 검색합니다.
+	SearchBuildings(params postalcode.SearchParamsBuilding) ([]postalcode.PostalCodeBuilding, int64, error)
+
+	// BatchCreateBuilding은 여러 건물 데이터를 배치로 생성합니다. (건물관리번호 기준 upsert)
+	BatchCreateBuilding(buildings []postalcode.PostalCodeBuilding) error
+
+	// TruncateBuilding은 건물 테이블의 모든 데이터를 삭제합니다.
+	TruncateBuilding() error
+
+	// 사서함 관련 메서드
+	// FindPOBoxByZipCode는 우편번호로 사서함을 조회합니다.
+	FindPOBoxByZipCode(zipCode string) ([]postalcode.PostalCodePOBox, error)
+
+	// SearchPOBoxes는 여러 조건으로 사서함을 검색합니다.
+	SearchPOBoxes(params postalcode.SearchParamsPOBox) ([]postalcode.PostalCodePOBox, int64, error)
+
+	// BatchCreatePOBox는 여러 사서함 데이터를 배치로 생성합니다. (유니크 키 기준 upsert)
+	BatchCreatePOBox(poBoxes []postalcode.PostalCodePOBox) error
+
+	// TruncatePOBox는 사서함 테이블의 모든 데이터를 삭제합니다.
+	TruncatePOBox() error
+
+	// 다량배달처 관련 메서드
+	// FindBulkDeliveryByZipCode는 우편번호로 다량배달처를 조회합니다.
+	FindBulkDeliveryByZipCode(zipCode string) ([]postalcode.PostalCodeBulkDelivery, error)
+
+	// SearchBulkDeliveries는 여러 조건으로 다량배달처를 검색합니다.
+	SearchBulkDeliveries(params postalcode.SearchParamsBulkDelivery) ([]postalcode.PostalCodeBulkDelivery, int64, error)
+
+	// BatchCreateBulkDelivery는 여러 다량배달처 데이터를 배치로 생성합니다. (유니크 키 기준 upsert)
+	BatchCreateBulkDelivery(deliveries []postalcode.PostalCodeBulkDelivery) error
+
+	// TruncateBulkDelivery는 다량배달처 테이블의 모든 데이터를 삭제합니다.
+	TruncateBulkDelivery() error
+
+	// ExistsAddressZipCode는 우편번호가 도로명주소 또는 지번주소 테이블에 있는지 확인합니다.
+	ExistsAddressZipCode(zipCode string) (bool, error)
+
+	// Import 이력 관련 메서드
+	// CreateImportRun은 import 실행 이력을 생성합니다.
+	CreateImportRun(run *postalcode.ImportRun) error
+
+	// UpdateImportRun은 import 실행 이력을 갱신합니다.
+	UpdateImportRun(run *postalcode.ImportRun) error
+
+	// ListImportRuns는 최근 import 실행 이력을 최신순으로 조회합니다.
+	ListImportRuns(limit int) ([]postalcode.ImportRun, error)
+
+	// FindLatestImportRun은 마지막으로 성공한 import 실행을 조회합니다.
+	// 성공한 실행이 없으면 postalcode.ErrNotFound를, import_runs 테이블이 없으면 postalcode.ErrImportHistoryUnavailable을 반환합니다.
+	FindLatestImportRun() (*postalcode.ImportRun, error)
+
+	// FindLastAppliedImportRun은 dataTypes 중 하나를 대상으로 테이블에 데이터를 반영한
+	// 마지막 실행(성공했거나, 테이블을 비웠거나, 커밋된 체크포인트가 있는 실행)을 조회합니다.
+	// 해당 실행이 없으면 postalcode.ErrNotFound를 반환합니다.
+	FindLastAppliedImportRun(dataTypes ...string) (*postalcode.ImportRun, error)
+
+	// BatchCreateImportRejects는 거부된 라인을 격리 테이블에 일괄 저장합니다.
+	BatchCreateImportRejects(rejects []postalcode.ImportReject) error
+
+	// ListImportRejects는 import 실행의 거부 라인을 라인 순서로 조회합니다.
+	ListImportRejects(runID uint) ([]postalcode.ImportReject, error)
 }
 
 // gormRepository는 GORM 기반 Repository 구현입니다.
 type gormRepository struct {
-	db *gorm.DB
+	db   *gorm.DB
+	opts options
+	text *textIndexes
+}
+
+// Option은 Repository 생성 옵션입니다.
+type Option func(*options)
+
+// options는 Repository 구현이 공통으로 사용하는 생성 옵션입니다.
+type options struct {
+	fullText bool
+}
+
+// WithFullTextSearch는 통합 검색어(SearchParams.Query, SearchParamsLand.Query)를 전문 검색 인덱스로 처리하는 옵션입니다.
+// MySQL은 ngram parser FULLTEXT 인덱스(MATCH ... AGAINST), SQLite와 메모리 Repository는 내장 bigram 역색인으로 후보를 좁힌 뒤
+// LIKE 조건으로 다시 확인하며(MySQL의 한 글자 단어는 LIKE로만 검색), PostgreSQL은 trigram 인덱스가 ILIKE 조건을 가속하므로 이 옵션을 사용하지 않습니다.
+// 옵션이 없으면 통합 검색어를 검색 대상 컬럼의 LIKE 조건으로만 처리합니다.
+func WithFullTextSearch() Option {
+	return func(o *options) {
+		o.fullText = true
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
 }
 
 // New는 새로운 Repository를 생성합니다.
-func New(db *gorm.DB) Repository {
-	return &gormRepository{db: db}
+func New(db *gorm.DB, opts ...Option) Repository {
+	return &gormRepository{db: db, opts: newOptions(opts), text: &textIndexes{}}
+}
+
+// trigramIndexes는 PostgreSQL에서 부분 문자열 검색(ILIKE '%...%')을 가속하는 pg_trgm GIN 인덱스입니다.
+var trigramIndexes = []struct{ name, table, column string }{
+	{"idx_road_road_name_trgm", "postal_code_roads", "road_name"},
+	{"idx_road_sigungu_name_trgm", "postal_code_roads", "sigungu_name"},
+	{"idx_road_eupmyeon_name_trgm", "postal_code_roads", "eupmyeon_name"},
+	{"idx_land_sigungu_name_trgm", "postal_code_lands", "sigungu_name"},
+	{"idx_land_eupmyeondong_name_trgm", "postal_code_lands", "eupmyeondong_name"},
+	{"idx_land_ri_name_trgm", "postal_code_lands", "ri_name"},
+}
+
+// fullTextIndexes는 MySQL에서 통합 검색어(MATCH ... AGAINST)에 사용하는 ngram parser FULLTEXT 인덱스입니다.
+// 컬럼 목록은 MATCH 절과 같아야 합니다.
+var fullTextIndexes = []struct {
+	name, table string
+	columns     []string
+}{
+	{"idx_road_fulltext", "postal_code_roads", roadTextColumns},
+	{"idx_land_fulltext", "postal_code_lands", landTextColumns},
+}
+
+// CreateSearchIndexes는 dialect별 검색용 인덱스를 만듭니다. 테이블을 AutoMigrate한 뒤 호출하며, 여러 번 호출해도 안전합니다.
+// PostgreSQL은 pg_trgm 확장과 도로명/시군구/읍면(동)/리 trigram GIN 인덱스, MySQL은 통합 검색어용 ngram FULLTEXT 인덱스를 만들고,
+// SQLite는 아무것도 하지 않습니다. (역색인은 Repository가 메모리에 만듦)
+func CreateSearchIndexes(db *gorm.DB) error {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return createTrigramIndexes(db)
+	case "mysql":
+		return createFullTextIndexes(db)
+	}
+	return nil
+}
+
+// createFullTextIndexes는 MySQL ngram FULLTEXT 인덱스를 만듭니다. MySQL은 CREATE INDEX IF NOT EXISTS가 없으므로 먼저 존재 여부를 확인합니다.
+func createFullTextIndexes(db *gorm.DB) error {
+	for _, index := range fullTextIndexes {
+		if !db.Migrator().HasTable(index.table) || db.Migrator().HasIndex(index.table, index.name) {
+			continue
+		}
+		sql := fmt.Sprintf("CREATE FULLTEXT INDEX %s ON %s (%s) WITH PARSER ngram", index.name, index.table, strings.Join(index.columns, ", "))
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to create index %s: %w", index.name, err)
+		}
+	}
+	return nil
+}
+
+// createTrigramIndexes는 PostgreSQL pg_trgm 확장과 trigram GIN 인덱스를 만듭니다.
+func createTrigramIndexes(db *gorm.DB) error {
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return fmt.Errorf("failed to create pg_trgm extension (requires CREATE privilege on the database): %w", err)
+	}
+	for _, index := range trigramIndexes {
+		if !db.Migrator().HasTable(index.table) {
+			continue
+		}
+		sql := fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING gin (%s gin_trgm_ops)", index.name, index.table, index.column)
+		if err := db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to create index %s: %w", index.name, err)
+		}
+	}
+	return nil
+}
+
+// maxBindParams는 dialect별 SQL 문 하나에 넣을 수 있는 최대 바인드 파라미터 수입니다.
+// MySQL은 개수 제한이 없고(max_allowed_packet), SQLite는 SQLITE_MAX_VARIABLE_NUMBER(3.32 이상 32766),
+// PostgreSQL은 프로토콜 제한(65535)입니다.
+var maxBindParams = map[string]int{
+	"sqlite":   32766,
+	"postgres": 65535,
+}
+
+// upsert는 유니크 키(columns) 기준으로 values를 일괄 upsert합니다.
+// MySQL은 ON DUPLICATE KEY UPDATE, SQLite/PostgreSQL은 ON CONFLICT (columns) DO UPDATE로 실행되며,
+// 한 문장의 바인드 파라미터가 dialect 제한을 넘지 않도록 나누어 실행합니다.
+func (r *gormRepository) upsert(values interface{}, count int, columns []clause.Column) error {
+	if count == 0 {
+		return nil
+	}
+
+	db := r.db.Clauses(clause.OnConflict{Columns: columns, UpdateAll: true})
+	dialect := r.db.Dialector.Name()
+	limit, ok := maxBindParams[dialect]
+	if !ok {
+		return db.Create(values).Error
+	}
+
+	stmt := &gorm.Statement{DB: r.db}
+	if err := stmt.Parse(values); err != nil {
+		return err
+	}
+
+	// PostgreSQL은 한 문장 안에서 같은 행을 두 번 갱신할 수 없으므로
+	// ("ON CONFLICT DO UPDATE command cannot affect row a second time") 같은 키는 마지막 행만 남김
+	if dialect == "postgres" {
+		values, count = lastByKey(stmt.Schema, values, columns)
+	}
+
+	if size := limit / len(stmt.Schema.DBNames); count > size {
+		return db.CreateInBatches(values, size).Error
+	}
+	return db.Create(values).Error
+}
+
+// lastByKey는 유니크 키(columns)가 같은 행이 여러 개면 마지막 행만 남긴 슬라이스 포인터와 행 수를 반환합니다.
+// MySQL/SQLite에서 같은 배치를 upsert한 결과(뒤의 행이 앞의 행을 갱신)와 같습니다.
+func lastByKey(sch *schema.Schema, values interface{}, columns []clause.Column) (interface{}, int) {
+	rows := reflect.Indirect(reflect.ValueOf(values))
+	fields := make([]*schema.Field, len(columns))
+	for i, column := range columns {
+		fields[i] = sch.LookUpField(column.Name)
+	}
+
+	ctx := context.Background()
+	keys := make([]string, rows.Len())
+	last := make(map[string]int, rows.Len())
+	for i := range keys {
+		var key strings.Builder
+		for _, field := range fields {
+			value, _ := field.ValueOf(ctx, rows.Index(i))
+			fmt.Fprintf(&key, "%v\x00", value)
+		}
+		keys[i] = key.String()
+		last[keys[i]] = i
+	}
+	if len(last) == rows.Len() {
+		return values, rows.Len()
+	}
+
+	unique := reflect.MakeSlice(rows.Type(), 0, len(last))
+	for i, key := range keys {
+		if last[key] == i {
+			unique = reflect.Append(unique, rows.Index(i))
+		}
+	}
+	ptr := reflect.New(unique.Type())
+	ptr.Elem().Set(unique)
+	return ptr.Interface(), unique.Len()
+}
+
+// like는 column의 부분 문자열 검색 조건("column LIKE ?")을 반환합니다.
+// MySQL(기본 collation)과 SQLite의 LIKE는 대소문자를 구분하지 않으므로, PostgreSQL은 같은 결과가 나오도록 ILIKE를 사용합니다.
+// (pg_trgm GIN 인덱스는 ILIKE에도 사용됨)
+func (r *gormRepository) like(column string) string {
+	if r.db.Dialector.Name() == "postgres" {
+		return column + " ILIKE ?"
+	}
+	return column + " LIKE ?"
+}
+
+// truncate는 테이블의 모든 데이터를 삭제하고 자동 증가 ID를 초기화합니다.
+func (r *gormRepository) truncate(table string) error {
+	switch r.db.Dialector.Name() {
+	case "mysql":
+		return r.db.Exec("TRUNCATE TABLE " + table).Error
+	case "postgres":
+		return r.db.Exec("TRUNCATE TABLE " + table + " RESTART IDENTITY").Error
+	case "sqlite":
+		// SQLite는 TRUNCATE가 없으므로 DELETE 후 AUTOINCREMENT 시퀀스 삭제
+		if err := r.db.Exec("DELETE FROM " + table).Error; err != nil {
+			return err
+		}
+		return r.db.Exec("DELETE FROM sqlite_sequence WHERE name = ?", table).Error
+	}
+	return r.db.Exec("DELETE FROM " + table).Error
 }
 
 // FindByZipCode는 우편번호로 조회합니다.
 func (r *gormRepository) FindByZipCode(zipCode string) ([]postalcode.PostalCodeRoad, error) {
 	var roads []postalcode.PostalCodeRoad
-	err := r.db.Where("zip_code = ?", zipCode).Find(&roads).Error
+	err := r.db.Where("zip_code = ?", zipCode).Order("id").Find(&roads).Error
 	return roads, err
 }
 
@@ -96,8 +374,8 @@ func (r *gormRepository) FindByZipPrefix(zipPrefix string, limit, offset int) ([
 		query = query.Offset(offset)
 	}
 
-	// 조회
-	err := query.Find(&roads).Error
+	// 조회 (ID 순; ORDER BY가 없으면 PostgreSQL은 페이지 간 순서를 보장하지 않음)
+	err := query.Order("id").Find(&roads).Error
 	return roads, total, err
 }
 
@@ -106,24 +384,7 @@ func (r *gormRepository) Search(params postalcode.SearchParams) ([]postalcode.Po
 	var roads []postalcode.PostalCodeRoad
 	var total int64
 
-	query := r.db.Model(&postalcode.PostalCodeRoad{})
-
-	// 조건 추가
-	if params.ZipCode != "" {
-		query = query.Where("zip_code = ?", params.ZipCode)
-	}
-	if params.ZipPrefix != "" {
-		query = query.Where("zip_prefix = ?", params.ZipPrefix)
-	}
-	if params.SidoName != "" {
-		query = query.Where("sido_name LIKE ?", "%"+params.SidoName+"%")
-	}
-	if params.SigunguName != "" {
-		query = query.Where("sigungu_name LIKE ?", "%"+params.SigunguName+"%")
-	}
-	if params.RoadName != "" {
-		query = query.Where("road_name LIKE ?", "%"+params.RoadName+"%")
-	}
+	query := r.applyRoadFilters(r.db.Model(&postalcode.PostalCodeRoad{}), params)
 
 	// 총 개수 조회
 	if err := query.Count(&total).Error; err != nil {
@@ -143,56 +404,100 @@ func (r *gormRepository) Search(params postalcode.SearchParams) ([]postalcode.Po
 		query = query.Offset(offset)
 	}
 
-	// 조회
-	err := query.Find(&roads).Error
+	// 조회 (ID 순; ORDER BY가 없으면 PostgreSQL은 페이지 간 순서를 보장하지 않음)
+	err := query.Order("id").Find(&roads).Error
 	return roads, total, err
 }
 
+// applyRoadFilters는 검색 조건을 쿼리에 추가합니다.
+func (r *gormRepository) applyRoadFilters(query *gorm.DB, params postalcode.SearchParams) *gorm.DB {
+	if params.ZipCode != "" {
+		query = query.Where("zip_code = ?", params.ZipCode)
+	}
+	if params.ZipPrefix != "" {
+		query = query.Where("zip_prefix = ?", params.ZipPrefix)
+	}
+	if params.SidoName != "" {
+		query = query.Where(r.like("sido_name"), "%"+params.SidoName+"%")
+	}
+	if params.SigunguName != "" {
+		query = query.Where(r.like("sigungu_name"), "%"+params.SigunguName+"%")
+	}
+	if params.RoadName != "" {
+		query = query.Where(r.like("road_name"), "%"+params.RoadName+"%")
+	}
+	if params.Query != "" {
+		query = r.applyQuery(query, params.Query, roadTextColumns, r.roadTextIndex)
+	}
+	return query
+}
+
 // Create는 새로운 우편번호 데이터를 생성합니다.
 func (r *gormRepository) Create(road *postalcode.PostalCodeRoad) error {
+	defer r.text.resetRoads()
 	return r.db.Create(road).Error
 }
 
 // BatchCreate는 여러 우편번호 데이터를 배치로 생성합니다.
 func (r *gormRepository) BatchCreate(roads []postalcode.PostalCodeRoad) error {
-	return r.db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "zip_code"}, {Name: "sido_name"}, {Name: "sigungu_name"}, {Name: "road_name"}, {Name: "start_building_main"}},
-		UpdateAll: true,
-	}).Create(&roads).Error
+	defer r.text.resetRoads()
+	return r.upsert(&roads, len(roads), []clause.Column{{Name: "zip_code"}, {Name: "sido_name"}, {Name: "sigungu_name"}, {Name: "road_name"}, {Name: "start_building_main"}})
 }
 
 // Update는 우편번호 데이터를 업데이트합니다.
 func (r *gormRepository) Update(road *postalcode.PostalCodeRoad) error {
+	defer r.text.resetRoads()
 	return r.db.Save(road).Error
 }
 
 // Delete는 우편번호 데이터를 삭제합니다.
 func (r *gormRepository) Delete(id uint) error {
+	defer r.text.resetRoads()
 	return r.db.Delete(&postalcode.PostalCodeRoad{}, id).Error
 }
 
-// TruncateRoad는 도로명주소 테이블의 모든 데이터를 삭제합니다.
-func (r *gormRepository) TruncateRoad() error {
-	// MySQL과 SQLite 모두 지원
-	// MySQL의 경우 TRUNCATE가 빠르지만, SQLite는 DELETE를 사용
-	dialect := r.db.Dialector.Name()
-
-	if dialect == "mysql" {
-		return r.db.Exec("TRUNCATE TABLE postal_code_roads").Error
+// BatchDelete는 여러 우편번호 데이터를 ID로 일괄 삭제합니다.
+func (r *gormRepository) BatchDelete(ids []uint) error {
+	defer r.text.resetRoads()
+	if len(ids) == 0 {
+		return nil
 	}
+	return r.db.Delete(&postalcode.PostalCodeRoad{}, ids).Error
+}
 
-	// SQLite 또는 다른 DB의 경우
-	// 1. 모든 데이터 삭제
-	if err := r.db.Exec("DELETE FROM postal_code_roads").Error; err != nil {
-		return err
+// ScanRoads는 검색 조건에 맞는 모든 데이터를 ID 순으로 batchSize씩 순회합니다.
+func (r *gormRepository) ScanRoads(params postalcode.SearchParams, batchSize int, fn func([]postalcode.PostalCodeRoad) error) error {
+	if batchSize <= 0 {
+		batchSize = 1000
 	}
 
-	// 2. AUTO_INCREMENT 리셋 (SQLite의 경우)
-	if dialect == "sqlite" {
-		return r.db.Exec("DELETE FROM sqlite_sequence WHERE name='postal_code_roads'").Error
+	// OFFSET 대신 마지막 ID 기준으로 다음 배치를 조회 (대용량 테이블에서도 일정한 속도)
+	var lastID uint
+	for {
+		var roads []postalcode.PostalCodeRoad
+		query := r.applyRoadFilters(r.db.Model(&postalcode.PostalCodeRoad{}), params)
+		if err := query.Where("id > ?", lastID).Order("id").Limit(batchSize).Find(&roads).Error; err != nil {
+			return err
+		}
+		if len(roads) == 0 {
+			return nil
+		}
+
+		if err := fn(roads); err != nil {
+			return err
+		}
+
+		lastID = roads[len(roads)-1].ID
+		if len(roads) < batchSize {
+			return nil
+		}
 	}
+}
 
-	return nil
+// TruncateRoad는 도로명주소 테이블의 모든 데이터를 삭제합니다.
+func (r *gormRepository) TruncateRoad() error {
+	defer r.text.resetRoads()
+	return r.truncate("postal_code_roads")
 }
 
 // ============================================================
@@ -202,7 +507,7 @@ func (r *gormRepository) TruncateRoad() error {
 // FindLandByZipCode는 우편번호로 지번주소를 조회합니다.
 func (r *gormRepository) FindLandByZipCode(zipCode string) ([]postalcode.PostalCodeLand, error) {
 	var lands []postalcode.PostalCodeLand
-	err := r.db.Where("zip_code = ?", zipCode).Find(&lands).Error
+	err := r.db.Where("zip_code = ?", zipCode).Order("id").Find(&lands).Error
 	return lands, err
 }
 
@@ -226,8 +531,8 @@ func (r *gormRepository) FindLandByZipPrefix(zipPrefix string, limit, offset int
 		query = query.Offset(offset)
 	}
 
-	// 조회
-	err := query.Find(&lands).Error
+	// 조회 (ID 순; ORDER BY가 없으면 PostgreSQL은 페이지 간 순서를 보장하지 않음)
+	err := query.Order("id").Find(&lands).Error
 	return lands, total, err
 }
 
@@ -236,9 +541,33 @@ func (r *gormRepository) SearchLand(params postalcode.SearchParamsLand) ([]posta
 	var lands []postalcode.PostalCodeLand
 	var total int64
 
-	query := r.db.Model(&postalcode.PostalCodeLand{})
+	query := r.applyLandFilters(r.db.Model(&postalcode.PostalCodeLand{}), params)
+
+	// 총 개수 조회
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
 
-	// 조건 추가
+	// 페이징 (page를 offset으로 변환)
+	if params.Limit > 0 {
+		query = query.Limit(params.Limit)
+	} else {
+		query = query.Limit(10) // 기본 10개
+	}
+
+	// page 기반 offset 계산
+	offset := (params.Page - 1) * params.Limit
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	// 조회 (ID 순; ORDER BY가 없으면 PostgreSQL은 페이지 간 순서를 보장하지 않음)
+	err := query.Order("id").Find(&lands).Error
+	return lands, total, err
+}
+
+// applyLandFilters는 지번주소 검색 조건을 쿼리에 추가합니다.
+func (r *gormRepository) applyLandFilters(query *gorm.DB, params postalcode.SearchParamsLand) *gorm.DB {
 	if params.ZipCode != "" {
 		query = query.Where("zip_code = ?", params.ZipCode)
 	}
@@ -246,16 +575,114 @@ func (r *gormRepository) SearchLand(params postalcode.SearchParamsLand) ([]posta
 		query = query.Where("zip_prefix = ?", params.ZipPrefix)
 	}
 	if params.SidoName != "" {
-		query = query.Where("sido_name LIKE ?", "%"+params.SidoName+"%")
+		query = query.Where(r.like("sido_name"), "%"+params.SidoName+"%")
 	}
 	if params.SigunguName != "" {
-		query = query.Where("sigungu_name LIKE ?", "%"+params.SigunguName+"%")
+		query = query.Where(r.like("sigungu_name"), "%"+params.SigunguName+"%")
 	}
 	if params.EupmyeondongName != "" {
-		query = query.Where("eupmyeondong_name LIKE ?", "%"+params.EupmyeondongName+"%")
+		query = query.Where(r.like("eupmyeondong_name"), "%"+params.EupmyeondongName+"%")
 	}
 	if params.RiName != "" {
-		query = query.Where("ri_name LIKE ?", "%"+params.RiName+"%")
+		query = query.Where(r.like("ri_name"), "%"+params.RiName+"%")
+	}
+	if params.Query != "" {
+		query = r.applyQuery(query, params.Query, landTextColumns, r.landTextIndex)
+	}
+	return query
+}
+
+// CreateLand는 새로운 지번주소 데이터를 생성합니다.
+func (r *gormRepository) CreateLand(land *postalcode.PostalCodeLand) error {
+	defer r.text.resetLands()
+	return r.db.Create(land).Error
+}
+
+// BatchCreateLand는 여러 지번주소 데이터를 배치로 생성합니다.
+func (r *gormRepository) BatchCreateLand(lands []postalcode.PostalCodeLand) error {
+	defer r.text.resetLands()
+	return r.upsert(&lands, len(lands), []clause.Column{{Name: "zip_code"}, {Name: "sido_name"}, {Name: "sigungu_name"}, {Name: "eupmyeondong_name"}, {Name: "ri_name"}, {Name: "is_mountain"}, {Name: "start_jibun_main"}})
+}
+
+// UpdateLand는 지번주소 데이터를 업데이트합니다.
+func (r *gormRepository) UpdateLand(land *postalcode.PostalCodeLand) error {
+	defer r.text.resetLands()
+	return r.db.Save(land).Error
+}
+
+// DeleteLand는 지번주소 데이터를 삭제합니다.
+func (r *gormRepository) DeleteLand(id uint) error {
+	defer r.text.resetLands()
+	return r.db.Delete(&postalcode.PostalCodeLand{}, id).Error
+}
+
+// BatchDeleteLand는 여러 지번주소 데이터를 ID로 일괄 삭제합니다.
+func (r *gormRepository) BatchDeleteLand(ids []uint) error {
+	defer r.text.resetLands()
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.Delete(&postalcode.PostalCodeLand{}, ids).Error
+}
+
+// ScanLands는 검색 조건에 맞는 모든 지번주소 데이터를 ID 순으로 batchSize씩 순회합니다.
+func (r *gormRepository) ScanLands(params postalcode.SearchParamsLand, batchSize int, fn func([]postalcode.PostalCodeLand) error) error {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	// OFFSET 대신 마지막 ID 기준으로 다음 배치를 조회 (대용량 테이블에서도 일정한 속도)
+	var lastID uint
+	for {
+		var lands []postalcode.PostalCodeLand
+		query := r.applyLandFilters(r.db.Model(&postalcode.PostalCodeLand{}), params)
+		if err := query.Where("id > ?", lastID).Order("id").Limit(batchSize).Find(&lands).Error; err != nil {
+			return err
+		}
+		if len(lands) == 0 {
+			return nil
+		}
+
+		if err := fn(lands); err != nil {
+			return err
+		}
+
+		lastID = lands[len(lands)-1].ID
+		if len(lands) < batchSize {
+			return nil
+		}
+	}
+}
+
+// TruncateLand는 지번주소 테이블의 모든 데이터를 삭제합니다.
+func (r *gormRepository) TruncateLand() error {
+	defer r.text.resetLands()
+	return r.truncate("postal_code_lands")
+}
+
+// ============================================================
+// 건물 관련 메서드
+// ============================================================
+
+// SearchBuildings는 건물명 등 여러 조건으로 건물을 검색합니다.
+// 건물명은 공백을 무시하고 시군구용 건물명 또는 건축물대장 건물명에 부분 매칭합니다.
+func (r *gormRepository) SearchBuildings(params postalcode.SearchParamsBuilding) ([]postalcode.PostalCodeBuilding, int64, error) {
+	var buildings []postalcode.PostalCodeBuilding
+	var total int64
+
+	query := r.db.Model(&postalcode.PostalCodeBuilding{})
+	if name := strings.Join(strings.Fields(params.BuildingName), ""); name != "" {
+		pattern := "%" + name + "%"
+		query = query.Where(r.like("REPLACE(building_name, ' ', '')")+" OR "+r.like("REPLACE(building_ledger_name, ' ', '')"), pattern, pattern)
+	}
+	if params.ZipCode != "" {
+		query = query.Where("zip_code = ?", params.ZipCode)
+	}
+	if params.SidoName != "" {
+		query = query.Where(r.like("sido_name"), "%"+params.SidoName+"%")
+	}
+	if params.SigunguName != "" {
+		query = query.Where(r.like("sigungu_name"), "%"+params.SigunguName+"%")
 	}
 
 	// 총 개수 조회
@@ -276,54 +703,229 @@ func (r *gormRepository) SearchLand(params postalcode.SearchParamsLand) ([]posta
 		query = query.Offset(offset)
 	}
 
-	// 조회
-	err := query.Find(&lands).Error
-	return lands, total, err
+	// 조회 (시군구용 건물명이 있는 건물 중 이름이 짧은 순: 정확히 일치하는 건물이 먼저 오도록)
+	err := query.Order("CASE WHEN building_name = '' THEN 1 ELSE 0 END, LENGTH(building_name), id").Find(&buildings).Error
+	return buildings, total, err
 }
 
-// CreateLand는 새로운 지번주소 데이터를 생성합니다.
-func (r *gormRepository) CreateLand(land *postalcode.PostalCodeLand) error {
-	return r.db.Create(land).Error
+// BatchCreateBuilding은 여러 건물 데이터를 배치로 생성합니다. (건물관리번호 기준 upsert)
+func (r *gormRepository) BatchCreateBuilding(buildings []postalcode.PostalCodeBuilding) error {
+	return r.upsert(&buildings, len(buildings), []clause.Column{{Name: "building_management_number"}})
 }
 
-// BatchCreateLand는 여러 지번주소 데이터를 배치로 생성합니다.
-func (r *gormRepository) BatchCreateLand(lands []postalcode.PostalCodeLand) error {
-	return r.db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "zip_code"}, {Name: "sido_name"}, {Name: "sigungu_name"}, {Name: "eupmyeondong_name"}, {Name: "ri_name"}, {Name: "is_mountain"}, {Name: "start_jibun_main"}},
-		UpdateAll: true,
-	}).Create(&lands).Error
+// TruncateBuilding은 건물 테이블의 모든 데이터를 삭제합니다.
+func (r *gormRepository) TruncateBuilding() error {
+	return r.truncate("postal_code_buildings")
 }
 
-// UpdateLand는 지번주소 데이터를 업데이트합니다.
-func (r *gormRepository) UpdateLand(land *postalcode.PostalCodeLand) error {
-	return r.db.Save(land).Error
+// ============================================================
+// 사서함 관련 메서드
+// ============================================================
+
+// FindPOBoxByZipCode는 우편번호로 사서함을 조회합니다.
+func (r *gormRepository) FindPOBoxByZipCode(zipCode string) ([]postalcode.PostalCodePOBox, error) {
+	var poBoxes []postalcode.PostalCodePOBox
+	err := r.db.Where("zip_code = ?", zipCode).Order("id").Find(&poBoxes).Error
+	return poBoxes, err
 }
 
-// DeleteLand는 지번주소 데이터를 삭제합니다.
-func (r *gormRepository) DeleteLand(id uint) error {
-	return r.db.Delete(&postalcode.PostalCodeLand{}, id).Error
+// SearchPOBoxes는 여러 조건으로 사서함을 검색합니다.
+func (r *gormRepository) SearchPOBoxes(params postalcode.SearchParamsPOBox) ([]postalcode.PostalCodePOBox, int64, error) {
+	var poBoxes []postalcode.PostalCodePOBox
+	var total int64
+
+	query := r.db.Model(&postalcode.PostalCodePOBox{})
+	if params.ZipCode != "" {
+		query = query.Where("zip_code = ?", params.ZipCode)
+	}
+	if params.SidoName != "" {
+		query = query.Where(r.like("sido_name"), "%"+params.SidoName+"%")
+	}
+	if params.SigunguName != "" {
+		query = query.Where(r.like("sigungu_name"), "%"+params.SigunguName+"%")
+	}
+	if params.POBoxName != "" {
+		query = query.Where(r.like("po_box_name"), "%"+params.POBoxName+"%")
+	}
+
+	// 총 개수 조회
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 페이징 (page를 offset으로 변환)
+	if params.Limit > 0 {
+		query = query.Limit(params.Limit)
+	} else {
+		query = query.Limit(10) // 기본 10개
+	}
+
+	// page 기반 offset 계산
+	offset := (params.Page - 1) * params.Limit
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err := query.Order("zip_code, start_number_main, id").Find(&poBoxes).Error
+	return poBoxes, total, err
 }
 
-// TruncateLand는 지번주소 테이블의 모든 데이터를 삭제합니다.
-func (r *gormRepository) TruncateLand() error {
-	// MySQL과 SQLite 모두 지원
-	// MySQL의 경우 TRUNCATE가 빠르지만, SQLite는 DELETE를 사용
-	dialect := r.db.Dialector.Name()
+// BatchCreatePOBox는 여러 사서함 데이터를 배치로 생성합니다. (유니크 키 기준 upsert)
+func (r *gormRepository) BatchCreatePOBox(poBoxes []postalcode.PostalCodePOBox) error {
+	return r.upsert(&poBoxes, len(poBoxes), []clause.Column{{Name: "zip_code"}, {Name: "po_box_name"}, {Name: "start_number_main"}})
+}
 
-	if dialect == "mysql" {
-		return r.db.Exec("TRUNCATE TABLE postal_code_lands").Error
+// TruncatePOBox는 사서함 테이블의 모든 데이터를 삭제합니다.
+func (r *gormRepository) TruncatePOBox() error {
+	return r.truncate("postal_code_po_boxes")
+}
+
+// ============================================================
+// 다량배달처 관련 메서드
+// ============================================================
+
+// FindBulkDeliveryByZipCode는 우편번호로 다량배달처를 조회합니다.
+func (r *gormRepository) FindBulkDeliveryByZipCode(zipCode string) ([]postalcode.PostalCodeBulkDelivery, error) {
+	var deliveries []postalcode.PostalCodeBulkDelivery
+	err := r.db.Where("zip_code = ?", zipCode).Order("id").Find(&deliveries).Error
+	return deliveries, err
+}
+
+// SearchBulkDeliveries는 여러 조건으로 다량배달처를 검색합니다.
+// 다량배달처명은 공백을 무시하고 부분 매칭합니다.
+func (r *gormRepository) SearchBulkDeliveries(params postalcode.SearchParamsBulkDelivery) ([]postalcode.PostalCodeBulkDelivery, int64, error) {
+	var deliveries []postalcode.PostalCodeBulkDelivery
+	var total int64
+
+	query := r.db.Model(&postalcode.PostalCodeBulkDelivery{})
+	if params.ZipCode != "" {
+		query = query.Where("zip_code = ?", params.ZipCode)
+	}
+	if params.SidoName != "" {
+		query = query.Where(r.like("sido_name"), "%"+params.SidoName+"%")
+	}
+	if params.SigunguName != "" {
+		query = query.Where(r.like("sigungu_name"), "%"+params.SigunguName+"%")
+	}
+	if name := strings.Join(strings.Fields(params.DeliveryName), ""); name != "" {
+		query = query.Where(r.like("REPLACE(delivery_name, ' ', '')"), "%"+name+"%")
 	}
 
-	// SQLite 또는 다른 DB의 경우
-	// 1. 모든 데이터 삭제
-	if err := r.db.Exec("DELETE FROM postal_code_lands").Error; err != nil {
-		return err
+	// 총 개수 조회
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
 	}
 
-	// 2. AUTO_INCREMENT 리셋 (SQLite의 경우)
-	if dialect == "sqlite" {
-		return r.db.Exec("DELETE FROM sqlite_sequence WHERE name='postal_code_lands'").Error
+	// 페이징 (page를 offset으로 변환)
+	if params.Limit > 0 {
+		query = query.Limit(params.Limit)
+	} else {
+		query = query.Limit(10) // 기본 10개
 	}
 
-	return nil
+	// page 기반 offset 계산
+	offset := (params.Page - 1) * params.Limit
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	// 조회 (이름이 짧은 순: 정확히 일치하는 다량배달처가 먼저 오도록)
+	err := query.Order("LENGTH(delivery_name), id").Find(&deliveries).Error
+	return deliveries, total, err
+}
+
+// BatchCreateBulkDelivery는 여러 다량배달처 데이터를 배치로 생성합니다. (유니크 키 기준 upsert)
+func (r *gormRepository) BatchCreateBulkDelivery(deliveries []postalcode.PostalCodeBulkDelivery) error {
+	return r.upsert(&deliveries, len(deliveries), []clause.Column{{Name: "zip_code"}, {Name: "delivery_name"}})
+}
+
+// TruncateBulkDelivery는 다량배달처 테이블의 모든 데이터를 삭제합니다.
+func (r *gormRepository) TruncateBulkDelivery() error {
+	return r.truncate("postal_code_bulk_deliveries")
+}
+
+// ExistsAddressZipCode는 우편번호가 도로명주소 또는 지번주소 테이블에 있는지 확인합니다.
+func (r *gormRepository) ExistsAddressZipCode(zipCode string) (bool, error) {
+	for _, model := range []interface{}{&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}} {
+		var ids []uint
+		if err := r.db.Model(model).Where("zip_code = ?", zipCode).Limit(1).Pluck("id", &ids).Error; err != nil {
+			return false, err
+		}
+		if len(ids) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ============================================================
+// Import 이력 관련 메서드
+// ============================================================
+
+// CreateImportRun은 import 실행 이력을 생성합니다.
+// import_runs 테이블이 없으면 postalcode.ErrImportHistoryUnavailable을 반환합니다.
+func (r *gormRepository) CreateImportRun(run *postalcode.ImportRun) error {
+	if !r.db.Migrator().HasTable(&postalcode.ImportRun{}) {
+		return postalcode.ErrImportHistoryUnavailable
+	}
+	return r.db.Create(run).Error
+}
+
+// UpdateImportRun은 import 실행 이력을 갱신합니다.
+func (r *gormRepository) UpdateImportRun(run *postalcode.ImportRun) error {
+	return r.db.Save(run).Error
+}
+
+// ListImportRuns는 최근 import 실행 이력을 최신순으로 조회합니다.
+func (r *gormRepository) ListImportRuns(limit int) ([]postalcode.ImportRun, error) {
+	var runs []postalcode.ImportRun
+	query := r.db.Order("id DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&runs).Error
+	return runs, err
+}
+
+// FindLatestImportRun은 마지막으로 성공한 import 실행을 조회합니다.
+func (r *gormRepository) FindLatestImportRun() (*postalcode.ImportRun, error) {
+	return r.findLastImportRun(r.db.Where("outcome = ?", postalcode.ImportOutcomeSuccess))
+}
+
+// FindLastAppliedImportRun은 테이블에 데이터를 반영한 마지막 실행을 조회합니다.
+func (r *gormRepository) FindLastAppliedImportRun(dataTypes ...string) (*postalcode.ImportRun, error) {
+	return r.findLastImportRun(r.db.
+		Where("data_type IN ?", dataTypes).
+		Where("outcome = ? OR truncated = ? OR checkpoint_rows > 0", postalcode.ImportOutcomeSuccess, true))
+}
+
+// BatchCreateImportRejects는 거부된 라인을 격리 테이블에 일괄 저장합니다.
+func (r *gormRepository) BatchCreateImportRejects(rejects []postalcode.ImportReject) error {
+	if len(rejects) == 0 {
+		return nil
+	}
+	return r.db.CreateInBatches(rejects, 500).Error
+}
+
+// ListImportRejects는 import 실행의 거부 라인을 라인 순서로 조회합니다.
+func (r *gormRepository) ListImportRejects(runID uint) ([]postalcode.ImportReject, error) {
+	var rejects []postalcode.ImportReject
+	err := r.db.Where("run_id = ?", runID).Order("line ASC, id ASC").Find(&rejects).Error
+	return rejects, err
+}
+
+// findLastImportRun은 query 조건에 맞는 가장 최근 실행을 조회합니다.
+// 조회에 실패했을 때 import_runs 테이블이 없으면 postalcode.ErrImportHistoryUnavailable을 반환합니다.
+func (r *gormRepository) findLastImportRun(query *gorm.DB) (*postalcode.ImportRun, error) {
+	var runs []postalcode.ImportRun
+	if err := query.Order("id DESC").Limit(1).Find(&runs).Error; err != nil {
+		if !r.db.Migrator().HasTable(&postalcode.ImportRun{}) {
+			return nil, postalcode.ErrImportHistoryUnavailable
+		}
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, postalcode.ErrNotFound
+	}
+	return &runs[0], nil
 }
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// coverageScanBatchSize는 범위 분석 시 한 번에 읽을 행 수입니다.
+const coverageScanBatchSize = 5000
+
+// coverageKey는 범위 분석 그룹 키입니다.
+type coverageKey struct {
+	sidoName    string
+	sigunguName string
+	roadName    string
+	underground bool
+}
+
+// coverageRange는 한쪽(홀수/짝수) 건물번호에 대한 범위 하나입니다.
+// 시작/종료는 (본번, 부번) 순서로 비교하며, 종료 부번이 없으면 종료 본번의 모든 부번을 포함합니다.
+type coverageRange struct {
+	id        uint
+	zipCode   string
+	startMain int
+	startSub  int
+	endMain   int
+	endSub    int
+}
+
+// AnalyzeRoadCoverage는 도로별 건물번호 범위의 겹침과 빈 구간을 분석합니다.
+func (s *service) AnalyzeRoadCoverage(params postalcode.CoverageParams) (*postalcode.CoverageReport, error) {
+	startTime := time.Now()
+
+	groups := make(map[coverageKey][]postalcode.PostalCodeRoad)
+	scan := postalcode.SearchParams{
+		SidoName:    params.SidoName,
+		SigunguName: params.SigunguName,
+		RoadName:    params.RoadName,
+	}
+	err := s.repo.ScanRoads(scan, coverageScanBatchSize, func(roads []postalcode.PostalCodeRoad) error {
+		for _, road := range roads {
+			// 도로명은 부분 매칭으로 조회되므로 정확히 일치하는 도로만 분석
+			if params.RoadName != "" && road.RoadName != params.RoadName {
+				continue
+			}
+			key := coverageKey{road.SidoName, road.SigunguName, road.RoadName, road.IsUnderground}
+			groups[key] = append(groups[key], road)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]coverageKey, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.sidoName != b.sidoName {
+			return a.sidoName < b.sidoName
+		}
+		if a.sigunguName != b.sigunguName {
+			return a.sigunguName < b.sigunguName
+		}
+		if a.roadName != b.roadName {
+			return a.roadName < b.roadName
+		}
+		return !a.underground && b.underground
+	})
+
+	report := &postalcode.CoverageReport{
+		Roads: make([]postalcode.RoadCoverage, 0, len(keys)),
+	}
+	for _, key := range keys {
+		coverage := analyzeRoad(key, groups[key])
+		report.RangeCount += coverage.RangeCount
+		report.OverlapCount += len(coverage.Overlaps)
+		report.GapCount += len(coverage.Gaps)
+		report.Roads = append(report.Roads, coverage)
+	}
+	report.RoadCount = len(report.Roads)
+
+	report.Duration = time.Since(startTime).String()
+	return report, nil
+}
+
+// analyzeRoad는 도로 그룹 하나의 홀수/짝수 범위를 각각 분석합니다.
+func analyzeRoad(key coverageKey, roads []postalcode.PostalCodeRoad) postalcode.RoadCoverage {
+	coverage := postalcode.RoadCoverage{
+		SidoName:      key.sidoName,
+		SigunguName:   key.sigunguName,
+		RoadName:      key.roadName,
+		IsUnderground: key.underground,
+		RangeCount:    len(roads),
+		ZipCodes:      []string{},
+		Overlaps:      []postalcode.RangeOverlap{},
+		Gaps:          []postalcode.RangeGap{},
+	}
+
+	seen := make(map[string]bool)
+	for _, road := range roads {
+		if !seen[road.ZipCode] {
+			seen[road.ZipCode] = true
+			coverage.ZipCodes = append(coverage.ZipCodes, road.ZipCode)
+		}
+	}
+	sort.Strings(coverage.ZipCodes)
+
+	for _, side := range []string{postalcode.CoverageSideOdd, postalcode.CoverageSideEven} {
+		ranges := sideRanges(roads, side)
+		coverage.Overlaps = append(coverage.Overlaps, findOverlaps(ranges, side)...)
+		coverage.Gaps = append(coverage.Gaps, findGaps(ranges, side)...)
+	}
+	return coverage
+}
+
+// sideRanges는 side(홀수/짝수)에 해당하는 범위만 골라 시작/종료 본번을 side에 맞춘 뒤 시작 순서로 정렬합니다.
+// 범위종류가 올바르지 않은 행은 데이터 품질 검사(audit)에서 다루므로 제외합니다.
+func sideRanges(roads []postalcode.PostalCodeRoad, side string) []coverageRange {
+	parity := 1
+	if side == postalcode.CoverageSideEven {
+		parity = 0
+	}
+
+	ranges := make([]coverageRange, 0, len(roads))
+	for _, road := range roads {
+		switch road.RangeType {
+		case postalcode.RangeTypeSingle:
+			if abs(road.StartBuildingMain)%2 != parity {
+				continue
+			}
+		case postalcode.RangeTypeOdd:
+			if parity != 1 {
+				continue
+			}
+		case postalcode.RangeTypeEven:
+			if parity != 0 {
+				continue
+			}
+		case postalcode.RangeTypeAll:
+		default:
+			continue
+		}
+
+		r := coverageRange{
+			id:        road.ID,
+			zipCode:   road.ZipCode,
+			startMain: road.StartBuildingMain,
+			startSub:  intValue(road.StartBuildingSub),
+			endMain:   road.StartBuildingMain,
+			endSub:    intValue(road.StartBuildingSub),
+		}
+		if road.EndBuildingMain != nil && road.RangeType != postalcode.RangeTypeSingle {
+			r.endMain = *road.EndBuildingMain
+			r.endSub = math.MaxInt32
+			if road.EndBuildingSub != nil && *road.EndBuildingSub > 0 {
+				r.endSub = *road.EndBuildingSub
+			}
+		}
+
+		// 시작/종료 본번을 side의 건물번호로 맞춤 (예: 홀수 범위 2~10 → 3~9)
+		if abs(r.startMain)%2 != parity {
+			r.startMain++
+			r.startSub = 0
+		}
+		if abs(r.endMain)%2 != parity {
+			r.endMain--
+			r.endSub = math.MaxInt32
+		}
+		if comparePosition(r.startMain, r.startSub, r.endMain, r.endSub) > 0 {
+			continue
+		}
+		ranges = append(ranges, r)
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return comparePosition(ranges[i].startMain, ranges[i].startSub, ranges[j].startMain, ranges[j].startSub) < 0
+	})
+	return ranges
+}
+
+// findOverlaps는 서로 다른 우편번호의 범위가 겹치는 구간을 찾습니다. (ranges는 시작 순서로 정렬되어 있어야 함)
+func findOverlaps(ranges []coverageRange, side string) []postalcode.RangeOverlap {
+	var overlaps []postalcode.RangeOverlap
+	for i := range ranges {
+		a := ranges[i]
+		for j := i + 1; j < len(ranges); j++ {
+			b := ranges[j]
+			if comparePosition(b.startMain, b.startSub, a.endMain, a.endSub) > 0 {
+				break
+			}
+			if a.zipCode == b.zipCode {
+				continue
+			}
+			endMain := a.endMain
+			if b.endMain < endMain {
+				endMain = b.endMain
+			}
+			overlaps = append(overlaps, postalcode.RangeOverlap{
+				Side:      side,
+				StartMain: b.startMain,
+				EndMain:   endMain,
+				ZipCodes:  [2]string{a.zipCode, b.zipCode},
+				RowIDs:    [2]uint{a.id, b.id},
+			})
+		}
+	}
+	return overlaps
+}
+
+// findGaps는 첫 범위와 마지막 범위 사이에서 어떤 범위에도 속하지 않는 본번 구간을 찾습니다.
+// 부번 일부만 포함된 본번도 범위에 속한 것으로 봅니다. (ranges는 시작 순서로 정렬되어 있어야 함)
+func findGaps(ranges []coverageRange, side string) []postalcode.RangeGap {
+	var gaps []postalcode.RangeGap
+	if len(ranges) == 0 {
+		return gaps
+	}
+
+	// covered는 지금까지 본 범위가 포함하는 가장 큰 본번입니다.
+	covered := ranges[0].endMain
+	for _, r := range ranges[1:] {
+		if r.startMain > covered+2 {
+			gaps = append(gaps, postalcode.RangeGap{
+				Side:      side,
+				StartMain: covered + 2,
+				EndMain:   r.startMain - 2,
+			})
+		}
+		if r.endMain > covered {
+			covered = r.endMain
+		}
+	}
+	return gaps
+}
+
+// comparePosition은 건물번호 (본번, 부번) 위치를 비교합니다.
+func comparePosition(mainA, subA, mainB, subB int) int {
+	switch {
+	case mainA != mainB:
+		if mainA < mainB {
+			return -1
+		}
+		return 1
+	case subA < subB:
+		return -1
+	case subA > subB:
+		return 1
+	}
+	return 0
+}
+
+// intValue는 nil이면 0을 반환합니다.
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// abs는 절댓값을 반환합니다.
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -1,8 +1,10 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	postalcode "github.com/oursportsnation/korean-postalcode"
 	"github.com/oursportsnation/korean-postalcode/internal/repository"
@@ -29,9 +31,23 @@ type Service interface {
 	// ExtractZipPrefix는 우편번호에서 앞 3자리를 추출합니다.
 	ExtractZipPrefix(zipCode string) string
 
+	// Validate는 도로명주소 데이터를 검증합니다. (DB에 접근하지 않음)
+	// 실패 시 *postalcode.ValidationError를 반환합니다.
+	Validate(road *postalcode.PostalCodeRoad) error
+
+	// BatchDelete는 여러 우편번호 데이터를 ID로 일괄 삭제합니다.
+	BatchDelete(ids []uint) error
+
+	// ScanRoads는 검색 조건에 맞는 모든 데이터를 batchSize씩 순회합니다.
+	ScanRoads(params postalcode.SearchParams, batchSize int, fn func([]postalcode.PostalCodeRoad) error) error
+
 	// TruncateRoad는 도로명주소 테이블의 모든 데이터를 삭제합니다.
 	TruncateRoad() error
 
+	// AnalyzeRoadCoverage는 (시도, 시군구, 도로명, 지하여부) 그룹별로 서로 다른 우편번호에 배정된
+	// 겹치는 건물번호 범위와 어떤 범위에도 속하지 않는 빈 구간을 찾습니다.
+	AnalyzeRoadCoverage(params postalcode.CoverageParams) (*postalcode.CoverageReport, error)
+
 	// 지번주소 관련 메서드
 	// GetLandByZipCode는 우편번호로 지번주소를 조회합니다.
 	GetLandByZipCode(zipCode string) ([]postalcode.PostalCodeLand, error)
@@ -48,18 +64,127 @@ type Service interface {
 	// BatchUpsertLand는 여러 지번주소 데이터를 배치로 생성/업데이트합니다.
 	BatchUpsertLand(lands []postalcode.PostalCodeLand) error
 
+	// ValidateLand는 지번주소 데이터를 검증합니다. (DB에 접근하지 않음)
+	// 실패 시 *postalcode.ValidationError를 반환합니다.
+	ValidateLand(land *postalcode.PostalCodeLand) error
+
+	// BatchDeleteLand는 여러 지번주소 데이터를 ID로 일괄 삭제합니다.
+	BatchDeleteLand(ids []uint) error
+
+	// ScanLands는 검색 조건에 맞는 모든 지번주소 데이터를 batchSize씩 순회합니다.
+	ScanLands(params postalcode.SearchParamsLand, batchSize int, fn func([]postalcode.PostalCodeLand) error) error
+
 	// TruncateLand는 지번주소 테이블의 모든 데이터를 삭제합니다.
 	TruncateLand() error
+
+	// 건물 관련 메서드
+	// SearchBuildings는 건물명으로 건물을 검색하여 우편번호, 도로명주소, 지번주소를 반환합니다.
+	// 건물명은 필수이며 공백을 무시하고 부분 매칭합니다.
+	SearchBuildings(params postalcode.SearchParamsBuilding) ([]postalcode.BuildingAddress, int64, error)
+
+	// BatchUpsertBuilding은 여러 건물 데이터를 배치로 생성/업데이트합니다. (건물관리번호 기준)
+	BatchUpsertBuilding(buildings []postalcode.PostalCodeBuilding) error
+
+	// ValidateBuilding은 건물 데이터를 검증합니다. (DB에 접근하지 않음)
+	// 실패 시 *postalcode.ValidationError를 반환합니다.
+	ValidateBuilding(building *postalcode.PostalCodeBuilding) error
+
+	// TruncateBuilding은 건물 테이블의 모든 데이터를 삭제합니다.
+	TruncateBuilding() error
+
+	// 사서함 관련 메서드
+	// GetPOBoxByZipCode는 우편번호로 사서함을 조회합니다.
+	GetPOBoxByZipCode(zipCode string) ([]postalcode.PostalCodePOBox, error)
+
+	// SearchPOBoxes는 여러 조건으로 사서함을 검색합니다.
+	SearchPOBoxes(params postalcode.SearchParamsPOBox) ([]postalcode.PostalCodePOBox, int64, error)
+
+	// BatchUpsertPOBox는 여러 사서함 데이터를 배치로 생성/업데이트합니다.
+	BatchUpsertPOBox(poBoxes []postalcode.PostalCodePOBox) error
+
+	// ValidatePOBox는 사서함 데이터를 검증합니다. (DB에 접근하지 않음)
+	// 실패 시 *postalcode.ValidationError를 반환합니다.
+	ValidatePOBox(poBox *postalcode.PostalCodePOBox) error
+
+	// TruncatePOBox는 사서함 테이블의 모든 데이터를 삭제합니다.
+	TruncatePOBox() error
+
+	// 다량배달처 관련 메서드
+	// GetBulkDeliveryByZipCode는 우편번호로 다량배달처를 조회합니다.
+	GetBulkDeliveryByZipCode(zipCode string) ([]postalcode.PostalCodeBulkDelivery, error)
+
+	// SearchBulkDeliveries는 여러 조건으로 다량배달처를 검색합니다.
+	SearchBulkDeliveries(params postalcode.SearchParamsBulkDelivery) ([]postalcode.PostalCodeBulkDelivery, int64, error)
+
+	// BatchUpsertBulkDelivery는 여러 다량배달처 데이터를 배치로 생성/업데이트합니다.
+	BatchUpsertBulkDelivery(deliveries []postalcode.PostalCodeBulkDelivery) error
+
+	// ValidateBulkDelivery는 다량배달처 데이터를 검증합니다. (DB에 접근하지 않음)
+	// 실패 시 *postalcode.ValidationError를 반환합니다.
+	ValidateBulkDelivery(delivery *postalcode.PostalCodeBulkDelivery) error
+
+	// TruncateBulkDelivery는 다량배달처 테이블의 모든 데이터를 삭제합니다.
+	TruncateBulkDelivery() error
+
+	// CheckZipCode는 우편번호가 일반 배달구역(도로명/지번), 사서함, 다량배달처 중 어디에 있는지 확인합니다.
+	// 어디에도 없으면 Exists가 false인 결과를 반환합니다.
+	CheckZipCode(zipCode string) (*postalcode.ZipCodeCheck, error)
+
+	// Import 이력 관련 메서드
+	// StartImportRun은 import 실행 이력을 "running" 상태로 기록합니다.
+	StartImportRun(run *postalcode.ImportRun) error
+
+	// FinishImportRun은 import 실행 결과를 기록합니다.
+	// runErr가 nil이면 "success", 아니면 "failed"로 기록합니다.
+	FinishImportRun(run *postalcode.ImportRun, runErr error) error
+
+	// CheckpointImportRun은 커밋된 배치까지 처리한 유효 행 수를 기록합니다.
+	CheckpointImportRun(run *postalcode.ImportRun, rows int) error
+
+	// FindResumableImportRun은 dataType 테이블에 마지막으로 반영된 실행이 중단된 replace import이면 반환합니다.
+	// 재개할 실행이 없으면 postalcode.ErrNoResumableImport를 반환합니다.
+	FindResumableImportRun(dataType string) (*postalcode.ImportRun, error)
+
+	// ListImportRuns는 최근 import 실행 이력을 최신순으로 조회합니다.
+	ListImportRuns(limit int) ([]postalcode.ImportRun, error)
+
+	// SaveImportRejects는 거부된 라인을 격리 테이블(postal_code_import_rejects)에 저장합니다.
+	SaveImportRejects(rejects []postalcode.ImportReject) error
+
+	// ListImportRejects는 import 실행의 거부 라인을 라인 순서로 조회합니다.
+	ListImportRejects(runID uint) ([]postalcode.ImportReject, error)
+
+	// GetCurrentDataset은 현재 서비스 중인 데이터셋(마지막으로 성공한 import)을 조회합니다.
+	// 성공한 import가 없으면 postalcode.ErrNotFound를 반환합니다.
+	GetCurrentDataset() (*postalcode.ImportRun, error)
 }
 
 // service는 Service 인터페이스 구현입니다.
 type service struct {
-	repo repository.Repository
+	repo   repository.Repository
+	events postalcode.EventHandler
+}
+
+// Option은 Service 생성 옵션입니다.
+type Option func(*service)
+
+// WithEventHandler는 배치 저장 중 검증 실패로 거부된 행 등의 이벤트를 받을 핸들러를 지정합니다.
+// 지정하지 않으면 이벤트를 무시합니다. (postalcode.NopEventHandler)
+func WithEventHandler(handler postalcode.EventHandler) Option {
+	return func(s *service) {
+		if handler != nil {
+			s.events = handler
+		}
+	}
 }
 
 // New는 새로운 Service를 생성합니다.
-func New(repo repository.Repository) Service {
-	return &service{repo: repo}
+func New(repo repository.Repository, opts ...Option) Service {
+	s := &service{repo: repo, events: postalcode.NopEventHandler}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // GetByZipCode는 우편번호로 조회합니다.
@@ -149,13 +274,12 @@ func (s *service) Upsert(road *postalcode.PostalCodeRoad) error {
 // BatchUpsert는 여러 우편번호 데이터를 배치로 생성/업데이트합니다.
 func (s *service) BatchUpsert(roads []postalcode.PostalCodeRoad) error {
 	validRoads := make([]postalcode.PostalCodeRoad, 0, len(roads))
-	var validationErrors []string
 
 	for i := range roads {
 		// Validation
 		if err := s.validate(&roads[i]); err != nil {
 			// 개별 레코드 실패는 스킵하고 계속 진행
-			validationErrors = append(validationErrors, fmt.Sprintf("레코드 %d (우편번호: %s): %v", i, roads[i].ZipCode, err))
+			s.reject(postalcode.ImportDataTypeRoad, i, roads[i].ZipCode, err)
 			continue
 		}
 
@@ -167,19 +291,6 @@ func (s *service) BatchUpsert(roads []postalcode.PostalCodeRoad) error {
 		validRoads = append(validRoads, roads[i])
 	}
 
-	// Validation 에러가 있으면 출력
-	if len(validationErrors) > 0 {
-		fmt.Printf("⚠️  Validation 실패: %d개\n", len(validationErrors))
-		for i, errMsg := range validationErrors {
-			if i < 10 { // 최대 10개만 출력
-				fmt.Printf("  - %s\n", errMsg)
-			}
-		}
-		if len(validationErrors) > 10 {
-			fmt.Printf("  ... 외 %d개\n", len(validationErrors)-10)
-		}
-	}
-
 	if len(validRoads) == 0 {
 		return fmt.Errorf("no valid records in batch")
 	}
@@ -196,24 +307,39 @@ func (s *service) ExtractZipPrefix(zipCode string) string {
 	return ""
 }
 
+// Validate는 도로명주소 데이터를 검증합니다.
+func (s *service) Validate(road *postalcode.PostalCodeRoad) error {
+	return s.validate(road)
+}
+
 // validate는 우편번호 데이터를 검증합니다.
 func (s *service) validate(road *postalcode.PostalCodeRoad) error {
 	if road.ZipCode == "" {
-		return fmt.Errorf("zip code is required")
+		return postalcode.NewValidationError("zip_code", "zip code is required")
 	}
 	if len(road.ZipCode) != 5 {
-		return fmt.Errorf("zip code must be 5 digits")
+		return postalcode.NewValidationError("zip_code", "zip code must be 5 digits")
 	}
 	if road.SidoName == "" {
-		return fmt.Errorf("sido name is required")
+		return postalcode.NewValidationError("sido_name", "sido name is required")
 	}
 	// SigunguName은 선택적 (세종시 등 일부 지역은 시군구가 없음)
 	if road.RoadName == "" {
-		return fmt.Errorf("road name is required")
+		return postalcode.NewValidationError("road_name", "road name is required")
 	}
 	return nil
 }
 
+// BatchDelete는 여러 우편번호 데이터를 ID로 일괄 삭제합니다.
+func (s *service) BatchDelete(ids []uint) error {
+	return s.repo.BatchDelete(ids)
+}
+
+// ScanRoads는 검색 조건에 맞는 모든 데이터를 batchSize씩 순회합니다.
+func (s *service) ScanRoads(params postalcode.SearchParams, batchSize int, fn func([]postalcode.PostalCodeRoad) error) error {
+	return s.repo.ScanRoads(params, batchSize, fn)
+}
+
 // TruncateRoad는 도로명주소 테이블의 모든 데이터를 삭제합니다.
 func (s *service) TruncateRoad() error {
 	return s.repo.TruncateRoad()
@@ -312,13 +438,12 @@ func (s *service) UpsertLand(land *postalcode.PostalCodeLand) error {
 // BatchUpsertLand는 여러 지번주소 데이터를 배치로 생성/업데이트합니다.
 func (s *service) BatchUpsertLand(lands []postalcode.PostalCodeLand) error {
 	validLands := make([]postalcode.PostalCodeLand, 0, len(lands))
-	var validationErrors []string
 
 	for i := range lands {
 		// Validation
 		if err := s.validateLand(&lands[i]); err != nil {
 			// 개별 레코드 실패는 스킵하고 계속 진행
-			validationErrors = append(validationErrors, fmt.Sprintf("레코드 %d (우편번호: %s): %v", i, lands[i].ZipCode, err))
+			s.reject(postalcode.ImportDataTypeLand, i, lands[i].ZipCode, err)
 			continue
 		}
 
@@ -330,19 +455,6 @@ func (s *service) BatchUpsertLand(lands []postalcode.PostalCodeLand) error {
 		validLands = append(validLands, lands[i])
 	}
 
-	// Validation 에러가 있으면 출력
-	if len(validationErrors) > 0 {
-		fmt.Printf("⚠️  Validation 실패: %d개\n", len(validationErrors))
-		for i, errMsg := range validationErrors {
-			if i < 10 { // 최대 10개만 출력
-				fmt.Printf("  - %s\n", errMsg)
-			}
-		}
-		if len(validationErrors) > 10 {
-			fmt.Printf("  ... 외 %d개\n", len(validationErrors)-10)
-		}
-	}
-
 	if len(validLands) == 0 {
 		return fmt.Errorf("no valid records in batch")
 	}
@@ -350,25 +462,419 @@ func (s *service) BatchUpsertLand(lands []postalcode.PostalCodeLand) error {
 	return s.repo.BatchCreateLand(validLands)
 }
 
+// reject는 배치에서 검증에 실패하여 건너뛴 레코드를 RowRejected 이벤트로 알립니다.
+func (s *service) reject(dataType string, index int, zipCode string, err error) {
+	event := postalcode.Event{
+		Type:     postalcode.EventRowRejected,
+		DataType: dataType,
+		Message:  fmt.Sprintf("레코드 %d (우편번호: %s): %v", index, zipCode, err),
+		Err:      err,
+	}
+	var verr *postalcode.ValidationError
+	if errors.As(err, &verr) {
+		event.Field = verr.Field
+	}
+	s.events.HandleEvent(event)
+}
+
+// ValidateLand는 지번주소 데이터를 검증합니다.
+func (s *service) ValidateLand(land *postalcode.PostalCodeLand) error {
+	return s.validateLand(land)
+}
+
 // validateLand는 지번주소 데이터를 검증합니다.
 func (s *service) validateLand(land *postalcode.PostalCodeLand) error {
 	if land.ZipCode == "" {
-		return fmt.Errorf("zip code is required")
+		return postalcode.NewValidationError("zip_code", "zip code is required")
 	}
 	if len(land.ZipCode) != 5 {
-		return fmt.Errorf("zip code must be 5 digits")
+		return postalcode.NewValidationError("zip_code", "zip code must be 5 digits")
 	}
 	if land.SidoName == "" {
-		return fmt.Errorf("sido name is required")
+		return postalcode.NewValidationError("sido_name", "sido name is required")
 	}
 	// SigunguName은 선택적 (세종시 등 일부 지역은 시군구가 없음)
 	if land.EupmyeondongName == "" {
-		return fmt.Errorf("eupmyeondong name is required")
+		return postalcode.NewValidationError("eupmyeondong_name", "eupmyeondong name is required")
 	}
 	return nil
 }
 
+// BatchDeleteLand는 여러 지번주소 데이터를 ID로 일괄 삭제합니다.
+func (s *service) BatchDeleteLand(ids []uint) error {
+	return s.repo.BatchDeleteLand(ids)
+}
+
+// ScanLands는 검색 조건에 맞는 모든 지번주소 데이터를 batchSize씩 순회합니다.
+func (s *service) ScanLands(params postalcode.SearchParamsLand, batchSize int, fn func([]postalcode.PostalCodeLand) error) error {
+	return s.repo.ScanLands(params, batchSize, fn)
+}
+
 // TruncateLand는 지번주소 테이블의 모든 데이터를 삭제합니다.
 func (s *service) TruncateLand() error {
 	return s.repo.TruncateLand()
 }
+
+// ============================================================
+// 건물 관련 메서드
+// ============================================================
+
+// SearchBuildings는 건물명으로 건물을 검색하여 우편번호, 도로명주소, 지번주소를 반환합니다.
+func (s *service) SearchBuildings(params postalcode.SearchParamsBuilding) ([]postalcode.BuildingAddress, int64, error) {
+	if strings.TrimSpace(params.BuildingName) == "" {
+		return nil, 0, fmt.Errorf("building name is required")
+	}
+
+	// 기본값 설정
+	if params.Limit <= 0 || params.Limit > 100 {
+		params.Limit = 10
+	}
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	buildings, total, err := s.repo.SearchBuildings(params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]postalcode.BuildingAddress, len(buildings))
+	for i := range buildings {
+		results[i] = postalcode.NewBuildingAddress(buildings[i])
+	}
+	return results, total, nil
+}
+
+// BatchUpsertBuilding은 여러 건물 데이터를 배치로 생성/업데이트합니다.
+func (s *service) BatchUpsertBuilding(buildings []postalcode.PostalCodeBuilding) error {
+	validBuildings := make([]postalcode.PostalCodeBuilding, 0, len(buildings))
+
+	for i := range buildings {
+		// Validation
+		if err := s.validateBuilding(&buildings[i]); err != nil {
+			// 개별 레코드 실패는 스킵하고 계속 진행
+			s.reject(postalcode.ImportDataTypeBuilding, i, buildings[i].ZipCode, err)
+			continue
+		}
+
+		// ZipPrefix 자동 설정
+		if buildings[i].ZipPrefix == "" {
+			buildings[i].ZipPrefix = s.ExtractZipPrefix(buildings[i].ZipCode)
+		}
+
+		validBuildings = append(validBuildings, buildings[i])
+	}
+
+	if len(validBuildings) == 0 {
+		return fmt.Errorf("no valid records in batch")
+	}
+
+	return s.repo.BatchCreateBuilding(validBuildings)
+}
+
+// ValidateBuilding은 건물 데이터를 검증합니다.
+func (s *service) ValidateBuilding(building *postalcode.PostalCodeBuilding) error {
+	return s.validateBuilding(building)
+}
+
+// validateBuilding은 건물 데이터를 검증합니다.
+func (s *service) validateBuilding(building *postalcode.PostalCodeBuilding) error {
+	if building.BuildingManagementNumber == "" {
+		return postalcode.NewValidationError("building_management_number", "building management number is required")
+	}
+	if building.ZipCode == "" {
+		return postalcode.NewValidationError("zip_code", "zip code is required")
+	}
+	if len(building.ZipCode) != 5 {
+		return postalcode.NewValidationError("zip_code", "zip code must be 5 digits")
+	}
+	if building.SidoName == "" {
+		return postalcode.NewValidationError("sido_name", "sido name is required")
+	}
+	if building.RoadName == "" {
+		return postalcode.NewValidationError("road_name", "road name is required")
+	}
+	return nil
+}
+
+// TruncateBuilding은 건물 테이블의 모든 데이터를 삭제합니다.
+func (s *service) TruncateBuilding() error {
+	return s.repo.TruncateBuilding()
+}
+
+// ============================================================
+// 사서함 관련 메서드
+// ============================================================
+
+// GetPOBoxByZipCode는 우편번호로 사서함을 조회합니다.
+func (s *service) GetPOBoxByZipCode(zipCode string) ([]postalcode.PostalCodePOBox, error) {
+	if zipCode == "" {
+		return nil, fmt.Errorf("zip code is required")
+	}
+	if len(zipCode) != 5 {
+		return nil, fmt.Errorf("zip code must be 5 digits")
+	}
+	return s.repo.FindPOBoxByZipCode(zipCode)
+}
+
+// SearchPOBoxes는 여러 조건으로 사서함을 검색합니다.
+func (s *service) SearchPOBoxes(params postalcode.SearchParamsPOBox) ([]postalcode.PostalCodePOBox, int64, error) {
+	// 기본값 설정
+	if params.Limit <= 0 || params.Limit > 100 {
+		params.Limit = 10
+	}
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	return s.repo.SearchPOBoxes(params)
+}
+
+// BatchUpsertPOBox는 여러 사서함 데이터를 배치로 생성/업데이트합니다.
+func (s *service) BatchUpsertPOBox(poBoxes []postalcode.PostalCodePOBox) error {
+	validPOBoxes := make([]postalcode.PostalCodePOBox, 0, len(poBoxes))
+
+	for i := range poBoxes {
+		// Validation
+		if err := s.validatePOBox(&poBoxes[i]); err != nil {
+			// 개별 레코드 실패는 스킵하고 계속 진행
+			s.reject(postalcode.ImportDataTypePOBox, i, poBoxes[i].ZipCode, err)
+			continue
+		}
+
+		// ZipPrefix 자동 설정
+		if poBoxes[i].ZipPrefix == "" {
+			poBoxes[i].ZipPrefix = s.ExtractZipPrefix(poBoxes[i].ZipCode)
+		}
+
+		validPOBoxes = append(validPOBoxes, poBoxes[i])
+	}
+
+	if len(validPOBoxes) == 0 {
+		return fmt.Errorf("no valid records in batch")
+	}
+
+	return s.repo.BatchCreatePOBox(validPOBoxes)
+}
+
+// ValidatePOBox는 사서함 데이터를 검증합니다.
+func (s *service) ValidatePOBox(poBox *postalcode.PostalCodePOBox) error {
+	return s.validatePOBox(poBox)
+}
+
+// validatePOBox는 사서함 데이터를 검증합니다.
+func (s *service) validatePOBox(poBox *postalcode.PostalCodePOBox) error {
+	if poBox.ZipCode == "" {
+		return postalcode.NewValidationError("zip_code", "zip code is required")
+	}
+	if len(poBox.ZipCode) != 5 {
+		return postalcode.NewValidationError("zip_code", "zip code must be 5 digits")
+	}
+	if poBox.SidoName == "" {
+		return postalcode.NewValidationError("sido_name", "sido name is required")
+	}
+	if poBox.POBoxName == "" {
+		return postalcode.NewValidationError("po_box_name", "po box name is required")
+	}
+	return nil
+}
+
+// TruncatePOBox는 사서함 테이블의 모든 데이터를 삭제합니다.
+func (s *service) TruncatePOBox() error {
+	return s.repo.TruncatePOBox()
+}
+
+// ============================================================
+// 다량배달처 관련 메서드
+// ============================================================
+
+// GetBulkDeliveryByZipCode는 우편번호로 다량배달처를 조회합니다.
+func (s *service) GetBulkDeliveryByZipCode(zipCode string) ([]postalcode.PostalCodeBulkDelivery, error) {
+	if zipCode == "" {
+		return nil, fmt.Errorf("zip code is required")
+	}
+	if len(zipCode) != 5 {
+		return nil, fmt.Errorf("zip code must be 5 digits")
+	}
+	return s.repo.FindBulkDeliveryByZipCode(zipCode)
+}
+
+// SearchBulkDeliveries는 여러 조건으로 다량배달처를 검색합니다.
+func (s *service) SearchBulkDeliveries(params postalcode.SearchParamsBulkDelivery) ([]postalcode.PostalCodeBulkDelivery, int64, error) {
+	// 기본값 설정
+	if params.Limit <= 0 || params.Limit > 100 {
+		params.Limit = 10
+	}
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	return s.repo.SearchBulkDeliveries(params)
+}
+
+// BatchUpsertBulkDelivery는 여러 다량배달처 데이터를 배치로 생성/업데이트합니다.
+func (s *service) BatchUpsertBulkDelivery(deliveries []postalcode.PostalCodeBulkDelivery) error {
+	validDeliveries := make([]postalcode.PostalCodeBulkDelivery, 0, len(deliveries))
+
+	for i := range deliveries {
+		// Validation
+		if err := s.validateBulkDelivery(&deliveries[i]); err != nil {
+			// 개별 레코드 실패는 스킵하고 계속 진행
+			s.reject(postalcode.ImportDataTypeBulk, i, deliveries[i].ZipCode, err)
+			continue
+		}
+
+		// ZipPrefix 자동 설정
+		if deliveries[i].ZipPrefix == "" {
+			deliveries[i].ZipPrefix = s.ExtractZipPrefix(deliveries[i].ZipCode)
+		}
+
+		validDeliveries = append(validDeliveries, deliveries[i])
+	}
+
+	if len(validDeliveries) == 0 {
+		return fmt.Errorf("no valid records in batch")
+	}
+
+	return s.repo.BatchCreateBulkDelivery(validDeliveries)
+}
+
+// ValidateBulkDelivery는 다량배달처 데이터를 검증합니다.
+func (s *service) ValidateBulkDelivery(delivery *postalcode.PostalCodeBulkDelivery) error {
+	return s.validateBulkDelivery(delivery)
+}
+
+// validateBulkDelivery는 다량배달처 데이터를 검증합니다.
+func (s *service) validateBulkDelivery(delivery *postalcode.PostalCodeBulkDelivery) error {
+	if delivery.ZipCode == "" {
+		return postalcode.NewValidationError("zip_code", "zip code is required")
+	}
+	if len(delivery.ZipCode) != 5 {
+		return postalcode.NewValidationError("zip_code", "zip code must be 5 digits")
+	}
+	if delivery.SidoName == "" {
+		return postalcode.NewValidationError("sido_name", "sido name is required")
+	}
+	if delivery.DeliveryName == "" {
+		return postalcode.NewValidationError("delivery_name", "delivery name is required")
+	}
+	return nil
+}
+
+// TruncateBulkDelivery는 다량배달처 테이블의 모든 데이터를 삭제합니다.
+func (s *service) TruncateBulkDelivery() error {
+	return s.repo.TruncateBulkDelivery()
+}
+
+// CheckZipCode는 우편번호가 일반 배달구역(도로명/지번), 사서함, 다량배달처 중 어디에 있는지 확인합니다.
+// 사서함/다량배달처 전용 우편번호는 도로명/지번 테이블에 없으므로 순서대로 확인합니다.
+func (s *service) CheckZipCode(zipCode string) (*postalcode.ZipCodeCheck, error) {
+	if zipCode == "" {
+		return nil, fmt.Errorf("zip code is required")
+	}
+	if len(zipCode) != 5 {
+		return nil, fmt.Errorf("zip code must be 5 digits")
+	}
+
+	check := &postalcode.ZipCodeCheck{ZipCode: zipCode}
+
+	exists, err := s.repo.ExistsAddressZipCode(zipCode)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		check.Exists, check.Kind = true, postalcode.ZipCodeKindAddress
+		return check, nil
+	}
+
+	poBoxes, err := s.repo.FindPOBoxByZipCode(zipCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(poBoxes) > 0 {
+		check.Exists, check.Kind, check.Name = true, postalcode.ZipCodeKindPOBox, poBoxes[0].POBoxName
+		return check, nil
+	}
+
+	deliveries, err := s.repo.FindBulkDeliveryByZipCode(zipCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) > 0 {
+		check.Exists, check.Kind, check.Name = true, postalcode.ZipCodeKindBulk, deliveries[0].DeliveryName
+	}
+	return check, nil
+}
+
+// ============================================================
+// Import 이력 관련 메서드
+// ============================================================
+
+// StartImportRun은 import 실행 이력을 "running" 상태로 기록합니다.
+func (s *service) StartImportRun(run *postalcode.ImportRun) error {
+	if run.StartedAt.IsZero() {
+		run.StartedAt = time.Now()
+	}
+	run.Outcome = postalcode.ImportOutcomeRunning
+	return s.repo.CreateImportRun(run)
+}
+
+// FinishImportRun은 import 실행 결과를 기록합니다.
+func (s *service) FinishImportRun(run *postalcode.ImportRun, runErr error) error {
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	if runErr != nil {
+		run.Outcome = postalcode.ImportOutcomeFailed
+		run.ErrorMessage = runErr.Error()
+	} else {
+		run.Outcome = postalcode.ImportOutcomeSuccess
+	}
+	return s.repo.UpdateImportRun(run)
+}
+
+// CheckpointImportRun은 커밋된 배치까지 처리한 유효 행 수를 기록합니다.
+func (s *service) CheckpointImportRun(run *postalcode.ImportRun, rows int) error {
+	run.CheckpointRows = rows
+	return s.repo.UpdateImportRun(run)
+}
+
+// FindResumableImportRun은 재개할 수 있는 중단된 import 실행을 조회합니다.
+//
+// 도로명/지번 테이블은 데이터셋 import로도 교체되므로 같은 타입과 "dataset" 실행을 함께 확인하며,
+// 중단된 실행 이후 다른 import가 테이블에 반영되었다면 재개할 수 없습니다.
+func (s *service) FindResumableImportRun(dataType string) (*postalcode.ImportRun, error) {
+	run, err := s.repo.FindLastAppliedImportRun(dataType, postalcode.ImportDataTypeDataset)
+	if errors.Is(err, postalcode.ErrNotFound) {
+		return nil, postalcode.ErrNoResumableImport
+	}
+	if err != nil {
+		return nil, err
+	}
+	if run.DataType != dataType || !run.Resumable() {
+		return nil, postalcode.ErrNoResumableImport
+	}
+	return run, nil
+}
+
+// ListImportRuns는 최근 import 실행 이력을 최신순으로 조회합니다.
+func (s *service) ListImportRuns(limit int) ([]postalcode.ImportRun, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return s.repo.ListImportRuns(limit)
+}
+
+// SaveImportRejects는 거부된 라인을 격리 테이블에 저장합니다.
+func (s *service) SaveImportRejects(rejects []postalcode.ImportReject) error {
+	return s.repo.BatchCreateImportRejects(rejects)
+}
+
+// ListImportRejects는 import 실행의 거부 라인을 라인 순서로 조회합니다.
+func (s *service) ListImportRejects(runID uint) ([]postalcode.ImportReject, error) {
+	return s.repo.ListImportRejects(runID)
+}
+
+// GetCurrentDataset은 현재 서비스 중인 데이터셋을 조회합니다.
+func (s *service) GetCurrentDataset() (*postalcode.ImportRun, error) {
+	return s.repo.FindLatestImportRun()
+}
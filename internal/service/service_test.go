@@ -16,7 +16,8 @@ func setupTestService(t *testing.T) Service {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{})
+	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.PostalCodeBuilding{},
+		&postalcode.PostalCodePOBox{}, &postalcode.PostalCodeBulkDelivery{}, &postalcode.ImportRun{})
 	require.NoError(t, err)
 
 	repo := repository.New(db)
@@ -267,6 +268,30 @@ func TestService_BatchUpsert_PartialFailure(t *testing.T) {
 	assert.Equal(t, int64(2), total) // Only 2 valid records
 }
 
+func TestService_BatchUpsert_RowRejectedEvent(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&postalcode.PostalCodeRoad{}))
+
+	var events []postalcode.Event
+	svc := New(repository.New(db), WithEventHandler(postalcode.EventHandlerFunc(func(event postalcode.Event) {
+		events = append(events, event)
+	})))
+
+	roads := []postalcode.PostalCodeRoad{
+		{ZipCode: "01000", SidoName: "서울특별시", SigunguName: "강북구", RoadName: "삼양로1"},
+		{ZipCode: "0100", SidoName: "서울특별시", SigunguName: "강북구", RoadName: "삼양로2"},
+	}
+	require.NoError(t, svc.BatchUpsert(roads))
+
+	require.Len(t, events, 1)
+	assert.Equal(t, postalcode.EventRowRejected, events[0].Type)
+	assert.Equal(t, "road", events[0].DataType)
+	assert.Equal(t, "zip_code", events[0].Field)
+	assert.Contains(t, events[0].Message, "레코드 1 (우편번호: 0100)")
+	assert.Error(t, events[0].Err)
+}
+
 func TestService_ExtractZipPrefix(t *testing.T) {
 	svc := setupTestService(t)
 
@@ -289,6 +314,98 @@ func TestService_ExtractZipPrefix(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Road Coverage Tests
+// ============================================================
+
+func coverageRoad(zipCode string, rangeType int8, start, end int) postalcode.PostalCodeRoad {
+	return postalcode.PostalCodeRoad{
+		ZipCode:           zipCode,
+		ZipPrefix:         zipCode[:3],
+		SidoName:          "서울특별시",
+		SigunguName:       "강북구",
+		RoadName:          "삼양로",
+		StartBuildingMain: start,
+		EndBuildingMain:   &end,
+		RangeType:         rangeType,
+	}
+}
+
+func TestService_AnalyzeRoadCoverage(t *testing.T) {
+	svc := setupTestService(t)
+
+	single := coverageRoad("01001", postalcode.RangeTypeSingle, 153, 153)
+	single.EndBuildingMain = nil
+	underground := coverageRoad("01009", postalcode.RangeTypeOdd, 1, 9)
+	underground.IsUnderground = true
+	other := coverageRoad("01005", postalcode.RangeTypeOdd, 1, 99)
+	other.RoadName = "삼양로177길"
+
+	roads := []postalcode.PostalCodeRoad{
+		coverageRoad("01000", postalcode.RangeTypeOdd, 1, 99),     // A
+		coverageRoad("01000", postalcode.RangeTypeOdd, 3, 9),      // 같은 우편번호끼리는 겹쳐도 무시
+		coverageRoad("01001", postalcode.RangeTypeOdd, 51, 151),   // A와 51~99 겹침
+		coverageRoad("01000", postalcode.RangeTypeEven, 2, 100),   //
+		coverageRoad("01002", postalcode.RangeTypeEven, 120, 200), // 102~118 빈 구간
+		single,
+		underground,
+		other,
+	}
+	for i := range roads {
+		require.NoError(t, svc.Upsert(&roads[i]))
+	}
+
+	report, err := svc.AnalyzeRoadCoverage(postalcode.CoverageParams{RoadName: "삼양로"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.RoadCount) // 지상/지하 그룹, 삼양로177길 제외
+	assert.Equal(t, 7, report.RangeCount)
+	assert.Equal(t, 1, report.OverlapCount)
+	assert.Equal(t, 1, report.GapCount)
+
+	road := report.Roads[0]
+	assert.False(t, road.IsUnderground)
+	assert.Equal(t, []string{"01000", "01001", "01002"}, road.ZipCodes)
+	require.Len(t, road.Overlaps, 1)
+	assert.Equal(t, postalcode.RangeOverlap{
+		Side:      postalcode.CoverageSideOdd,
+		StartMain: 51,
+		EndMain:   99,
+		ZipCodes:  [2]string{"01000", "01001"},
+		RowIDs:    [2]uint{roads[0].ID, roads[2].ID},
+	}, road.Overlaps[0])
+	assert.Equal(t, []postalcode.RangeGap{{Side: postalcode.CoverageSideEven, StartMain: 102, EndMain: 118}}, road.Gaps)
+	assert.True(t, road.HasIssues())
+
+	assert.True(t, report.Roads[1].IsUnderground)
+	assert.False(t, report.Roads[1].HasIssues())
+
+	// 전체 분석
+	report, err = svc.AnalyzeRoadCoverage(postalcode.CoverageParams{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.RoadCount)
+	assert.Equal(t, 8, report.RangeCount)
+}
+
+func TestSideRanges(t *testing.T) {
+	road := coverageRoad("01000", postalcode.RangeTypeAll, 2, 10)
+
+	odd := sideRanges([]postalcode.PostalCodeRoad{road}, postalcode.CoverageSideOdd)
+	require.Len(t, odd, 1)
+	assert.Equal(t, 3, odd[0].startMain)
+	assert.Equal(t, 9, odd[0].endMain)
+
+	even := sideRanges([]postalcode.PostalCodeRoad{road}, postalcode.CoverageSideEven)
+	require.Len(t, even, 1)
+	assert.Equal(t, 2, even[0].startMain)
+	assert.Equal(t, 10, even[0].endMain)
+
+	// 홀수 범위는 짝수 쪽에 포함되지 않고, 범위종류가 잘못된 행은 제외
+	invalid := coverageRoad("01000", 9, 1, 9)
+	oddOnly := coverageRoad("01000", postalcode.RangeTypeOdd, 1, 9)
+	assert.Empty(t, sideRanges([]postalcode.PostalCodeRoad{invalid, oddOnly}, postalcode.CoverageSideEven))
+}
+
 // ============================================================
 // Land Address Service Tests
 // ============================================================
@@ -378,3 +495,205 @@ func TestService_UpsertLand_Validation(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================
+// Import History Service Tests
+// ============================================================
+
+// ============================================================
+// Building Tests
+// ============================================================
+
+func TestService_SearchBuildings(t *testing.T) {
+	svc := setupTestService(t)
+
+	sub := 43
+	buildings := []postalcode.PostalCodeBuilding{
+		{BuildingManagementNumber: "1165010700100200043000001", ZipCode: "06506", SidoName: "서울특별시", SigunguName: "서초구", EupmyeondongName: "반포동",
+			JibunMain: 20, JibunSub: &sub, RoadName: "반포대로", BuildingMain: 275, BuildingName: "래미안퍼스티지", IsApartment: true},
+		{BuildingManagementNumber: "4182025022100320000000001", ZipCode: "12461", SidoName: "경기도", SigunguName: "가평군", EupmyeondongName: "가평읍", RiName: "읍내리",
+			IsMountain: true, JibunMain: 32, RoadName: "가화로", IsUnderground: true, BuildingMain: 12, BuildingName: "가평역사"},
+		{BuildingManagementNumber: "", ZipCode: "06506", SidoName: "서울특별시", RoadName: "반포대로", BuildingMain: 1}, // 건물관리번호 누락
+	}
+	require.NoError(t, svc.BatchUpsertBuilding(buildings))
+
+	results, total, err := svc.SearchBuildings(postalcode.SearchParamsBuilding{BuildingName: "래미안 퍼스티지"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, results, 1)
+	assert.Equal(t, "06506", results[0].ZipCode)
+	assert.Equal(t, "065", results[0].ZipPrefix)
+	assert.Equal(t, "서울특별시 서초구 반포대로 275 (반포동, 래미안퍼스티지)", results[0].RoadAddress)
+	assert.Equal(t, "서울특별시 서초구 반포동 20-43 래미안퍼스티지", results[0].JibunAddress)
+
+	// 읍/면 지역은 도로명 앞에 읍면명, 지하 건물과 산 지번 표기
+	results, _, err = svc.SearchBuildings(postalcode.SearchParamsBuilding{BuildingName: "가평역"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "경기도 가평군 가평읍 가화로 지하 12", results[0].RoadAddress)
+	assert.Equal(t, "경기도 가평군 가평읍 읍내리 산32 가평역사", results[0].JibunAddress)
+
+	// 건물명 필수
+	_, _, err = svc.SearchBuildings(postalcode.SearchParamsBuilding{SidoName: "서울"})
+	assert.Error(t, err)
+}
+
+func TestService_ValidateBuilding(t *testing.T) {
+	svc := setupTestService(t)
+
+	tests := []struct {
+		name     string
+		building postalcode.PostalCodeBuilding
+		field    string
+	}{
+		{"missing management number", postalcode.PostalCodeBuilding{ZipCode: "06506", SidoName: "서울특별시", RoadName: "반포대로"}, "building_management_number"},
+		{"invalid zip code", postalcode.PostalCodeBuilding{BuildingManagementNumber: "1", ZipCode: "065", SidoName: "서울특별시", RoadName: "반포대로"}, "zip_code"},
+		{"missing sido", postalcode.PostalCodeBuilding{BuildingManagementNumber: "1", ZipCode: "06506", RoadName: "반포대로"}, "sido_name"},
+		{"missing road name", postalcode.PostalCodeBuilding{BuildingManagementNumber: "1", ZipCode: "06506", SidoName: "서울특별시"}, "road_name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := svc.ValidateBuilding(&tt.building)
+			var verr *postalcode.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.field, verr.Field)
+		})
+	}
+}
+
+func TestService_CheckZipCode(t *testing.T) {
+	svc := setupTestService(t)
+
+	require.NoError(t, svc.Upsert(&postalcode.PostalCodeRoad{ZipCode: "01000", SidoName: "서울특별시", SigunguName: "강북구", RoadName: "삼양로177길", StartBuildingMain: 93}))
+	require.NoError(t, svc.BatchUpsertPOBox([]postalcode.PostalCodePOBox{
+		{ZipCode: "03154", SidoName: "서울특별시", SigunguName: "종로구", POBoxName: "광화문우체국사서함", StartNumberMain: 1},
+	}))
+	require.NoError(t, svc.BatchUpsertBulkDelivery([]postalcode.PostalCodeBulkDelivery{
+		{ZipCode: "03171", SidoName: "서울특별시", SigunguName: "종로구", RoadName: "세종대로", BuildingMain: 209, DeliveryName: "정부서울청사"},
+	}))
+
+	tests := []struct {
+		zipCode string
+		exists  bool
+		kind    string
+		name    string
+	}{
+		{"01000", true, postalcode.ZipCodeKindAddress, ""},
+		{"03154", true, postalcode.ZipCodeKindPOBox, "광화문우체국사서함"},
+		{"03171", true, postalcode.ZipCodeKindBulk, "정부서울청사"},
+		{"99999", false, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zipCode, func(t *testing.T) {
+			check, err := svc.CheckZipCode(tt.zipCode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.zipCode, check.ZipCode)
+			assert.Equal(t, tt.exists, check.Exists)
+			assert.Equal(t, tt.kind, check.Kind)
+			assert.Equal(t, tt.name, check.Name)
+		})
+	}
+
+	_, err := svc.CheckZipCode("031")
+	assert.Error(t, err)
+}
+
+func TestService_POBoxAndBulkDelivery_Lookup(t *testing.T) {
+	svc := setupTestService(t)
+
+	// 검증 실패 레코드는 건너뛰고 ZipPrefix 자동 설정
+	require.NoError(t, svc.BatchUpsertPOBox([]postalcode.PostalCodePOBox{
+		{ZipCode: "03154", SidoName: "서울특별시", POBoxName: "광화문우체국사서함", StartNumberMain: 1},
+		{ZipCode: "03154", SidoName: "서울특별시", StartNumberMain: 101},
+	}))
+	poBoxes, err := svc.GetPOBoxByZipCode("03154")
+	require.NoError(t, err)
+	require.Len(t, poBoxes, 1)
+	assert.Equal(t, "031", poBoxes[0].ZipPrefix)
+
+	require.NoError(t, svc.BatchUpsertBulkDelivery([]postalcode.PostalCodeBulkDelivery{
+		{ZipCode: "03171", SidoName: "서울특별시", DeliveryName: "정부서울청사"},
+	}))
+	deliveries, total, err := svc.SearchBulkDeliveries(postalcode.SearchParamsBulkDelivery{DeliveryName: "서울청사"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, "03171", deliveries[0].ZipCode)
+
+	var verr *postalcode.ValidationError
+	require.ErrorAs(t, svc.ValidatePOBox(&postalcode.PostalCodePOBox{ZipCode: "03154", SidoName: "서울특별시"}), &verr)
+	assert.Equal(t, "po_box_name", verr.Field)
+	require.ErrorAs(t, svc.ValidateBulkDelivery(&postalcode.PostalCodeBulkDelivery{ZipCode: "03171", SidoName: "서울특별시"}), &verr)
+	assert.Equal(t, "delivery_name", verr.Field)
+}
+
+func TestService_ImportRun_Lifecycle(t *testing.T) {
+	svc := setupTestService(t)
+
+	_, err := svc.GetCurrentDataset()
+	assert.ErrorIs(t, err, postalcode.ErrNotFound)
+
+	run := &postalcode.ImportRun{DataType: "road", Mode: "replace", FileName: "road.txt", FileSHA256: "9f86d081884c7d65", DatasetDate: "2024-05-01"}
+	require.NoError(t, svc.StartImportRun(run))
+	assert.Equal(t, postalcode.ImportOutcomeRunning, run.Outcome)
+	assert.False(t, run.StartedAt.IsZero())
+	assert.Nil(t, run.FinishedAt)
+
+	require.NoError(t, svc.FinishImportRun(run, nil))
+	assert.Equal(t, postalcode.ImportOutcomeSuccess, run.Outcome)
+	assert.NotNil(t, run.FinishedAt)
+
+	current, err := svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, run.ID, current.ID)
+	assert.Equal(t, "2024-05-01+9f86d081", current.DatasetVersion())
+
+	// 실패한 import는 현재 데이터셋을 바꾸지 않음
+	failed := &postalcode.ImportRun{DataType: "road", Mode: "replace", FileName: "bad.txt"}
+	require.NoError(t, svc.StartImportRun(failed))
+	require.NoError(t, svc.FinishImportRun(failed, fmt.Errorf("file parsing failed")))
+	assert.Equal(t, postalcode.ImportOutcomeFailed, failed.Outcome)
+	assert.Equal(t, "file parsing failed", failed.ErrorMessage)
+
+	current, err = svc.GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, run.ID, current.ID)
+
+	runs, err := svc.ListImportRuns(0)
+	require.NoError(t, err)
+	assert.Len(t, runs, 2)
+	assert.Equal(t, failed.ID, runs[0].ID)
+}
+
+func TestService_FindResumableImportRun(t *testing.T) {
+	svc := setupTestService(t)
+
+	_, err := svc.FindResumableImportRun("road")
+	assert.ErrorIs(t, err, postalcode.ErrNoResumableImport)
+
+	// 첫 배치 커밋 후 중단된 도로명주소 import
+	interrupted := &postalcode.ImportRun{DataType: "road", Mode: postalcode.ImportModeReplace, FileSHA256: "abc"}
+	require.NoError(t, svc.StartImportRun(interrupted))
+	require.NoError(t, svc.CheckpointImportRun(interrupted, 1000))
+
+	run, err := svc.FindResumableImportRun("road")
+	require.NoError(t, err)
+	assert.Equal(t, interrupted.ID, run.ID)
+	assert.Equal(t, 1000, run.CheckpointRows)
+
+	// 지번주소 import는 도로명주소 재개에 영향 없음
+	land := &postalcode.ImportRun{DataType: "land", Mode: postalcode.ImportModeReplace}
+	require.NoError(t, svc.StartImportRun(land))
+	require.NoError(t, svc.FinishImportRun(land, nil))
+	_, err = svc.FindResumableImportRun("road")
+	assert.NoError(t, err)
+
+	// 이후 데이터셋 import가 테이블을 교체했다면 재개 불가
+	dataset := &postalcode.ImportRun{DataType: postalcode.ImportDataTypeDataset, Mode: postalcode.ImportModeReplace}
+	require.NoError(t, svc.StartImportRun(dataset))
+	require.NoError(t, svc.FinishImportRun(dataset, nil))
+	_, err = svc.FindResumableImportRun("road")
+	assert.ErrorIs(t, err, postalcode.ErrNoResumableImport)
+}
@@ -0,0 +1,107 @@
+// Package snapshot은 도로명주소/지번주소 테이블을 읽기 전용 조회용 바이너리 파일로 저장하고,
+// 파일을 메모리 매핑하여 파싱 없이 바로 조회하는 Repository를 제공합니다.
+//
+// 파일 구조 (모든 정수는 little endian):
+//
+//	헤더         magic "KPCSNAP\x00" | version u32 | checksum u32 | section 수 u32 | reserved u32
+//	섹션 테이블  (kind u32 | reserved u32 | offset u64 | length u64) × section 수
+//	meta         JSON (생성 시각, 데이터셋, 행 수)
+//	strings      문자열 수 u32 | 오프셋 u32 × (문자열 수 + 1) | UTF-8 바이트  (0번은 빈 문자열)
+//	roads        도로명주소 레코드 (80바이트 고정 길이, ID 순)
+//	road_zip     도로명주소 레코드 번호 u32 (우편번호, ID 순 정렬)
+//	lands        지번주소 레코드 (80바이트 고정 길이, ID 순)
+//	land_zip     지번주소 레코드 번호 u32 (우편번호, ID 순 정렬)
+//
+// 지역명/도로명 등 문자열 컬럼은 strings 섹션의 번호로 저장하여 중복을 제거하고,
+// checksum은 헤더 이후 전체 내용의 CRC-32C입니다.
+package snapshot
+
+import (
+	"hash/crc32"
+	"math"
+)
+
+// 파일 형식 상수
+const (
+	// FormatVersion은 현재 스냅샷 파일 형식 버전입니다.
+	FormatVersion = 1
+
+	magic = "KPCSNAP\x00"
+
+	headerSize       = 24
+	sectionEntrySize = 24
+	sectionAlign     = 8
+
+	// recordSize는 도로명주소/지번주소 레코드의 고정 길이입니다.
+	recordSize = 80
+
+	// nullInt는 nil인 *int 컬럼을 나타내는 값입니다.
+	nullInt = math.MinInt32
+)
+
+// 섹션 종류 (파일에 기록되는 순서)
+const (
+	sectionMeta uint32 = iota + 1
+	sectionStrings
+	sectionRoads
+	sectionRoadZip
+	sectionLands
+	sectionLandZip
+)
+
+// sectionKinds는 파일에 기록하는 섹션 순서입니다.
+var sectionKinds = []uint32{sectionMeta, sectionStrings, sectionRoads, sectionRoadZip, sectionLands, sectionLandZip}
+
+// 레코드 내 필드 오프셋 (도로명주소/지번주소 공통)
+//
+//	0  ID u32
+//	4  우편번호 [5]byte
+//	9  지하여부/산여부 u8
+//	10 범위종류 i8 (도로명주소)
+//	12 문자열 번호 u32 × 9 (zip_prefix, 행정구역/도로명 또는 읍면동/리/행정동)
+//	48 범위 i32 × 4 (시작 본번, 시작 부번, 끝 본번, 끝 부번; nil은 nullInt)
+//	64 생성 시각 i64, 수정 시각 i64 (UnixNano, zero time은 0)
+const (
+	offID        = 0
+	offZip       = 4
+	offFlag      = 9
+	offRangeType = 10
+	offStrings   = 12
+	offRanges    = 48
+	offCreatedAt = 64
+	offUpdatedAt = 72
+)
+
+// 도로명주소 레코드의 문자열 필드 순서
+const (
+	roadZipPrefix = iota
+	roadSido
+	roadSidoEn
+	roadSigungu
+	roadSigunguEn
+	roadEupmyeon
+	roadEupmyeonEn
+	roadName
+	roadNameEn
+)
+
+// 지번주소 레코드의 문자열 필드 순서
+const (
+	landZipPrefix = iota
+	landSido
+	landSidoEn
+	landSigungu
+	landSigunguEn
+	landEupmyeondong
+	landEupmyeondongEn
+	landRi
+	landHaengjeongdong
+)
+
+// castagnoli는 checksum 계산에 사용하는 CRC-32C 테이블입니다.
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// align은 n을 sectionAlign 배수로 올림합니다.
+func align(n int) int {
+	return (n + sectionAlign - 1) / sectionAlign * sectionAlign
+}
@@ -0,0 +1,17 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package snapshot
+
+import (
+	"io"
+	"os"
+)
+
+// mapFile은 메모리 매핑을 지원하지 않는 플랫폼에서 파일 전체를 읽습니다.
+func mapFile(f *os.File) ([]byte, func() error, error) {
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, func() error { return nil }, nil
+}
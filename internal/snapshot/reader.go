@@ -0,0 +1,337 @@
+package snapshot
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"sort"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// Snapshot은 읽어 들인 스냅샷 파일입니다.
+// Open으로 연 스냅샷은 파일을 메모리 매핑하므로 레코드는 조회할 때 필요한 만큼만 디코딩합니다.
+type Snapshot struct {
+	data  []byte
+	unmap func() error
+
+	meta     meta
+	checksum uint32
+
+	stringOffsets []byte // u32 × (문자열 수 + 1)
+	stringData    []byte
+	stringCount   int
+
+	roads   []byte
+	roadZip []byte
+	lands   []byte
+	landZip []byte
+}
+
+// Open은 스냅샷 파일을 메모리 매핑하여 엽니다.
+// 매핑을 지원하지 않는 플랫폼에서는 파일 전체를 읽습니다. 사용이 끝나면 Close를 호출합니다.
+func Open(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer f.Close()
+
+	data, unmap, err := mapFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map snapshot: %w", err)
+	}
+
+	snap, err := Parse(data)
+	if err != nil {
+		unmap()
+		return nil, err
+	}
+	snap.unmap = unmap
+	return snap, nil
+}
+
+// Parse는 메모리의 스냅샷 내용을 검증하고 Snapshot을 생성합니다. data는 복사하지 않습니다.
+func Parse(data []byte) (*Snapshot, error) {
+	if len(data) < headerSize || string(data[:len(magic)]) != magic {
+		return nil, fmt.Errorf("%w: bad magic", postalcode.ErrInvalidSnapshot)
+	}
+	if version := binary.LittleEndian.Uint32(data[8:]); version != FormatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d (supported: %d)", postalcode.ErrInvalidSnapshot, version, FormatVersion)
+	}
+	checksum := binary.LittleEndian.Uint32(data[12:])
+	if crc32.Checksum(data[headerSize:], castagnoli) != checksum {
+		return nil, fmt.Errorf("%w: checksum mismatch", postalcode.ErrInvalidSnapshot)
+	}
+
+	count := int(binary.LittleEndian.Uint32(data[16:]))
+	if len(data) < headerSize+sectionEntrySize*count {
+		return nil, fmt.Errorf("%w: truncated section table", postalcode.ErrInvalidSnapshot)
+	}
+	sections := make(map[uint32][]byte, count)
+	for i := 0; i < count; i++ {
+		entry := data[headerSize+sectionEntrySize*i:]
+		kind := binary.LittleEndian.Uint32(entry)
+		offset := binary.LittleEndian.Uint64(entry[8:])
+		length := binary.LittleEndian.Uint64(entry[16:])
+		if offset > uint64(len(data)) || length > uint64(len(data))-offset {
+			return nil, fmt.Errorf("%w: section %d out of range", postalcode.ErrInvalidSnapshot, kind)
+		}
+		sections[kind] = data[offset : offset+length]
+	}
+	for _, kind := range sectionKinds {
+		if _, ok := sections[kind]; !ok {
+			return nil, fmt.Errorf("%w: missing section %d", postalcode.ErrInvalidSnapshot, kind)
+		}
+	}
+
+	s := &Snapshot{
+		data:     data,
+		unmap:    func() error { return nil },
+		checksum: checksum,
+		roads:    sections[sectionRoads],
+		roadZip:  sections[sectionRoadZip],
+		lands:    sections[sectionLands],
+		landZip:  sections[sectionLandZip],
+	}
+	if err := json.Unmarshal(sections[sectionMeta], &s.meta); err != nil {
+		return nil, fmt.Errorf("%w: meta: %v", postalcode.ErrInvalidSnapshot, err)
+	}
+	if err := s.parseStrings(sections[sectionStrings]); err != nil {
+		return nil, err
+	}
+	if err := s.validateRecords(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// parseStrings는 strings 섹션을 검증합니다.
+func (s *Snapshot) parseStrings(section []byte) error {
+	if len(section) < 4 {
+		return fmt.Errorf("%w: truncated strings", postalcode.ErrInvalidSnapshot)
+	}
+	n := int(binary.LittleEndian.Uint32(section))
+	end := 4 + 4*(n+1)
+	if n < 1 || len(section) < end {
+		return fmt.Errorf("%w: truncated strings", postalcode.ErrInvalidSnapshot)
+	}
+	s.stringCount = n
+	s.stringOffsets = section[4:end]
+	s.stringData = section[end:]
+	if int(binary.LittleEndian.Uint32(s.stringOffsets[4*n:])) != len(s.stringData) {
+		return fmt.Errorf("%w: string table size mismatch", postalcode.ErrInvalidSnapshot)
+	}
+	return nil
+}
+
+// validateRecords는 레코드/인덱스 섹션 크기와 행 수를 검증합니다.
+func (s *Snapshot) validateRecords() error {
+	if len(s.roads)%recordSize != 0 || len(s.lands)%recordSize != 0 {
+		return fmt.Errorf("%w: bad record section size", postalcode.ErrInvalidSnapshot)
+	}
+	if s.roadCount() != s.meta.RoadCount || s.landCount() != s.meta.LandCount ||
+		len(s.roadZip) != 4*s.roadCount() || len(s.landZip) != 4*s.landCount() {
+		return fmt.Errorf("%w: row count mismatch", postalcode.ErrInvalidSnapshot)
+	}
+	return nil
+}
+
+// Close는 메모리 매핑을 해제합니다. Close 이후에는 Snapshot과 이를 사용하는 Repository를 사용할 수 없습니다.
+func (s *Snapshot) Close() error {
+	unmap := s.unmap
+	s.unmap = func() error { return nil }
+	return unmap()
+}
+
+// Info는 스냅샷 요약 정보를 반환합니다.
+func (s *Snapshot) Info() *postalcode.SnapshotInfo {
+	info := &postalcode.SnapshotInfo{
+		FormatVersion: FormatVersion,
+		BuiltAt:       s.meta.BuiltAt,
+		Dataset:       s.meta.Dataset,
+		RoadCount:     s.meta.RoadCount,
+		LandCount:     s.meta.LandCount,
+		StringCount:   s.stringCount,
+		Size:          int64(len(s.data)),
+		Checksum:      fmt.Sprintf("%08x", s.checksum),
+	}
+	if s.meta.Dataset != nil {
+		info.DatasetVersion = s.meta.Dataset.DatasetVersion()
+	}
+	return info
+}
+
+// ============================================================
+// 레코드 디코딩
+// ============================================================
+
+// str은 번호에 해당하는 문자열을 반환합니다.
+func (s *Snapshot) str(id uint32) string {
+	if id == 0 || int(id) >= s.stringCount {
+		return ""
+	}
+	start := binary.LittleEndian.Uint32(s.stringOffsets[4*id:])
+	end := binary.LittleEndian.Uint32(s.stringOffsets[4*id+4:])
+	return string(s.stringData[start:end])
+}
+
+func (s *Snapshot) roadCount() int { return len(s.roads) / recordSize }
+func (s *Snapshot) landCount() int { return len(s.lands) / recordSize }
+
+func (s *Snapshot) roadRecord(row int) []byte { return s.roads[row*recordSize : (row+1)*recordSize] }
+func (s *Snapshot) landRecord(row int) []byte { return s.lands[row*recordSize : (row+1)*recordSize] }
+
+// recordID는 레코드의 행 ID입니다.
+func recordID(rec []byte) uint {
+	return uint(binary.LittleEndian.Uint32(rec[offID:]))
+}
+
+// recordZip은 레코드의 우편번호 바이트입니다. (5자리 미만이면 뒤가 0으로 채워짐)
+func recordZip(rec []byte) []byte {
+	return rec[offZip : offZip+5]
+}
+
+// zipString은 레코드의 우편번호입니다.
+func zipString(rec []byte) string {
+	zip := recordZip(rec)
+	for i, c := range zip {
+		if c == 0 {
+			return string(zip[:i])
+		}
+	}
+	return string(zip)
+}
+
+// recordString은 레코드의 i번째 문자열 필드 번호입니다.
+func recordString(rec []byte, i int) uint32 {
+	return binary.LittleEndian.Uint32(rec[offStrings+4*i:])
+}
+
+// recordRange는 레코드의 i번째 범위 값입니다.
+func recordRange(rec []byte, i int) int {
+	return int(int32(binary.LittleEndian.Uint32(rec[offRanges+4*i:])))
+}
+
+// recordOptionalRange는 nil일 수 있는 범위 값입니다.
+func recordOptionalRange(rec []byte, i int) *int {
+	v := recordRange(rec, i)
+	if v == nullInt {
+		return nil
+	}
+	return &v
+}
+
+// recordTime은 UnixNano로 저장된 시각입니다.
+func recordTime(rec []byte, off int) time.Time {
+	n := int64(binary.LittleEndian.Uint64(rec[off:]))
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, n)
+}
+
+// road는 row번째 도로명주소 레코드를 디코딩합니다.
+func (s *Snapshot) road(row int) postalcode.PostalCodeRoad {
+	rec := s.roadRecord(row)
+	return postalcode.PostalCodeRoad{
+		ID:                recordID(rec),
+		ZipCode:           zipString(rec),
+		ZipPrefix:         s.str(recordString(rec, roadZipPrefix)),
+		SidoName:          s.str(recordString(rec, roadSido)),
+		SidoNameEn:        s.str(recordString(rec, roadSidoEn)),
+		SigunguName:       s.str(recordString(rec, roadSigungu)),
+		SigunguNameEn:     s.str(recordString(rec, roadSigunguEn)),
+		EupmyeonName:      s.str(recordString(rec, roadEupmyeon)),
+		EupmyeonNameEn:    s.str(recordString(rec, roadEupmyeonEn)),
+		RoadName:          s.str(recordString(rec, roadName)),
+		RoadNameEn:        s.str(recordString(rec, roadNameEn)),
+		IsUnderground:     rec[offFlag] == 1,
+		StartBuildingMain: recordRange(rec, 0),
+		StartBuildingSub:  recordOptionalRange(rec, 1),
+		EndBuildingMain:   recordOptionalRange(rec, 2),
+		EndBuildingSub:    recordOptionalRange(rec, 3),
+		RangeType:         int8(rec[offRangeType]),
+		CreatedAt:         recordTime(rec, offCreatedAt),
+		UpdatedAt:         recordTime(rec, offUpdatedAt),
+	}
+}
+
+// land는 row번째 지번주소 레코드를 디코딩합니다.
+func (s *Snapshot) land(row int) postalcode.PostalCodeLand {
+	rec := s.landRecord(row)
+	return postalcode.PostalCodeLand{
+		ID:                 recordID(rec),
+		ZipCode:            zipString(rec),
+		ZipPrefix:          s.str(recordString(rec, landZipPrefix)),
+		SidoName:           s.str(recordString(rec, landSido)),
+		SidoNameEn:         s.str(recordString(rec, landSidoEn)),
+		SigunguName:        s.str(recordString(rec, landSigungu)),
+		SigunguNameEn:      s.str(recordString(rec, landSigunguEn)),
+		EupmyeondongName:   s.str(recordString(rec, landEupmyeondong)),
+		EupmyeondongNameEn: s.str(recordString(rec, landEupmyeondongEn)),
+		RiName:             s.str(recordString(rec, landRi)),
+		IsMountain:         rec[offFlag] == 1,
+		HaengjeongdongName: s.str(recordString(rec, landHaengjeongdong)),
+		StartJibunMain:     recordRange(rec, 0),
+		StartJibunSub:      recordOptionalRange(rec, 1),
+		EndJibunMain:       recordOptionalRange(rec, 2),
+		EndJibunSub:        recordOptionalRange(rec, 3),
+		CreatedAt:          recordTime(rec, offCreatedAt),
+		UpdatedAt:          recordTime(rec, offUpdatedAt),
+	}
+}
+
+// ============================================================
+// zip 인덱스
+// ============================================================
+
+// zipIndex는 우편번호, ID 순으로 정렬된 레코드 번호 목록입니다.
+type zipIndex struct {
+	index   []byte
+	records []byte
+}
+
+func (idx zipIndex) len() int { return len(idx.index) / 4 }
+
+func (idx zipIndex) row(i int) int {
+	return int(binary.LittleEndian.Uint32(idx.index[4*i:]))
+}
+
+func (idx zipIndex) zip(i int) string {
+	return zipString(idx.records[idx.row(i)*recordSize : (idx.row(i)+1)*recordSize])
+}
+
+// rangeOf는 우편번호가 prefix로 시작하는 인덱스 범위 [start, end)를 반환합니다.
+// prefix가 5자리이면 우편번호가 정확히 일치하는 범위입니다.
+func (idx zipIndex) rangeOf(prefix string) (int, int) {
+	n := idx.len()
+	start := sort.Search(n, func(i int) bool { return idx.zip(i) >= prefix })
+	end := start + sort.Search(n-start, func(i int) bool {
+		zip := idx.zip(start + i)
+		return len(zip) < len(prefix) || zip[:len(prefix)] != prefix
+	})
+	return start, end
+}
+
+// rows는 우편번호가 prefix로 시작하는 레코드 번호를 오름차순(ID 순)으로 반환합니다.
+func (idx zipIndex) rows(prefix string, exact bool) []int {
+	start, end := idx.rangeOf(prefix)
+	rows := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		if exact && idx.zip(i) != prefix {
+			continue
+		}
+		rows = append(rows, idx.row(i))
+	}
+	if !exact {
+		sort.Ints(rows)
+	}
+	return rows
+}
+
+func (s *Snapshot) roadZipIndex() zipIndex { return zipIndex{index: s.roadZip, records: s.roads} }
+func (s *Snapshot) landZipIndex() zipIndex { return zipIndex{index: s.landZip, records: s.lands} }
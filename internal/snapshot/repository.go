@@ -0,0 +1,463 @@
+package snapshot
+
+import (
+	"strings"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/repository"
+)
+
+// snapshotRepository는 스냅샷 파일을 직접 조회하는 읽기 전용 Repository 구현입니다.
+// 도로명주소/지번주소 조회 결과(정렬, 부분 매칭, 페이징, 총 개수)는 gormRepository와 같고,
+// 스냅샷에 없는 건물/사서함/다량배달처는 빈 결과를 반환하며 쓰기 메서드는 ErrReadOnly를 반환합니다.
+type snapshotRepository struct {
+	snap *Snapshot
+}
+
+// NewRepository는 스냅샷을 조회하는 읽기 전용 Repository를 생성합니다.
+// 반환된 Repository는 io.Closer이기도 하며, Close는 스냅샷의 메모리 매핑을 해제합니다.
+func NewRepository(snap *Snapshot) repository.Repository {
+	return &snapshotRepository{snap: snap}
+}
+
+// Close는 스냅샷의 메모리 매핑을 해제합니다.
+func (r *snapshotRepository) Close() error {
+	return r.snap.Close()
+}
+
+// ============================================================
+// 검색 헬퍼
+// ============================================================
+
+// like는 SQL의 "value LIKE '%substr%'"와 같이 대소문자를 구분하지 않는 부분 매칭입니다.
+func like(value, substr string) bool {
+	return strings.Contains(strings.ToLower(value), strings.ToLower(substr))
+}
+
+// matchStrings는 substr을 포함하는 문자열 번호 표를 만듭니다. substr이 비어 있으면 nil입니다.
+func (s *Snapshot) matchStrings(substr string) []bool {
+	if substr == "" {
+		return nil
+	}
+	matched := make([]bool, s.stringCount)
+	for id := range matched {
+		matched[id] = like(s.str(uint32(id)), substr)
+	}
+	return matched
+}
+
+// fieldFilter는 문자열 필드의 부분 매칭 조건입니다.
+type fieldFilter struct {
+	field   int
+	matched []bool
+}
+
+// termFilter는 통합 검색어 단어 하나의 조건입니다. 필드 중 하나라도 단어를 포함하면 만족합니다.
+type termFilter struct {
+	fields  []int
+	matched []bool
+}
+
+// recordFilter는 레코드 검색 조건입니다. (applyRoadFilters/applyLandFilters와 같은 조건)
+type recordFilter struct {
+	snap      *Snapshot
+	zipCode   string
+	zipPrefix string
+	fields    []fieldFilter
+	terms     []termFilter
+}
+
+// newRecordFilter는 검색 조건을 만듭니다. like는 필드 번호 → 부분 매칭 문자열입니다.
+func (s *Snapshot) newRecordFilter(zipCode, zipPrefix string, like map[int]string) *recordFilter {
+	f := &recordFilter{snap: s, zipCode: zipCode, zipPrefix: zipPrefix}
+	for field, substr := range like {
+		if substr != "" {
+			f.fields = append(f.fields, fieldFilter{field: field, matched: s.matchStrings(substr)})
+		}
+	}
+	return f
+}
+
+// addQuery는 통합 검색어 조건을 추가합니다. fields는 검색 대상 필드 번호입니다.
+func (f *recordFilter) addQuery(q string, fields ...int) {
+	for _, term := range repository.QueryTerms(q) {
+		f.terms = append(f.terms, termFilter{fields: fields, matched: f.snap.matchStrings(term)})
+	}
+}
+
+// matches는 레코드가 조건을 모두 만족하는지 확인합니다.
+func (f *recordFilter) matches(rec []byte) bool {
+	if f.zipCode != "" && zipString(rec) != f.zipCode {
+		return false
+	}
+	if f.zipPrefix != "" && f.snap.str(recordString(rec, 0)) != f.zipPrefix {
+		return false
+	}
+	for _, ff := range f.fields {
+		if !ff.matched[recordString(rec, ff.field)] {
+			return false
+		}
+	}
+	for _, tf := range f.terms {
+		found := false
+		for _, field := range tf.fields {
+			if tf.matched[recordString(rec, field)] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// filterRows는 조건에 맞는 레코드 번호를 ID 순으로 반환합니다.
+// 우편번호/prefix 조건이 있으면 zip 인덱스로 후보를 좁힙니다.
+func (f *recordFilter) filterRows(idx zipIndex, record func(int) []byte) []int {
+	var candidates []int
+	switch {
+	case f.zipCode != "":
+		candidates = idx.rows(f.zipCode, true)
+	case f.zipPrefix != "":
+		candidates = idx.rows(f.zipPrefix, false)
+	default:
+		candidates = make([]int, idx.len())
+		for i := range candidates {
+			candidates[i] = i
+		}
+	}
+
+	rows := candidates[:0]
+	for _, row := range candidates {
+		if f.matches(record(row)) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// pageBounds는 n개의 결과에 limit/offset을 적용한 범위를 반환합니다. (limit이 0 이하이면 제한 없음)
+func pageBounds(n, limit, offset int) (int, int) {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > n {
+		offset = n
+	}
+	end := n
+	if limit > 0 && offset+limit < n {
+		end = offset + limit
+	}
+	return offset, end
+}
+
+// searchBounds는 Search 계열 메서드의 페이징(기본 10개, page 기반 offset)을 적용한 범위를 반환합니다.
+func searchBounds(n, page, limit int) (int, int) {
+	offset := (page - 1) * limit
+	if limit <= 0 {
+		limit = 10 // 기본 10개
+	}
+	return pageBounds(n, limit, offset)
+}
+
+func (r *snapshotRepository) roadRows(params postalcode.SearchParams) []int {
+	f := r.snap.newRecordFilter(params.ZipCode, params.ZipPrefix, map[int]string{
+		roadSido:    params.SidoName,
+		roadSigungu: params.SigunguName,
+		roadName:    params.RoadName,
+	})
+	f.addQuery(params.Query, roadName, roadSigungu, roadEupmyeon)
+	return f.filterRows(r.snap.roadZipIndex(), r.snap.roadRecord)
+}
+
+func (r *snapshotRepository) landRows(params postalcode.SearchParamsLand) []int {
+	f := r.snap.newRecordFilter(params.ZipCode, params.ZipPrefix, map[int]string{
+		landSido:         params.SidoName,
+		landSigungu:      params.SigunguName,
+		landEupmyeondong: params.EupmyeondongName,
+		landRi:           params.RiName,
+	})
+	f.addQuery(params.Query, landSigungu, landEupmyeondong, landRi)
+	return f.filterRows(r.snap.landZipIndex(), r.snap.landRecord)
+}
+
+func (r *snapshotRepository) decodeRoads(rows []int) []postalcode.PostalCodeRoad {
+	roads := make([]postalcode.PostalCodeRoad, len(rows))
+	for i, row := range rows {
+		roads[i] = r.snap.road(row)
+	}
+	return roads
+}
+
+func (r *snapshotRepository) decodeLands(rows []int) []postalcode.PostalCodeLand {
+	lands := make([]postalcode.PostalCodeLand, len(rows))
+	for i, row := range rows {
+		lands[i] = r.snap.land(row)
+	}
+	return lands
+}
+
+// ============================================================
+// 도로명주소 관련 메서드
+// ============================================================
+
+// FindByZipCode는 우편번호로 조회합니다.
+func (r *snapshotRepository) FindByZipCode(zipCode string) ([]postalcode.PostalCodeRoad, error) {
+	return r.decodeRoads(r.roadRows(postalcode.SearchParams{ZipCode: zipCode})), nil
+}
+
+// FindByZipPrefix는 우편번호 앞 3자리로 조회합니다.
+func (r *snapshotRepository) FindByZipPrefix(zipPrefix string, limit, offset int) ([]postalcode.PostalCodeRoad, int64, error) {
+	rows := r.roadRows(postalcode.SearchParams{ZipPrefix: zipPrefix})
+	start, end := pageBounds(len(rows), limit, offset)
+	return r.decodeRoads(rows[start:end]), int64(len(rows)), nil
+}
+
+// Search는 여러 조건으로 검색합니다.
+func (r *snapshotRepository) Search(params postalcode.SearchParams) ([]postalcode.PostalCodeRoad, int64, error) {
+	rows := r.roadRows(params)
+	start, end := searchBounds(len(rows), params.Page, params.Limit)
+	return r.decodeRoads(rows[start:end]), int64(len(rows)), nil
+}
+
+// ScanRoads는 검색 조건에 맞는 모든 데이터를 ID 순으로 batchSize씩 순회합니다.
+func (r *snapshotRepository) ScanRoads(params postalcode.SearchParams, batchSize int, fn func([]postalcode.PostalCodeRoad) error) error {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	rows := r.roadRows(params)
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		if err := fn(r.decodeRoads(rows[start:end])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Create는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) Create(road *postalcode.PostalCodeRoad) error {
+	return postalcode.ErrReadOnly
+}
+
+// BatchCreate는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) BatchCreate(roads []postalcode.PostalCodeRoad) error {
+	return postalcode.ErrReadOnly
+}
+
+// Update는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) Update(road *postalcode.PostalCodeRoad) error {
+	return postalcode.ErrReadOnly
+}
+
+// Delete는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) Delete(id uint) error {
+	return postalcode.ErrReadOnly
+}
+
+// BatchDelete는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) BatchDelete(ids []uint) error {
+	return postalcode.ErrReadOnly
+}
+
+// TruncateRoad는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) TruncateRoad() error {
+	return postalcode.ErrReadOnly
+}
+
+// ============================================================
+// 지번주소 관련 메서드
+// ============================================================
+
+// FindLandByZipCode는 우편번호로 지번주소를 조회합니다.
+func (r *snapshotRepository) FindLandByZipCode(zipCode string) ([]postalcode.PostalCodeLand, error) {
+	return r.decodeLands(r.landRows(postalcode.SearchParamsLand{ZipCode: zipCode})), nil
+}
+
+// FindLandByZipPrefix는 우편번호 앞 3자리로 지번주소를 조회합니다.
+func (r *snapshotRepository) FindLandByZipPrefix(zipPrefix string, limit, offset int) ([]postalcode.PostalCodeLand, int64, error) {
+	rows := r.landRows(postalcode.SearchParamsLand{ZipPrefix: zipPrefix})
+	start, end := pageBounds(len(rows), limit, offset)
+	return r.decodeLands(rows[start:end]), int64(len(rows)), nil
+}
+
+// SearchLand는 여러 조건으로 지번주소를 검색합니다.
+func (r *snapshotRepository) SearchLand(params postalcode.SearchParamsLand) ([]postalcode.PostalCodeLand, int64, error) {
+	rows := r.landRows(params)
+	start, end := searchBounds(len(rows), params.Page, params.Limit)
+	return r.decodeLands(rows[start:end]), int64(len(rows)), nil
+}
+
+// ScanLands는 검색 조건에 맞는 모든 지번주소 데이터를 ID 순으로 batchSize씩 순회합니다.
+func (r *snapshotRepository) ScanLands(params postalcode.SearchParamsLand, batchSize int, fn func([]postalcode.PostalCodeLand) error) error {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	rows := r.landRows(params)
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		if err := fn(r.decodeLands(rows[start:end])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateLand는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) CreateLand(land *postalcode.PostalCodeLand) error {
+	return postalcode.ErrReadOnly
+}
+
+// BatchCreateLand는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) BatchCreateLand(lands []postalcode.PostalCodeLand) error {
+	return postalcode.ErrReadOnly
+}
+
+// UpdateLand는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) UpdateLand(land *postalcode.PostalCodeLand) error {
+	return postalcode.ErrReadOnly
+}
+
+// DeleteLand는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) DeleteLand(id uint) error {
+	return postalcode.ErrReadOnly
+}
+
+// BatchDeleteLand는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) BatchDeleteLand(ids []uint) error {
+	return postalcode.ErrReadOnly
+}
+
+// TruncateLand는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) TruncateLand() error {
+	return postalcode.ErrReadOnly
+}
+
+// ============================================================
+// 건물 / 사서함 / 다량배달처 (스냅샷에 포함되지 않음)
+// ============================================================
+
+// SearchBuildings는 스냅샷에 건물 데이터가 없으므로 빈 결과를 반환합니다.
+func (r *snapshotRepository) SearchBuildings(params postalcode.SearchParamsBuilding) ([]postalcode.PostalCodeBuilding, int64, error) {
+	return []postalcode.PostalCodeBuilding{}, 0, nil
+}
+
+// BatchCreateBuilding은 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) BatchCreateBuilding(buildings []postalcode.PostalCodeBuilding) error {
+	return postalcode.ErrReadOnly
+}
+
+// TruncateBuilding은 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) TruncateBuilding() error {
+	return postalcode.ErrReadOnly
+}
+
+// FindPOBoxByZipCode는 스냅샷에 사서함 데이터가 없으므로 빈 결과를 반환합니다.
+func (r *snapshotRepository) FindPOBoxByZipCode(zipCode string) ([]postalcode.PostalCodePOBox, error) {
+	return []postalcode.PostalCodePOBox{}, nil
+}
+
+// SearchPOBoxes는 스냅샷에 사서함 데이터가 없으므로 빈 결과를 반환합니다.
+func (r *snapshotRepository) SearchPOBoxes(params postalcode.SearchParamsPOBox) ([]postalcode.PostalCodePOBox, int64, error) {
+	return []postalcode.PostalCodePOBox{}, 0, nil
+}
+
+// BatchCreatePOBox는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) BatchCreatePOBox(poBoxes []postalcode.PostalCodePOBox) error {
+	return postalcode.ErrReadOnly
+}
+
+// TruncatePOBox는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) TruncatePOBox() error {
+	return postalcode.ErrReadOnly
+}
+
+// FindBulkDeliveryByZipCode는 스냅샷에 다량배달처 데이터가 없으므로 빈 결과를 반환합니다.
+func (r *snapshotRepository) FindBulkDeliveryByZipCode(zipCode string) ([]postalcode.PostalCodeBulkDelivery, error) {
+	return []postalcode.PostalCodeBulkDelivery{}, nil
+}
+
+// SearchBulkDeliveries는 스냅샷에 다량배달처 데이터가 없으므로 빈 결과를 반환합니다.
+func (r *snapshotRepository) SearchBulkDeliveries(params postalcode.SearchParamsBulkDelivery) ([]postalcode.PostalCodeBulkDelivery, int64, error) {
+	return []postalcode.PostalCodeBulkDelivery{}, 0, nil
+}
+
+// BatchCreateBulkDelivery는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) BatchCreateBulkDelivery(deliveries []postalcode.PostalCodeBulkDelivery) error {
+	return postalcode.ErrReadOnly
+}
+
+// TruncateBulkDelivery는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) TruncateBulkDelivery() error {
+	return postalcode.ErrReadOnly
+}
+
+// ExistsAddressZipCode는 우편번호가 도로명주소 또는 지번주소에 있는지 확인합니다.
+func (r *snapshotRepository) ExistsAddressZipCode(zipCode string) (bool, error) {
+	return len(r.snap.roadZipIndex().rows(zipCode, true)) > 0 || len(r.snap.landZipIndex().rows(zipCode, true)) > 0, nil
+}
+
+// ============================================================
+// Import 이력 관련 메서드
+// ============================================================
+
+// CreateImportRun은 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) CreateImportRun(run *postalcode.ImportRun) error {
+	return postalcode.ErrReadOnly
+}
+
+// UpdateImportRun은 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) UpdateImportRun(run *postalcode.ImportRun) error {
+	return postalcode.ErrReadOnly
+}
+
+// ListImportRuns는 스냅샷을 만든 시점의 데이터셋 import 실행을 반환합니다.
+func (r *snapshotRepository) ListImportRuns(limit int) ([]postalcode.ImportRun, error) {
+	if r.snap.meta.Dataset == nil {
+		return []postalcode.ImportRun{}, nil
+	}
+	return []postalcode.ImportRun{*r.snap.meta.Dataset}, nil
+}
+
+// FindLatestImportRun은 스냅샷을 만든 시점의 데이터셋(마지막으로 성공한 import)을 반환합니다.
+func (r *snapshotRepository) FindLatestImportRun() (*postalcode.ImportRun, error) {
+	if r.snap.meta.Dataset == nil {
+		return nil, postalcode.ErrNotFound
+	}
+	run := *r.snap.meta.Dataset
+	return &run, nil
+}
+
+// FindLastAppliedImportRun은 스냅샷의 데이터셋이 dataTypes 중 하나이면 반환합니다.
+func (r *snapshotRepository) FindLastAppliedImportRun(dataTypes ...string) (*postalcode.ImportRun, error) {
+	run, err := r.FindLatestImportRun()
+	if err != nil {
+		return nil, err
+	}
+	for _, dataType := range dataTypes {
+		if run.DataType == dataType {
+			return run, nil
+		}
+	}
+	return nil, postalcode.ErrNotFound
+}
+
+// BatchCreateImportRejects는 읽기 전용이므로 ErrReadOnly를 반환합니다.
+func (r *snapshotRepository) BatchCreateImportRejects(rejects []postalcode.ImportReject) error {
+	return postalcode.ErrReadOnly
+}
+
+// ListImportRejects는 스냅샷에 거부 라인이 없으므로 빈 결과를 반환합니다.
+func (r *snapshotRepository) ListImportRejects(runID uint) ([]postalcode.ImportReject, error) {
+	return []postalcode.ImportReject{}, nil
+}
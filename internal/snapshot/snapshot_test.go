@@ -0,0 +1,294 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/importer"
+	"github.com/oursportsnation/korean-postalcode/internal/repository"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const testRoadFile = `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류
+01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로177길|Samyang-ro 177-gil|0|93|0|126|0|3
+01001|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로177길|Samyang-ro 177-gil|0|1|0|91|0|1
+01001|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로|Samyang-ro|0|5||7||1
+06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|1|101|2|||0
+21000|부산광역시|Busan|중구|Jung-gu|||중앙대로|Jungang-daero|0|1||9||1
+63000|제주특별자치도|Jeju-do|제주시|Jeju-si|애월읍|Aewol-eup|애월로|Aewol-ro|0|1||9||1
+`
+
+const testLandFile = `우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면동명|읍면동명(영문)|리명|산여부|행정동명|지번본번(시작)|지번부번(시작)|지번본번(종료)|지번부번(종료)
+25627|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|모전리|0||1|0|100|0
+25628|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|심곡리|1||5|2|||
+25600|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|교동|Gyo-dong||0|교1동|1||50|
+48000|부산광역시|Busan|중구|Jung-gu|중앙동|Jungang-dong||0||1|||
+`
+
+// setupTestSnapshot은 sqlite DB에 테스트 데이터를 import하고 스냅샷 파일을 만들어 엽니다.
+func setupTestSnapshot(t *testing.T) (repository.Repository, *Snapshot, string) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.ImportRun{}))
+
+	repo := repository.New(db)
+	svc := service.New(repo)
+	imp := importer.New(svc, importer.WithDatasetDate("2024-05-01"))
+	_, err = imp.ImportFromReader(strings.NewReader(testRoadFile), 0, 100, nil)
+	require.NoError(t, err)
+	_, err = imp.ImportLandFromReader(strings.NewReader(testLandFile), 0, 100, nil)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "postalcode.snap")
+	info, err := BuildFile(path, svc)
+	require.NoError(t, err)
+	assert.Equal(t, 6, info.RoadCount)
+	assert.Equal(t, 4, info.LandCount)
+
+	snap, err := Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { snap.Close() })
+
+	return repo, snap, path
+}
+
+func roadKeys(roads []postalcode.PostalCodeRoad) []string {
+	keys := make([]string, len(roads))
+	for i, road := range roads {
+		keys[i] = fmt.Sprintf("%d:%s:%s", road.ID, road.ZipCode, road.RoadName)
+	}
+	return keys
+}
+
+func landKeys(lands []postalcode.PostalCodeLand) []string {
+	keys := make([]string, len(lands))
+	for i, land := range lands {
+		keys[i] = fmt.Sprintf("%d:%s:%s", land.ID, land.ZipCode, land.RiName)
+	}
+	return keys
+}
+
+// ============================================================
+// Format Tests
+// ============================================================
+
+func TestSnapshot_Info(t *testing.T) {
+	_, snap, path := setupTestSnapshot(t)
+
+	info := snap.Info()
+	assert.Equal(t, FormatVersion, info.FormatVersion)
+	assert.Equal(t, 6, info.RoadCount)
+	assert.Equal(t, 4, info.LandCount)
+	assert.Len(t, info.Checksum, 8)
+	assert.False(t, info.BuiltAt.IsZero())
+
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, stat.Size(), info.Size)
+
+	// 마지막으로 성공한 import가 데이터셋 버전
+	require.NotNil(t, info.Dataset)
+	assert.Equal(t, postalcode.ImportDataTypeLand, info.Dataset.DataType)
+	assert.Equal(t, info.Dataset.DatasetVersion(), info.DatasetVersion)
+	assert.True(t, strings.HasPrefix(info.DatasetVersion, "2024-05-01+"))
+
+	// 같은 지역명은 한 번만 저장
+	assert.Less(t, info.StringCount, (info.RoadCount+info.LandCount)*9)
+}
+
+func TestSnapshot_RecordsRoundTrip(t *testing.T) {
+	repo, snap, _ := setupTestSnapshot(t)
+	snapRepo := NewRepository(snap)
+
+	var want, got []postalcode.PostalCodeRoad
+	require.NoError(t, repo.ScanRoads(postalcode.SearchParams{}, 100, func(roads []postalcode.PostalCodeRoad) error {
+		want = append(want, roads...)
+		return nil
+	}))
+	require.NoError(t, snapRepo.ScanRoads(postalcode.SearchParams{}, 4, func(roads []postalcode.PostalCodeRoad) error {
+		got = append(got, roads...)
+		return nil
+	}))
+	require.Len(t, got, len(want))
+	for i := range want {
+		assert.True(t, want[i].CreatedAt.Equal(got[i].CreatedAt))
+		want[i].CreatedAt, want[i].UpdatedAt = got[i].CreatedAt, got[i].UpdatedAt
+	}
+	assert.Equal(t, want, got)
+
+	var wantLands, gotLands []postalcode.PostalCodeLand
+	require.NoError(t, repo.ScanLands(postalcode.SearchParamsLand{}, 100, func(lands []postalcode.PostalCodeLand) error {
+		wantLands = append(wantLands, lands...)
+		return nil
+	}))
+	require.NoError(t, snapRepo.ScanLands(postalcode.SearchParamsLand{}, 100, func(lands []postalcode.PostalCodeLand) error {
+		gotLands = append(gotLands, lands...)
+		return nil
+	}))
+	require.Len(t, gotLands, len(wantLands))
+	for i := range wantLands {
+		wantLands[i].CreatedAt, wantLands[i].UpdatedAt = gotLands[i].CreatedAt, gotLands[i].UpdatedAt
+	}
+	assert.Equal(t, wantLands, gotLands)
+}
+
+func TestSnapshot_Corrupted(t *testing.T) {
+	_, _, path := setupTestSnapshot(t)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// 내용이 바뀌면 checksum 불일치
+	corrupted := append([]byte(nil), data...)
+	corrupted[len(corrupted)/2] ^= 0xff
+	_, err = Parse(corrupted)
+	assert.True(t, errors.Is(err, postalcode.ErrInvalidSnapshot))
+	assert.Contains(t, err.Error(), "checksum")
+
+	// 잘린 파일
+	_, err = Parse(data[:len(data)/2])
+	assert.True(t, errors.Is(err, postalcode.ErrInvalidSnapshot))
+
+	// 지원하지 않는 형식 버전
+	future := append([]byte(nil), data...)
+	future[8] = FormatVersion + 1
+	_, err = Parse(future)
+	assert.True(t, errors.Is(err, postalcode.ErrInvalidSnapshot))
+	assert.Contains(t, err.Error(), "version")
+
+	// 스냅샷 파일이 아님
+	_, err = Parse([]byte(testRoadFile))
+	assert.True(t, errors.Is(err, postalcode.ErrInvalidSnapshot))
+}
+
+func TestSnapshot_Build_Empty(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.ImportRun{}))
+
+	var buf bytes.Buffer
+	info, err := Build(&buf, service.New(repository.New(db)))
+	require.NoError(t, err)
+	assert.Equal(t, 0, info.RoadCount)
+	assert.Nil(t, info.Dataset)
+
+	snap, err := Parse(buf.Bytes())
+	require.NoError(t, err)
+	repo := NewRepository(snap)
+
+	roads, err := repo.FindByZipCode("01000")
+	require.NoError(t, err)
+	assert.Empty(t, roads)
+	_, err = repo.FindLatestImportRun()
+	assert.ErrorIs(t, err, postalcode.ErrNotFound)
+}
+
+// ============================================================
+// Repository Tests
+// ============================================================
+
+func TestSnapshotRepository_MatchesGorm(t *testing.T) {
+	gormRepo, snap, _ := setupTestSnapshot(t)
+	snapRepo := NewRepository(snap)
+
+	for _, zipCode := range []string{"01001", "06000", "99999", "010"} {
+		want, err := gormRepo.FindByZipCode(zipCode)
+		require.NoError(t, err)
+		got, err := snapRepo.FindByZipCode(zipCode)
+		require.NoError(t, err)
+		assert.Equal(t, roadKeys(want), roadKeys(got), zipCode)
+	}
+
+	for _, page := range [][2]int{{10, 0}, {2, 0}, {2, 2}, {0, 0}, {2, 10}} {
+		want, wantTotal, err := gormRepo.FindByZipPrefix("010", page[0], page[1])
+		require.NoError(t, err)
+		got, gotTotal, err := snapRepo.FindByZipPrefix("010", page[0], page[1])
+		require.NoError(t, err)
+		assert.Equal(t, wantTotal, gotTotal)
+		assert.Equal(t, roadKeys(want), roadKeys(got), page)
+	}
+
+	roadParams := []postalcode.SearchParams{
+		{},
+		{SidoName: "서울", Page: 1, Limit: 2},
+		{SidoName: "서울", Page: 2, Limit: 2},
+		{SidoName: "서울", SigunguName: "강북", RoadName: "삼양로177"},
+		{RoadName: "삼양로", ZipCode: "01001"},
+		{ZipPrefix: "010", RoadName: "177"},
+		{SigunguName: "중", Page: 3, Limit: 10},
+		{RoadName: "없는도로"},
+		{Query: "강북 삼양로"},
+		{Query: "애월", Page: 1, Limit: 1}, // 도로명과 읍면명
+		{Query: "구 177"},
+		{Query: "강북구 테헤란로"},
+	}
+	for _, params := range roadParams {
+		want, wantTotal, err := gormRepo.Search(params)
+		require.NoError(t, err)
+		got, gotTotal, err := snapRepo.Search(params)
+		require.NoError(t, err)
+		assert.Equal(t, wantTotal, gotTotal, params)
+		assert.Equal(t, roadKeys(want), roadKeys(got), params)
+	}
+
+	wantLands, wantTotal, err := gormRepo.FindLandByZipPrefix("256", 2, 1)
+	require.NoError(t, err)
+	gotLands, gotTotal, err := snapRepo.FindLandByZipPrefix("256", 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, wantTotal, gotTotal)
+	assert.Equal(t, landKeys(wantLands), landKeys(gotLands))
+
+	landParams := []postalcode.SearchParamsLand{
+		{},
+		{SidoName: "강원", Page: 2, Limit: 2},
+		{EupmyeondongName: "강동", RiName: "심곡"},
+		{SigunguName: "중구", ZipPrefix: "480"},
+		{ZipCode: "25600"},
+		{Query: "강릉 모전"},
+		{Query: "리"},
+		{Query: "중앙동 중구", ZipPrefix: "480"},
+	}
+	for _, params := range landParams {
+		want, wantTotal, err := gormRepo.SearchLand(params)
+		require.NoError(t, err)
+		got, gotTotal, err := snapRepo.SearchLand(params)
+		require.NoError(t, err)
+		assert.Equal(t, wantTotal, gotTotal, params)
+		assert.Equal(t, landKeys(want), landKeys(got), params)
+	}
+
+	for zipCode, want := range map[string]bool{"01000": true, "25627": true, "03171": false, "010": false} {
+		exists, err := snapRepo.ExistsAddressZipCode(zipCode)
+		require.NoError(t, err)
+		assert.Equal(t, want, exists, zipCode)
+	}
+}
+
+func TestSnapshotRepository_ReadOnly(t *testing.T) {
+	_, snap, _ := setupTestSnapshot(t)
+	repo := NewRepository(snap)
+
+	assert.ErrorIs(t, repo.Create(&postalcode.PostalCodeRoad{ZipCode: "01000"}), postalcode.ErrReadOnly)
+	assert.ErrorIs(t, repo.BatchCreateLand(nil), postalcode.ErrReadOnly)
+	assert.ErrorIs(t, repo.TruncateRoad(), postalcode.ErrReadOnly)
+	assert.ErrorIs(t, repo.CreateImportRun(&postalcode.ImportRun{}), postalcode.ErrReadOnly)
+
+	// 서비스를 통한 쓰기도 거부
+	err := service.New(repo).BatchUpsert([]postalcode.PostalCodeRoad{{ZipCode: "01000", SidoName: "서울특별시", SigunguName: "강북구", RoadName: "삼양로"}})
+	assert.ErrorIs(t, err, postalcode.ErrReadOnly)
+
+	// 데이터셋 버전은 스냅샷을 만든 시점의 import
+	run, err := service.New(repo).GetCurrentDataset()
+	require.NoError(t, err)
+	assert.Equal(t, snap.Info().DatasetVersion, run.DatasetVersion())
+}
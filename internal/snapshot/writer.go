@@ -0,0 +1,243 @@
+package snapshot
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+	"github.com/oursportsnation/korean-postalcode/internal/service"
+)
+
+// buildBatchSize는 스냅샷을 만들 때 테이블에서 한 번에 읽을 행 수입니다.
+const buildBatchSize = 5000
+
+// meta는 meta 섹션에 JSON으로 저장하는 정보입니다.
+type meta struct {
+	BuiltAt   time.Time             `json:"built_at"`
+	Dataset   *postalcode.ImportRun `json:"dataset,omitempty"`
+	RoadCount int                   `json:"road_count"`
+	LandCount int                   `json:"land_count"`
+}
+
+// builder는 레코드와 중복 제거된 문자열을 모읍니다.
+type builder struct {
+	strings []string
+	ids     map[string]uint32
+	roads   []byte
+	lands   []byte
+}
+
+func newBuilder() *builder {
+	return &builder{strings: []string{""}, ids: map[string]uint32{"": 0}}
+}
+
+// intern은 문자열 번호를 반환합니다. 처음 보는 문자열이면 새 번호를 부여합니다.
+func (b *builder) intern(s string) uint32 {
+	if id, ok := b.ids[s]; ok {
+		return id
+	}
+	id := uint32(len(b.strings))
+	b.strings = append(b.strings, s)
+	b.ids[s] = id
+	return id
+}
+
+// putRecord는 레코드 공통 필드를 기록합니다.
+func (b *builder) putRecord(rec []byte, id uint, zipCode string, flag bool, strs []string, ranges []int, ranged []*int, createdAt, updatedAt time.Time) {
+	binary.LittleEndian.PutUint32(rec[offID:], uint32(id))
+	copy(rec[offZip:offZip+5], zipCode)
+	if flag {
+		rec[offFlag] = 1
+	}
+	for i, s := range strs {
+		binary.LittleEndian.PutUint32(rec[offStrings+4*i:], b.intern(s))
+	}
+	values := []int{ranges[0], nullInt, nullInt, nullInt}
+	for i, v := range ranged {
+		if v != nil {
+			values[i+1] = *v
+		}
+	}
+	for i, v := range values {
+		binary.LittleEndian.PutUint32(rec[offRanges+4*i:], uint32(int32(v)))
+	}
+	binary.LittleEndian.PutUint64(rec[offCreatedAt:], uint64(unixNano(createdAt)))
+	binary.LittleEndian.PutUint64(rec[offUpdatedAt:], uint64(unixNano(updatedAt)))
+}
+
+func (b *builder) addRoad(road *postalcode.PostalCodeRoad) {
+	rec := make([]byte, recordSize)
+	b.putRecord(rec, road.ID, road.ZipCode, road.IsUnderground,
+		[]string{road.ZipPrefix, road.SidoName, road.SidoNameEn, road.SigunguName, road.SigunguNameEn,
+			road.EupmyeonName, road.EupmyeonNameEn, road.RoadName, road.RoadNameEn},
+		[]int{road.StartBuildingMain}, []*int{road.StartBuildingSub, road.EndBuildingMain, road.EndBuildingSub},
+		road.CreatedAt, road.UpdatedAt)
+	rec[offRangeType] = byte(road.RangeType)
+	b.roads = append(b.roads, rec...)
+}
+
+func (b *builder) addLand(land *postalcode.PostalCodeLand) {
+	rec := make([]byte, recordSize)
+	b.putRecord(rec, land.ID, land.ZipCode, land.IsMountain,
+		[]string{land.ZipPrefix, land.SidoName, land.SidoNameEn, land.SigunguName, land.SigunguNameEn,
+			land.EupmyeondongName, land.EupmyeondongNameEn, land.RiName, land.HaengjeongdongName},
+		[]int{land.StartJibunMain}, []*int{land.StartJibunSub, land.EndJibunMain, land.EndJibunSub},
+		land.CreatedAt, land.UpdatedAt)
+	b.lands = append(b.lands, rec...)
+}
+
+// stringSection은 strings 섹션을 만듭니다.
+func (b *builder) stringSection() []byte {
+	size := 4 + 4*(len(b.strings)+1)
+	for _, s := range b.strings {
+		size += len(s)
+	}
+	buf := make([]byte, 4+4*(len(b.strings)+1), size)
+	binary.LittleEndian.PutUint32(buf, uint32(len(b.strings)))
+	offset := 0
+	for i, s := range b.strings {
+		binary.LittleEndian.PutUint32(buf[4+4*i:], uint32(offset))
+		offset += len(s)
+	}
+	binary.LittleEndian.PutUint32(buf[4+4*len(b.strings):], uint32(offset))
+	for _, s := range b.strings {
+		buf = append(buf, s...)
+	}
+	return buf
+}
+
+// zipSection은 레코드 번호를 우편번호, ID 순으로 정렬한 zip 인덱스 섹션을 만듭니다.
+func zipSection(records []byte) []byte {
+	n := len(records) / recordSize
+	rows := make([]uint32, n)
+	for i := range rows {
+		rows[i] = uint32(i)
+	}
+	zipOf := func(row uint32) string {
+		off := int(row)*recordSize + offZip
+		return string(records[off : off+5])
+	}
+	// 레코드가 ID 순이므로 안정 정렬하면 같은 우편번호 안에서는 ID 순
+	sort.SliceStable(rows, func(i, j int) bool { return zipOf(rows[i]) < zipOf(rows[j]) })
+
+	buf := make([]byte, 4*n)
+	for i, row := range rows {
+		binary.LittleEndian.PutUint32(buf[4*i:], row)
+	}
+	return buf
+}
+
+// Build는 Service의 도로명주소/지번주소 테이블과 현재 데이터셋 정보를 스냅샷 형식으로 w에 씁니다.
+func Build(w io.Writer, svc service.Service) (*postalcode.SnapshotInfo, error) {
+	b := newBuilder()
+
+	err := svc.ScanRoads(postalcode.SearchParams{}, buildBatchSize, func(roads []postalcode.PostalCodeRoad) error {
+		for i := range roads {
+			b.addRoad(&roads[i])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read roads: %w", err)
+	}
+
+	err = svc.ScanLands(postalcode.SearchParamsLand{}, buildBatchSize, func(lands []postalcode.PostalCodeLand) error {
+		for i := range lands {
+			b.addLand(&lands[i])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lands: %w", err)
+	}
+
+	m := meta{
+		BuiltAt:   time.Now().UTC().Truncate(time.Second),
+		RoadCount: len(b.roads) / recordSize,
+		LandCount: len(b.lands) / recordSize,
+	}
+	if run, err := svc.GetCurrentDataset(); err == nil {
+		m.Dataset = run
+	} else if !errors.Is(err, postalcode.ErrNotFound) {
+		return nil, fmt.Errorf("failed to read current dataset: %w", err)
+	}
+	metaJSON, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := [][]byte{metaJSON, b.stringSection(), b.roads, zipSection(b.roads), b.lands, zipSection(b.lands)}
+	data := assemble(sections)
+	if _, err := w.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	snap, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return snap.Info(), nil
+}
+
+// BuildFile은 스냅샷을 path에 씁니다.
+// 같은 디렉토리의 임시 파일에 쓴 뒤 이름을 바꾸므로, 서비스 중인 스냅샷 파일을 안전하게 교체할 수 있습니다.
+func BuildFile(path string, svc service.Service) (*postalcode.SnapshotInfo, error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	info, err := Build(f, svc)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return info, nil
+}
+
+// assemble은 헤더, 섹션 테이블, 섹션을 하나의 파일 내용으로 합치고 checksum을 기록합니다.
+func assemble(sections [][]byte) []byte {
+	offset := align(headerSize + sectionEntrySize*len(sections))
+	offsets := make([]int, len(sections))
+	for i, section := range sections {
+		offsets[i] = offset
+		offset = align(offset + len(section))
+	}
+
+	data := make([]byte, offset)
+	copy(data, magic)
+	binary.LittleEndian.PutUint32(data[8:], FormatVersion)
+	binary.LittleEndian.PutUint32(data[16:], uint32(len(sections)))
+	for i, section := range sections {
+		entry := data[headerSize+sectionEntrySize*i:]
+		binary.LittleEndian.PutUint32(entry, sectionKinds[i])
+		binary.LittleEndian.PutUint64(entry[8:], uint64(offsets[i]))
+		binary.LittleEndian.PutUint64(entry[16:], uint64(len(section)))
+		copy(data[offsets[i]:], section)
+	}
+	binary.LittleEndian.PutUint32(data[12:], crc32.Checksum(data[headerSize:], castagnoli))
+	return data
+}
+
+// unixNano는 t를 UnixNano로 변환합니다. zero time은 0입니다.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
@@ -0,0 +1,14 @@
+// Package migrations는 dialect별 버전 SQL 마이그레이션 파일을 담고 있습니다.
+//
+// 파일은 dialect 디렉토리(mysql, postgres, sqlite) 아래에 "<버전>_<이름>.up.sql"과
+// "<버전>_<이름>.down.sql" 쌍으로 두며, 버전 번호는 모든 dialect에서 같게 유지합니다.
+// 해당 dialect에 필요 없는 마이그레이션은 주석만 있는 빈 파일로 둡니다.
+// postalcode-migrate가 바이너리에 포함된 파일을 실행하므로, 파일을 추가하면 다시 빌드해야 합니다.
+package migrations
+
+import "embed"
+
+// FS는 바이너리에 포함된 마이그레이션 파일입니다. 루트 아래에 dialect 디렉토리가 있습니다.
+//
+//go:embed mysql/*.sql postgres/*.sql sqlite/*.sql
+var FS embed.FS
@@ -41,12 +41,9 @@ CREATE TABLE IF NOT EXISTS postal_code_roads (
     INDEX idx_sigungu (sigungu_name),
     INDEX idx_road (road_name),
 
-    -- 유니크 인덱스 (중복 방지 및 무결성 보장)
-    -- 모든 필드를 포함하여 완전히 동일한 레코드만 중복으로 간주
+    -- 유니크 인덱스 (upsert 중복 판단 키, PostalCodeRoad 모델과 같은 컬럼)
     UNIQUE INDEX idx_postal_unique (
-        zip_code, sido_name, sigungu_name, eupmyeon_name, road_name,
-        is_underground, start_building_main, start_building_sub,
-        end_building_main, end_building_sub, range_type
+        zip_code, sido_name, sigungu_name, road_name, start_building_main
     )
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='우편번호별 도로명 범위 정보';
 
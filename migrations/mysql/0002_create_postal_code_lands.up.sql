@@ -41,12 +41,10 @@ CREATE TABLE IF NOT EXISTS postal_code_lands (
     INDEX idx_land_eupmyeondong (eupmyeondong_name),
     INDEX idx_land_ri (ri_name),
 
-    -- 유니크 인덱스 (중복 방지 및 무결성 보장)
-    -- 모든 필드를 포함하여 완전히 동일한 레코드만 중복으로 간주
+    -- 유니크 인덱스 (upsert 중복 판단 키, PostalCodeLand 모델과 같은 컬럼)
     UNIQUE INDEX idx_land_unique (
         zip_code, sido_name, sigungu_name, eupmyeondong_name, ri_name,
-        is_mountain, start_jibun_main, start_jibun_sub,
-        end_jibun_main, end_jibun_sub
+        is_mountain, start_jibun_main
     )
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='우편번호별 지번주소 범위 정보';
 
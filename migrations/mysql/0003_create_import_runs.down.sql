@@ -0,0 +1,2 @@
+-- Import 실행 이력 테이블 삭제
+DROP TABLE IF EXISTS import_runs;
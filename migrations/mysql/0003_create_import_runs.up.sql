@@ -0,0 +1,31 @@
+-- Import 실행 이력 테이블 생성
+-- 마지막으로 성공한(outcome = 'success') 실행이 현재 서비스 중인 데이터셋 버전입니다.
+CREATE TABLE IF NOT EXISTS import_runs (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY COMMENT 'PK',
+
+    -- 대상 데이터
+    data_type VARCHAR(10) NOT NULL COMMENT '데이터 타입 (road, land, dataset)',
+    mode VARCHAR(10) NOT NULL COMMENT 'import 모드 (replace, diff)',
+    file_name VARCHAR(255) DEFAULT NULL COMMENT '파일 경로',
+    file_sha256 VARCHAR(64) DEFAULT NULL COMMENT '파일 SHA-256',
+    dataset_date VARCHAR(10) DEFAULT NULL COMMENT '배포 기준일 (YYYY-MM-DD)',
+
+    -- 실행 정보
+    started_at DATETIME(3) NOT NULL COMMENT '시작일시',
+    finished_at DATETIME(3) DEFAULT NULL COMMENT '종료일시',
+    operator VARCHAR(100) DEFAULT NULL COMMENT '실행자',
+    outcome VARCHAR(10) NOT NULL COMMENT '결과 (running, success, failed)',
+    error_message TEXT COMMENT '실패 사유',
+
+    -- 건수
+    inserted_count BIGINT NOT NULL DEFAULT 0 COMMENT '추가 건수',
+    updated_count BIGINT NOT NULL DEFAULT 0 COMMENT '변경 건수',
+    deleted_count BIGINT NOT NULL DEFAULT 0 COMMENT '삭제 건수',
+    rejected_count BIGINT NOT NULL DEFAULT 0 COMMENT '거부 건수',
+
+    -- 체크포인트 (중단된 import 재개용)
+    checkpoint_rows BIGINT NOT NULL DEFAULT 0 COMMENT '커밋된 배치까지 처리한 유효 행 수',
+    resumed_from_id BIGINT UNSIGNED DEFAULT NULL COMMENT '이어서 진행한 중단된 실행 ID',
+
+    INDEX idx_import_runs_outcome (outcome)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Import 실행 이력';
@@ -0,0 +1,2 @@
+-- Import 거부 라인 테이블 삭제
+DROP TABLE IF EXISTS postal_code_import_rejects;
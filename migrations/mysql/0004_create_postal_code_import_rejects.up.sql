@@ -0,0 +1,20 @@
+-- Import 거부 라인 격리 테이블 생성
+-- postalcode-import -rejects-table 사용 시 파싱/검증 실패 및 저장 실패 배치의 원본 라인을 기록합니다.
+CREATE TABLE IF NOT EXISTS postal_code_import_rejects (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY COMMENT 'PK',
+
+    run_id BIGINT UNSIGNED NOT NULL COMMENT 'import_runs.id (이력 기록 실패 시 0)',
+    data_type VARCHAR(10) NOT NULL COMMENT '데이터 타입 (road, land)',
+    file_name VARCHAR(255) DEFAULT NULL COMMENT '파일 경로',
+
+    -- 거부 정보
+    line BIGINT NOT NULL COMMENT '원본 파일 라인 번호 (헤더 = 1)',
+    field VARCHAR(40) DEFAULT NULL COMMENT '오류 필드',
+    value VARCHAR(255) DEFAULT NULL COMMENT '오류 필드 원본 값',
+    reason TEXT COMMENT '거부 사유',
+    record TEXT COMMENT '원본 라인 (파이프 구분, UTF-8)',
+
+    created_at DATETIME(3) DEFAULT NULL COMMENT '생성일시',
+
+    INDEX idx_import_rejects_run (run_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='Import 거부 라인';
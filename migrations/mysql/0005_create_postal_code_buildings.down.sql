@@ -0,0 +1,2 @@
+-- 건물 테이블 삭제
+DROP TABLE IF EXISTS postal_code_buildings;
@@ -0,0 +1,50 @@
+-- 건물 단위 주소 테이블 생성 (행정안전부 도로명주소 건물DB)
+CREATE TABLE IF NOT EXISTS postal_code_buildings (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY COMMENT 'PK',
+
+    -- 건물관리번호 (건물 고유 키)
+    building_management_number VARCHAR(25) NOT NULL COMMENT '건물관리번호 (25자리)',
+
+    -- 우편번호
+    zip_code VARCHAR(5) NOT NULL COMMENT '우편번호 (5자리)',
+    zip_prefix CHAR(3) NOT NULL COMMENT '우편번호 앞 3자리',
+
+    -- 행정구역
+    legal_dong_code VARCHAR(10) DEFAULT NULL COMMENT '법정동코드',
+    sido_name VARCHAR(40) NOT NULL COMMENT '시도명',
+    sigungu_name VARCHAR(40) DEFAULT NULL COMMENT '시군구명',
+    eupmyeondong_name VARCHAR(40) DEFAULT NULL COMMENT '법정읍면동명',
+    ri_name VARCHAR(40) DEFAULT NULL COMMENT '법정리명',
+    haengjeongdong_name VARCHAR(40) DEFAULT NULL COMMENT '행정동명',
+
+    -- 지번
+    is_mountain TINYINT(1) NOT NULL DEFAULT 0 COMMENT '산여부 (0=일반, 1=산)',
+    jibun_main INT DEFAULT NULL COMMENT '지번본번',
+    jibun_sub INT DEFAULT NULL COMMENT '지번부번',
+
+    -- 도로명
+    road_code VARCHAR(12) DEFAULT NULL COMMENT '도로명코드 (시군구코드 5자리 + 도로명번호 7자리)',
+    road_name VARCHAR(80) NOT NULL COMMENT '도로명',
+    is_underground TINYINT(1) NOT NULL DEFAULT 0 COMMENT '지하여부 (0=지상, 1=지하)',
+    building_main INT NOT NULL COMMENT '건물본번',
+    building_sub INT DEFAULT NULL COMMENT '건물부번',
+
+    -- 건물명
+    building_name VARCHAR(200) DEFAULT NULL COMMENT '시군구용 건물명',
+    building_ledger_name VARCHAR(200) DEFAULT NULL COMMENT '건축물대장 건물명',
+    detail_building_name VARCHAR(100) DEFAULT NULL COMMENT '상세건물명',
+    is_apartment TINYINT(1) NOT NULL DEFAULT 0 COMMENT '공동주택여부 (0=비공동주택, 1=공동주택)',
+
+    -- 타임스탬프
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '생성일시',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정일시',
+
+    -- 인덱스 (건물명/우편번호 조회 최적화)
+    INDEX idx_building_zipcode (zip_code),
+    INDEX idx_building_region (sido_name, sigungu_name),
+    INDEX idx_building_road_code (road_code),
+    INDEX idx_building_name (building_name),
+
+    -- 유니크 인덱스 (건물관리번호 기준 upsert)
+    UNIQUE INDEX idx_building_unique (building_management_number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='건물 단위 우편번호 및 주소 정보';
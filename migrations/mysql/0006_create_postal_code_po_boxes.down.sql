@@ -0,0 +1,2 @@
+-- 사서함 테이블 삭제
+DROP TABLE IF EXISTS postal_code_po_boxes;
@@ -0,0 +1,32 @@
+-- 사서함 우편번호 테이블 생성 (우정사업본부 사서함 우편번호 DB)
+CREATE TABLE IF NOT EXISTS postal_code_po_boxes (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY COMMENT 'PK',
+
+    -- 우편번호
+    zip_code VARCHAR(5) NOT NULL COMMENT '우편번호 (5자리)',
+    zip_prefix CHAR(3) NOT NULL COMMENT '우편번호 앞 3자리',
+
+    -- 행정구역 (사서함이 설치된 우체국 소재지)
+    sido_name VARCHAR(40) NOT NULL COMMENT '시도명',
+    sigungu_name VARCHAR(40) DEFAULT NULL COMMENT '시군구명',
+    eupmyeondong_name VARCHAR(40) DEFAULT NULL COMMENT '읍면동명',
+    ri_name VARCHAR(40) DEFAULT NULL COMMENT '리명',
+
+    -- 사서함
+    po_box_name VARCHAR(100) NOT NULL COMMENT '사서함명 (예: 광화문우체국사서함)',
+    start_number_main INT NOT NULL COMMENT '시작사서함번호(주)',
+    start_number_sub INT DEFAULT NULL COMMENT '시작사서함번호(부)',
+    end_number_main INT DEFAULT NULL COMMENT '끝사서함번호(주)',
+    end_number_sub INT DEFAULT NULL COMMENT '끝사서함번호(부)',
+
+    -- 타임스탬프
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '생성일시',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정일시',
+
+    -- 인덱스 (우편번호/사서함명 조회 최적화)
+    INDEX idx_pobox_zipcode (zip_code),
+    INDEX idx_pobox_name (po_box_name),
+
+    -- 유니크 인덱스 (중복 방지)
+    UNIQUE INDEX idx_pobox_unique (zip_code, po_box_name, start_number_main)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='사서함 우편번호 정보';
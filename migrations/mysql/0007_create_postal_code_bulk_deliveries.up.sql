@@ -0,0 +1,33 @@
+-- 다량배달처 우편번호 테이블 생성 (우정사업본부 다량배달처 우편번호 DB)
+CREATE TABLE IF NOT EXISTS postal_code_bulk_deliveries (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY COMMENT 'PK',
+
+    -- 우편번호
+    zip_code VARCHAR(5) NOT NULL COMMENT '우편번호 (5자리)',
+    zip_prefix CHAR(3) NOT NULL COMMENT '우편번호 앞 3자리',
+
+    -- 행정구역
+    sido_name VARCHAR(40) NOT NULL COMMENT '시도명',
+    sigungu_name VARCHAR(40) DEFAULT NULL COMMENT '시군구명',
+    eupmyeondong_name VARCHAR(40) DEFAULT NULL COMMENT '읍면동명',
+
+    -- 도로명주소
+    road_name VARCHAR(80) DEFAULT NULL COMMENT '도로명',
+    is_underground TINYINT(1) NOT NULL DEFAULT 0 COMMENT '지하여부 (0=지상, 1=지하)',
+    building_main INT DEFAULT NULL COMMENT '건물본번',
+    building_sub INT DEFAULT NULL COMMENT '건물부번',
+
+    -- 다량배달처
+    delivery_name VARCHAR(200) NOT NULL COMMENT '다량배달처명 (기관/기업명)',
+
+    -- 타임스탬프
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '생성일시',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정일시',
+
+    -- 인덱스 (우편번호/다량배달처명 조회 최적화)
+    INDEX idx_bulk_zipcode (zip_code),
+    INDEX idx_bulk_name (delivery_name),
+
+    -- 유니크 인덱스 (중복 방지)
+    UNIQUE INDEX idx_bulk_unique (zip_code, delivery_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='다량배달처 우편번호 정보';
@@ -0,0 +1,3 @@
+-- 통합 검색어(q)용 FULLTEXT 인덱스 삭제
+DROP INDEX idx_road_fulltext ON postal_code_roads;
+DROP INDEX idx_land_fulltext ON postal_code_lands;
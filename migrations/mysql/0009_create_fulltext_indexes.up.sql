@@ -0,0 +1,10 @@
+-- 통합 검색어(q)용 FULLTEXT 인덱스 생성 (MySQL, ngram parser)
+-- WithFullTextSearch 옵션을 사용한 Repository는 q 검색을 MATCH ... AGAINST (... IN BOOLEAN MODE)로 실행합니다.
+-- postalcode.CreateSearchIndexes와 같은 인덱스입니다.
+--
+-- 주의:
+--   - ngram parser는 MySQL 5.7.6 이상에 포함되어 있습니다. (토큰 크기: ngram_token_size, 기본 2)
+--   - 테이블에 FULLTEXT 인덱스가 처음 생기면 InnoDB가 테이블을 다시 만들므로 데이터가 많으면 시간이 걸립니다.
+
+CREATE FULLTEXT INDEX idx_road_fulltext ON postal_code_roads (road_name, sigungu_name, eupmyeon_name) WITH PARSER ngram;
+CREATE FULLTEXT INDEX idx_land_fulltext ON postal_code_lands (sigungu_name, eupmyeondong_name, ri_name) WITH PARSER ngram;
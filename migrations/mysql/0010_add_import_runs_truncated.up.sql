@@ -0,0 +1,5 @@
+-- import_runs에 truncated 컬럼 추가
+-- replace/데이터셋 import는 테이블을 비우기 전에 truncated = 1로 기록하므로,
+-- 첫 체크포인트 전에 중단된 실행도 테이블에 마지막으로 반영된 실행으로 조회됩니다. (재개 판단용)
+ALTER TABLE import_runs
+    ADD COLUMN truncated TINYINT(1) NOT NULL DEFAULT 0 COMMENT '대상 테이블을 비웠는지 여부' AFTER checkpoint_rows;
@@ -0,0 +1,59 @@
+-- 우편번호별 도로명 범위 테이블 생성 (PostgreSQL)
+CREATE TABLE IF NOT EXISTS postal_code_roads (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- 우편번호 (주 조회 키)
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+
+    -- 행정구역
+    sido_name VARCHAR(40) NOT NULL,
+    sido_name_en VARCHAR(40),
+    sigungu_name VARCHAR(40) NOT NULL,
+    sigungu_name_en VARCHAR(40),
+    eupmyeon_name VARCHAR(40),
+    eupmyeon_name_en VARCHAR(40),
+
+    -- 도로명
+    road_name VARCHAR(80) NOT NULL,
+    road_name_en VARCHAR(80),
+
+    -- 지하여부
+    is_underground BOOLEAN DEFAULT false,
+
+    -- 건물번호 범위
+    start_building_main INT NOT NULL,
+    start_building_sub INT,
+    end_building_main INT,
+    end_building_sub INT,
+
+    -- 범위종류 (1=단일번호, 2=구간, 3=다수)
+    range_type SMALLINT NOT NULL,
+
+    -- 타임스탬프
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE postal_code_roads IS '우편번호별 도로명 범위 정보';
+
+-- 인덱스 (우편번호 조회 최적화)
+CREATE INDEX IF NOT EXISTS idx_zipcode ON postal_code_roads (zip_code);
+CREATE INDEX IF NOT EXISTS idx_zip_prefix ON postal_code_roads (zip_prefix);
+CREATE INDEX IF NOT EXISTS idx_sido ON postal_code_roads (sido_name);
+CREATE INDEX IF NOT EXISTS idx_sigungu ON postal_code_roads (sigungu_name);
+CREATE INDEX IF NOT EXISTS idx_road ON postal_code_roads (road_name);
+
+-- 유니크 인덱스 (upsert의 ON CONFLICT 대상과 같은 컬럼이어야 함)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_postal_unique ON postal_code_roads (
+    zip_code, sido_name, sigungu_name, road_name, start_building_main
+);
+
+-- UPSERT 예시 (Repository.BatchCreate와 같은 형태)
+-- INSERT INTO postal_code_roads (zip_code, zip_prefix, sido_name, ..., range_type)
+-- VALUES (...), (...)
+-- ON CONFLICT (zip_code, sido_name, sigungu_name, road_name, start_building_main) DO UPDATE SET
+--     sido_name_en = EXCLUDED.sido_name_en,
+--     road_name_en = EXCLUDED.road_name_en,
+--     ...
+--     updated_at = EXCLUDED.updated_at;
@@ -0,0 +1,2 @@
+-- 우편번호별 지번주소 범위 테이블 삭제
+DROP TABLE IF EXISTS postal_code_lands;
@@ -0,0 +1,50 @@
+-- 우편번호별 지번주소 범위 테이블 생성 (PostgreSQL)
+CREATE TABLE IF NOT EXISTS postal_code_lands (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- 우편번호 (주 조회 키)
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+
+    -- 행정구역
+    sido_name VARCHAR(40) NOT NULL,
+    sido_name_en VARCHAR(40),
+    sigungu_name VARCHAR(40) NOT NULL,
+    sigungu_name_en VARCHAR(40),
+    eupmyeondong_name VARCHAR(40) NOT NULL,
+    eupmyeondong_name_en VARCHAR(40),
+
+    -- 리명
+    ri_name VARCHAR(40),
+
+    -- 산여부
+    is_mountain BOOLEAN DEFAULT false,
+
+    -- 행정동
+    haengjeongdong_name VARCHAR(40),
+
+    -- 주번지 범위
+    start_jibun_main INT NOT NULL,
+    start_jibun_sub INT,
+    end_jibun_main INT,
+    end_jibun_sub INT,
+
+    -- 타임스탬프
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE postal_code_lands IS '우편번호별 지번주소 범위 정보';
+
+-- 인덱스 (우편번호 조회 최적화)
+CREATE INDEX IF NOT EXISTS idx_land_zipcode ON postal_code_lands (zip_code);
+CREATE INDEX IF NOT EXISTS idx_land_zip_prefix ON postal_code_lands (zip_prefix);
+CREATE INDEX IF NOT EXISTS idx_land_sido ON postal_code_lands (sido_name);
+CREATE INDEX IF NOT EXISTS idx_land_sigungu ON postal_code_lands (sigungu_name);
+CREATE INDEX IF NOT EXISTS idx_land_eupmyeondong ON postal_code_lands (eupmyeondong_name);
+CREATE INDEX IF NOT EXISTS idx_land_ri ON postal_code_lands (ri_name);
+
+-- 유니크 인덱스 (upsert의 ON CONFLICT 대상과 같은 컬럼이어야 함)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_land_unique ON postal_code_lands (
+    zip_code, sido_name, sigungu_name, eupmyeondong_name, ri_name, is_mountain, start_jibun_main
+);
@@ -0,0 +1,32 @@
+-- Import 실행 이력 테이블 생성 (PostgreSQL)
+CREATE TABLE IF NOT EXISTS import_runs (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- 대상 데이터
+    data_type VARCHAR(10) NOT NULL,
+    mode VARCHAR(10) NOT NULL,
+    file_name VARCHAR(255),
+    file_sha256 VARCHAR(64),
+    dataset_date VARCHAR(10),
+
+    -- 실행 정보
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    operator VARCHAR(100),
+    outcome VARCHAR(10) NOT NULL,
+    error_message TEXT,
+
+    -- 건수
+    inserted_count BIGINT NOT NULL DEFAULT 0,
+    updated_count BIGINT NOT NULL DEFAULT 0,
+    deleted_count BIGINT NOT NULL DEFAULT 0,
+    rejected_count BIGINT NOT NULL DEFAULT 0,
+
+    -- 체크포인트 (중단된 import 재개용)
+    checkpoint_rows BIGINT NOT NULL DEFAULT 0,
+    resumed_from_id BIGINT
+);
+
+COMMENT ON TABLE import_runs IS 'Import 실행 이력';
+
+CREATE INDEX IF NOT EXISTS idx_import_runs_outcome ON import_runs (outcome);
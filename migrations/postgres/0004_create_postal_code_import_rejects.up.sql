@@ -0,0 +1,19 @@
+-- Import 거부 라인 테이블 생성 (PostgreSQL)
+CREATE TABLE IF NOT EXISTS postal_code_import_rejects (
+    id BIGSERIAL PRIMARY KEY,
+    run_id BIGINT NOT NULL,
+    data_type VARCHAR(10) NOT NULL,
+    file_name VARCHAR(255),
+
+    -- 거부 정보
+    line BIGINT NOT NULL,
+    field VARCHAR(40),
+    value VARCHAR(255),
+    reason TEXT,
+    record TEXT,
+    created_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE postal_code_import_rejects IS 'Import 거부 라인';
+
+CREATE INDEX IF NOT EXISTS idx_import_rejects_run ON postal_code_import_rejects (run_id);
@@ -0,0 +1,52 @@
+-- 건물 단위 우편번호 및 주소 테이블 생성 (PostgreSQL)
+CREATE TABLE IF NOT EXISTS postal_code_buildings (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- 건물관리번호 (건물 고유 키)
+    building_management_number VARCHAR(25) NOT NULL,
+
+    -- 우편번호
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+
+    -- 행정구역
+    legal_dong_code VARCHAR(10),
+    sido_name VARCHAR(40) NOT NULL,
+    sigungu_name VARCHAR(40),
+    eupmyeondong_name VARCHAR(40),
+    ri_name VARCHAR(40),
+    haengjeongdong_name VARCHAR(40),
+
+    -- 지번
+    is_mountain BOOLEAN DEFAULT false,
+    jibun_main INT,
+    jibun_sub INT,
+
+    -- 도로명
+    road_code VARCHAR(12),
+    road_name VARCHAR(80) NOT NULL,
+    is_underground BOOLEAN DEFAULT false,
+    building_main INT NOT NULL,
+    building_sub INT,
+
+    -- 건물명
+    building_name VARCHAR(200),
+    building_ledger_name VARCHAR(200),
+    detail_building_name VARCHAR(100),
+    is_apartment BOOLEAN DEFAULT false,
+
+    -- 타임스탬프
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE postal_code_buildings IS '건물 단위 우편번호 및 주소 정보';
+
+-- 인덱스 (건물명/우편번호 조회 최적화)
+CREATE INDEX IF NOT EXISTS idx_building_zipcode ON postal_code_buildings (zip_code);
+CREATE INDEX IF NOT EXISTS idx_building_region ON postal_code_buildings (sido_name, sigungu_name);
+CREATE INDEX IF NOT EXISTS idx_building_road_code ON postal_code_buildings (road_code);
+CREATE INDEX IF NOT EXISTS idx_building_name ON postal_code_buildings (building_name);
+
+-- 유니크 인덱스 (건물관리번호 기준 upsert)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_building_unique ON postal_code_buildings (building_management_number);
@@ -0,0 +1,34 @@
+-- 사서함 우편번호 테이블 생성 (PostgreSQL)
+CREATE TABLE IF NOT EXISTS postal_code_po_boxes (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- 우편번호
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+
+    -- 행정구역 (사서함이 설치된 우체국 소재지)
+    sido_name VARCHAR(40) NOT NULL,
+    sigungu_name VARCHAR(40),
+    eupmyeondong_name VARCHAR(40),
+    ri_name VARCHAR(40),
+
+    -- 사서함
+    po_box_name VARCHAR(100) NOT NULL,
+    start_number_main INT NOT NULL,
+    start_number_sub INT,
+    end_number_main INT,
+    end_number_sub INT,
+
+    -- 타임스탬프
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE postal_code_po_boxes IS '사서함 우편번호 정보';
+
+-- 인덱스 (우편번호/사서함명 조회 최적화)
+CREATE INDEX IF NOT EXISTS idx_pobox_zipcode ON postal_code_po_boxes (zip_code);
+CREATE INDEX IF NOT EXISTS idx_pobox_name ON postal_code_po_boxes (po_box_name);
+
+-- 유니크 인덱스 (중복 방지)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pobox_unique ON postal_code_po_boxes (zip_code, po_box_name, start_number_main);
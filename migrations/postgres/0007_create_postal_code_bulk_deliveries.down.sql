@@ -0,0 +1,2 @@
+-- 다량배달처 테이블 삭제
+DROP TABLE IF EXISTS postal_code_bulk_deliveries;
@@ -0,0 +1,35 @@
+-- 다량배달처 우편번호 테이블 생성 (PostgreSQL)
+CREATE TABLE IF NOT EXISTS postal_code_bulk_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- 우편번호
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+
+    -- 행정구역
+    sido_name VARCHAR(40) NOT NULL,
+    sigungu_name VARCHAR(40),
+    eupmyeondong_name VARCHAR(40),
+
+    -- 도로명주소
+    road_name VARCHAR(80),
+    is_underground BOOLEAN DEFAULT false,
+    building_main INT,
+    building_sub INT,
+
+    -- 다량배달처
+    delivery_name VARCHAR(200) NOT NULL,
+
+    -- 타임스탬프
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE postal_code_bulk_deliveries IS '다량배달처 우편번호 정보';
+
+-- 인덱스 (우편번호/다량배달처명 조회 최적화)
+CREATE INDEX IF NOT EXISTS idx_bulk_zipcode ON postal_code_bulk_deliveries (zip_code);
+CREATE INDEX IF NOT EXISTS idx_bulk_name ON postal_code_bulk_deliveries (delivery_name);
+
+-- 유니크 인덱스 (중복 방지)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bulk_unique ON postal_code_bulk_deliveries (zip_code, delivery_name);
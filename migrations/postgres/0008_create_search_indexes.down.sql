@@ -0,0 +1,6 @@
+-- 부분 문자열 검색용 trigram 인덱스 삭제
+-- pg_trgm 확장은 다른 객체가 사용할 수 있으므로 삭제하지 않습니다.
+DROP INDEX IF EXISTS idx_road_road_name_trgm;
+DROP INDEX IF EXISTS idx_road_sigungu_name_trgm;
+DROP INDEX IF EXISTS idx_land_sigungu_name_trgm;
+DROP INDEX IF EXISTS idx_land_eupmyeondong_name_trgm;
@@ -0,0 +1,15 @@
+-- 부분 문자열 검색용 trigram 인덱스 생성 (PostgreSQL)
+-- 도로명/지번주소 검색(ILIKE '%...%')은 B-tree 인덱스를 쓸 수 없으므로 pg_trgm GIN 인덱스로 가속합니다.
+-- postalcode.CreateSearchIndexes와 같은 인덱스입니다.
+--
+-- 주의:
+--   - 확장 생성에는 DB의 CREATE 권한이 필요합니다.
+--   - 한글 trigram은 DB 인코딩이 UTF8이고 로캘이 C가 아닌 경우(ko_KR.UTF-8, en_US.UTF-8 등)에만 만들어집니다.
+--   - 검색어가 3글자 미만이면 trigram이 없어 순차 스캔합니다.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_road_road_name_trgm ON postal_code_roads USING gin (road_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_road_sigungu_name_trgm ON postal_code_roads USING gin (sigungu_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_land_sigungu_name_trgm ON postal_code_lands USING gin (sigungu_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_land_eupmyeondong_name_trgm ON postal_code_lands USING gin (eupmyeondong_name gin_trgm_ops);
@@ -0,0 +1,3 @@
+-- 통합 검색어(q)용 trigram 인덱스 삭제
+DROP INDEX IF EXISTS idx_road_eupmyeon_name_trgm;
+DROP INDEX IF EXISTS idx_land_ri_name_trgm;
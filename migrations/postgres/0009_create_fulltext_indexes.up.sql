@@ -0,0 +1,7 @@
+-- 통합 검색어(q)용 trigram 인덱스 생성 (PostgreSQL)
+-- q 검색은 도로명/시군구/읍면(지번주소는 시군구/읍면동/리) 컬럼의 ILIKE 조건을 OR로 묶으므로,
+-- 0008에서 만들지 않은 읍면/리 컬럼에도 trigram 인덱스를 만들어 모든 컬럼이 인덱스를 사용하도록 합니다.
+-- postalcode.CreateSearchIndexes와 같은 인덱스입니다.
+
+CREATE INDEX IF NOT EXISTS idx_road_eupmyeon_name_trgm ON postal_code_roads USING gin (eupmyeon_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_land_ri_name_trgm ON postal_code_lands USING gin (ri_name gin_trgm_ops);
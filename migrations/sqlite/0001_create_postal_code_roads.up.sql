@@ -0,0 +1,29 @@
+-- 우편번호별 도로명 범위 테이블 생성 (SQLite)
+CREATE TABLE IF NOT EXISTS postal_code_roads (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+    sido_name VARCHAR(40) NOT NULL,
+    sido_name_en VARCHAR(40),
+    sigungu_name VARCHAR(40) NOT NULL,
+    sigungu_name_en VARCHAR(40),
+    eupmyeon_name VARCHAR(40),
+    eupmyeon_name_en VARCHAR(40),
+    road_name VARCHAR(80) NOT NULL,
+    road_name_en VARCHAR(80),
+    is_underground NUMERIC DEFAULT false,
+    start_building_main INTEGER NOT NULL,
+    start_building_sub INTEGER,
+    end_building_main INTEGER,
+    end_building_sub INTEGER,
+    range_type INTEGER NOT NULL,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_road ON postal_code_roads (road_name);
+CREATE INDEX IF NOT EXISTS idx_sido ON postal_code_roads (sido_name);
+CREATE INDEX IF NOT EXISTS idx_sigungu ON postal_code_roads (sigungu_name);
+CREATE INDEX IF NOT EXISTS idx_zip_prefix ON postal_code_roads (zip_prefix);
+CREATE INDEX IF NOT EXISTS idx_zipcode ON postal_code_roads (zip_code);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_postal_unique ON postal_code_roads (zip_code, sido_name, sigungu_name, road_name, start_building_main);
@@ -0,0 +1,29 @@
+-- 우편번호별 지번주소 범위 테이블 생성 (SQLite)
+CREATE TABLE IF NOT EXISTS postal_code_lands (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+    sido_name VARCHAR(40) NOT NULL,
+    sido_name_en VARCHAR(40),
+    sigungu_name VARCHAR(40) NOT NULL,
+    sigungu_name_en VARCHAR(40),
+    eupmyeondong_name VARCHAR(40) NOT NULL,
+    eupmyeondong_name_en VARCHAR(40),
+    ri_name VARCHAR(40),
+    is_mountain NUMERIC DEFAULT false,
+    haengjeongdong_name VARCHAR(40),
+    start_jibun_main INTEGER NOT NULL,
+    start_jibun_sub INTEGER,
+    end_jibun_main INTEGER,
+    end_jibun_sub INTEGER,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_land_eupmyeondong ON postal_code_lands (eupmyeondong_name);
+CREATE INDEX IF NOT EXISTS idx_land_ri ON postal_code_lands (ri_name);
+CREATE INDEX IF NOT EXISTS idx_land_sido ON postal_code_lands (sido_name);
+CREATE INDEX IF NOT EXISTS idx_land_sigungu ON postal_code_lands (sigungu_name);
+CREATE INDEX IF NOT EXISTS idx_land_zip_prefix ON postal_code_lands (zip_prefix);
+CREATE INDEX IF NOT EXISTS idx_land_zipcode ON postal_code_lands (zip_code);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_land_unique ON postal_code_lands (zip_code, sido_name, sigungu_name, eupmyeondong_name, ri_name, is_mountain, start_jibun_main);
@@ -0,0 +1,22 @@
+-- Import 실행 이력 테이블 생성 (SQLite)
+CREATE TABLE IF NOT EXISTS import_runs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    data_type VARCHAR(10) NOT NULL,
+    mode VARCHAR(10) NOT NULL,
+    file_name VARCHAR(255),
+    file_sha256 VARCHAR(64),
+    dataset_date VARCHAR(10),
+    started_at DATETIME NOT NULL,
+    finished_at DATETIME,
+    operator VARCHAR(100),
+    outcome VARCHAR(10) NOT NULL,
+    error_message TEXT,
+    inserted_count INTEGER,
+    updated_count INTEGER,
+    deleted_count INTEGER,
+    rejected_count INTEGER,
+    checkpoint_rows INTEGER,
+    resumed_from_id INTEGER
+);
+
+CREATE INDEX IF NOT EXISTS idx_import_runs_outcome ON import_runs (outcome);
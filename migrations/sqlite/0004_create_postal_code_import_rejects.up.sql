@@ -0,0 +1,15 @@
+-- Import 거부 라인 테이블 생성 (SQLite)
+CREATE TABLE IF NOT EXISTS postal_code_import_rejects (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    run_id INTEGER NOT NULL,
+    data_type VARCHAR(10) NOT NULL,
+    file_name VARCHAR(255),
+    line INTEGER,
+    field VARCHAR(40),
+    value VARCHAR(255),
+    reason TEXT,
+    record TEXT,
+    created_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_import_rejects_run ON postal_code_import_rejects (run_id);
@@ -0,0 +1,33 @@
+-- 건물 단위 우편번호 및 주소 테이블 생성 (SQLite)
+CREATE TABLE IF NOT EXISTS postal_code_buildings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    building_management_number VARCHAR(25) NOT NULL,
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+    legal_dong_code VARCHAR(10),
+    sido_name VARCHAR(40) NOT NULL,
+    sigungu_name VARCHAR(40),
+    eupmyeondong_name VARCHAR(40),
+    ri_name VARCHAR(40),
+    haengjeongdong_name VARCHAR(40),
+    is_mountain NUMERIC DEFAULT false,
+    jibun_main INTEGER,
+    jibun_sub INTEGER,
+    road_code VARCHAR(12),
+    road_name VARCHAR(80) NOT NULL,
+    is_underground NUMERIC DEFAULT false,
+    building_main INTEGER NOT NULL,
+    building_sub INTEGER,
+    building_name VARCHAR(200),
+    building_ledger_name VARCHAR(200),
+    detail_building_name VARCHAR(100),
+    is_apartment NUMERIC DEFAULT false,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_building_name ON postal_code_buildings (building_name);
+CREATE INDEX IF NOT EXISTS idx_building_region ON postal_code_buildings (sido_name, sigungu_name);
+CREATE INDEX IF NOT EXISTS idx_building_road_code ON postal_code_buildings (road_code);
+CREATE INDEX IF NOT EXISTS idx_building_zipcode ON postal_code_buildings (zip_code);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_building_unique ON postal_code_buildings (building_management_number);
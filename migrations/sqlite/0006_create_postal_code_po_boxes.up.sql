@@ -0,0 +1,21 @@
+-- 사서함 우편번호 테이블 생성 (SQLite)
+CREATE TABLE IF NOT EXISTS postal_code_po_boxes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+    sido_name VARCHAR(40) NOT NULL,
+    sigungu_name VARCHAR(40),
+    eupmyeondong_name VARCHAR(40),
+    ri_name VARCHAR(40),
+    po_box_name VARCHAR(100) NOT NULL,
+    start_number_main INTEGER NOT NULL,
+    start_number_sub INTEGER,
+    end_number_main INTEGER,
+    end_number_sub INTEGER,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_pobox_name ON postal_code_po_boxes (po_box_name);
+CREATE INDEX IF NOT EXISTS idx_pobox_zipcode ON postal_code_po_boxes (zip_code);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pobox_unique ON postal_code_po_boxes (zip_code, po_box_name, start_number_main);
@@ -0,0 +1,20 @@
+-- 다량배달처 우편번호 테이블 생성 (SQLite)
+CREATE TABLE IF NOT EXISTS postal_code_bulk_deliveries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    zip_code VARCHAR(5) NOT NULL,
+    zip_prefix CHAR(3) NOT NULL,
+    sido_name VARCHAR(40) NOT NULL,
+    sigungu_name VARCHAR(40),
+    eupmyeondong_name VARCHAR(40),
+    road_name VARCHAR(80),
+    is_underground NUMERIC DEFAULT false,
+    building_main INTEGER,
+    building_sub INTEGER,
+    delivery_name VARCHAR(200) NOT NULL,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_bulk_name ON postal_code_bulk_deliveries (delivery_name);
+CREATE INDEX IF NOT EXISTS idx_bulk_zipcode ON postal_code_bulk_deliveries (zip_code);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bulk_unique ON postal_code_bulk_deliveries (zip_code, delivery_name);
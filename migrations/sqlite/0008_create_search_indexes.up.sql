@@ -0,0 +1,2 @@
+-- 부분 문자열 검색용 trigram 인덱스 (PostgreSQL 전용)
+-- 이 dialect에는 해당 인덱스가 없으므로 아무것도 실행하지 않습니다. (버전 번호를 dialect 간에 맞추기 위한 빈 마이그레이션)
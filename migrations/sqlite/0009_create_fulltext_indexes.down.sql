@@ -0,0 +1,2 @@
+-- 통합 검색어(q)용 전문 검색 인덱스 (MySQL FULLTEXT, PostgreSQL trigram)
+-- SQLite는 Repository가 프로세스 안에 역색인을 만들므로 아무것도 실행하지 않습니다. (버전 번호를 dialect 간에 맞추기 위한 빈 마이그레이션)
@@ -0,0 +1,2 @@
+-- import_runs의 truncated 컬럼 삭제 (SQLite 3.35 이상)
+ALTER TABLE import_runs DROP COLUMN truncated;
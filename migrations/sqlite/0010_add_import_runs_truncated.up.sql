@@ -0,0 +1,4 @@
+-- import_runs에 truncated 컬럼 추가 (SQLite)
+-- replace/데이터셋 import는 테이블을 비우기 전에 truncated = 1로 기록하므로,
+-- 첫 체크포인트 전에 중단된 실행도 테이블에 마지막으로 반영된 실행으로 조회됩니다. (재개 판단용)
+ALTER TABLE import_runs ADD COLUMN truncated NUMERIC NOT NULL DEFAULT false;
@@ -1,6 +1,8 @@
 package postalcode
 
 import (
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -27,7 +29,7 @@ type PostalCodeRoad struct {
 	RoadNameEn string `json:"road_name_en" gorm:"type:varchar(80)" example:"Samyang-ro 177-gil"`
 
 	// 지하여부
-	IsUnderground bool `json:"is_underground" gorm:"type:tinyint(1);default:0" example:"false"`
+	IsUnderground bool `json:"is_underground" gorm:"default:false" example:"false"`
 
 	// 건물번호 범위
 	StartBuildingMain int  `json:"start_building_main" gorm:"type:int;not null;uniqueIndex:idx_postal_unique,priority:5" example:"93"`
@@ -36,7 +38,7 @@ type PostalCodeRoad struct {
 	EndBuildingSub    *int `json:"end_building_sub" gorm:"type:int" example:"0"`
 
 	// 범위종류
-	RangeType int8 `json:"range_type" gorm:"type:tinyint;not null" example:"3"`
+	RangeType int8 `json:"range_type" gorm:"not null" example:"3"`
 
 	// 타임스탬프
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime" example:"2024-01-01T00:00:00Z"`
@@ -56,6 +58,7 @@ type SearchParams struct {
 	SidoName    string `json:"sido_name" form:"sido_name" example:"서울특별시"`
 	SigunguName string `json:"sigungu_name" form:"sigungu_name" example:"강북구"`
 	RoadName    string `json:"road_name" form:"road_name" example:"삼양로"`
+	Query       string `json:"q" form:"q" example:"강북구 삼양로"` // 통합 검색어 (공백으로 나눈 단어가 모두 도로명/시군구명/읍면명 중 하나에 포함)
 	Page        int    `json:"page" form:"page" example:"1"`
 	Limit       int    `json:"limit" form:"limit" example:"10"`
 }
@@ -82,7 +85,7 @@ type PostalCodeLand struct {
 	RiName string `json:"ri_name" gorm:"type:varchar(40);index:idx_land_ri;uniqueIndex:idx_land_unique,priority:5" example:"모전리"`
 
 	// 산여부
-	IsMountain bool `json:"is_mountain" gorm:"type:tinyint(1);default:0;uniqueIndex:idx_land_unique,priority:6" example:"false"`
+	IsMountain bool `json:"is_mountain" gorm:"default:false;uniqueIndex:idx_land_unique,priority:6" example:"false"`
 
 	// 행정동
 	HaengjeongdongName string `json:"haengjeongdong_name" gorm:"type:varchar(40)" example:""`
@@ -112,10 +115,271 @@ type SearchParamsLand struct {
 	SigunguName      string `json:"sigungu_name" form:"sigungu_name" example:"강릉시"`
 	EupmyeondongName string `json:"eupmyeondong_name" form:"eupmyeondong_name" example:"강동면"`
 	RiName           string `json:"ri_name" form:"ri_name" example:"모전리"`
+	Query            string `json:"q" form:"q" example:"강릉시 모전리"` // 통합 검색어 (공백으로 나눈 단어가 모두 시군구명/읍면동명/리명 중 하나에 포함)
 	Page             int    `json:"page" form:"page" example:"1"`
 	Limit            int    `json:"limit" form:"limit" example:"10"`
 }
 
+// ============================================================
+// 건물 (Building)
+// ============================================================
+
+// PostalCodeBuilding은 건물 단위 주소 정보를 나타냅니다.
+// 행정안전부 도로명주소 건물DB(건물관리번호 단위)를 저장하며, 건물명으로 정확한 우편번호를 찾을 때 사용합니다.
+// @Description 건물 단위 우편번호 및 도로명/지번 주소 정보 (행정안전부 도로명주소 건물DB)
+type PostalCodeBuilding struct {
+	ID uint `json:"id" gorm:"primaryKey;autoIncrement" example:"1"`
+
+	// 건물관리번호 (건물 고유 키, 25자리)
+	BuildingManagementNumber string `json:"building_management_number" gorm:"type:varchar(25);not null;uniqueIndex:idx_building_unique" example:"1165010700100200000000001"`
+
+	// 우편번호
+	ZipCode   string `json:"zip_code" gorm:"type:varchar(5);not null;index:idx_building_zipcode" example:"06506"`
+	ZipPrefix string `json:"zip_prefix" gorm:"type:char(3);not null" example:"065"`
+
+	// 행정구역
+	LegalDongCode      string `json:"legal_dong_code" gorm:"type:varchar(10)" example:"1165010700"`
+	SidoName           string `json:"sido_name" gorm:"type:varchar(40);not null;index:idx_building_region,priority:1" example:"서울특별시"`
+	SigunguName        string `json:"sigungu_name" gorm:"type:varchar(40);index:idx_building_region,priority:2" example:"서초구"`
+	EupmyeondongName   string `json:"eupmyeondong_name" gorm:"type:varchar(40)" example:"반포동"`
+	RiName             string `json:"ri_name" gorm:"type:varchar(40)" example:""`
+	HaengjeongdongName string `json:"haengjeongdong_name" gorm:"type:varchar(40)" example:"반포2동"`
+
+	// 지번
+	IsMountain bool `json:"is_mountain" gorm:"default:false" example:"false"`
+	JibunMain  int  `json:"jibun_main" gorm:"type:int" example:"20"`
+	JibunSub   *int `json:"jibun_sub" gorm:"type:int" example:"43"`
+
+	// 도로명 (도로명코드: 시군구코드 5자리 + 도로명번호 7자리)
+	RoadCode      string `json:"road_code" gorm:"type:varchar(12);index:idx_building_road_code" example:"116504163213"`
+	RoadName      string `json:"road_name" gorm:"type:varchar(80);not null" example:"반포대로"`
+	IsUnderground bool   `json:"is_underground" gorm:"default:false" example:"false"`
+	BuildingMain  int    `json:"building_main" gorm:"type:int;not null" example:"275"`
+	BuildingSub   *int   `json:"building_sub" gorm:"type:int" example:"0"`
+
+	// 건물명
+	BuildingName       string `json:"building_name" gorm:"type:varchar(200);index:idx_building_name" example:"래미안퍼스티지"` // 시군구용 건물명
+	BuildingLedgerName string `json:"building_ledger_name" gorm:"type:varchar(200)" example:"래미안 퍼스티지"`                 // 건축물대장 건물명
+	DetailBuildingName string `json:"detail_building_name" gorm:"type:varchar(100)" example:"101동"`                     // 상세건물명
+	IsApartment        bool   `json:"is_apartment" gorm:"default:false" example:"true"`                                 // 공동주택여부
+
+	// 타임스탬프
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime" example:"2024-01-01T00:00:00Z"`
+}
+
+// TableName은 테이블 이름을 명시적으로 지정합니다.
+func (PostalCodeBuilding) TableName() string {
+	return "postal_code_buildings"
+}
+
+// RoadAddress는 도로명주소를 반환합니다.
+// 예: "서울특별시 서초구 반포대로 275 (반포동, 래미안퍼스티지)"
+//
+// 읍/면 지역은 도로명 앞에 읍면명을 붙이고, 참고항목(괄호)에는 동 지역의 법정동명과 공동주택의 건물명을 적습니다.
+func (b *PostalCodeBuilding) RoadAddress() string {
+	town := isTownName(b.EupmyeondongName)
+	parts := []string{b.SidoName, b.SigunguName}
+	if town {
+		parts = append(parts, b.EupmyeondongName)
+	}
+	parts = append(parts, b.RoadName)
+	if b.IsUnderground {
+		parts = append(parts, "지하")
+	}
+	parts = append(parts, addressNumber(b.BuildingMain, b.BuildingSub))
+	address := joinAddress(parts...)
+
+	var notes []string
+	if !town && b.EupmyeondongName != "" {
+		notes = append(notes, b.EupmyeondongName)
+	}
+	if b.IsApartment && b.BuildingName != "" {
+		notes = append(notes, b.BuildingName)
+	}
+	if len(notes) > 0 {
+		address += " (" + strings.Join(notes, ", ") + ")"
+	}
+	return address
+}
+
+// JibunAddress는 지번주소를 반환합니다.
+// 예: "서울특별시 서초구 반포동 20-43 래미안퍼스티지"
+func (b *PostalCodeBuilding) JibunAddress() string {
+	number := addressNumber(b.JibunMain, b.JibunSub)
+	if b.IsMountain {
+		number = "산" + number
+	}
+	return joinAddress(b.SidoName, b.SigunguName, b.EupmyeondongName, b.RiName, number, b.BuildingName)
+}
+
+// isTownName은 법정읍면동명이 읍 또는 면인지 확인합니다.
+func isTownName(name string) bool {
+	return strings.HasSuffix(name, "읍") || strings.HasSuffix(name, "면")
+}
+
+// addressNumber는 본번과 부번을 "본번-부번" 형식으로 반환합니다. 부번이 없거나 0이면 본번만 반환합니다.
+func addressNumber(main int, sub *int) string {
+	if sub == nil || *sub == 0 {
+		return strconv.Itoa(main)
+	}
+	return strconv.Itoa(main) + "-" + strconv.Itoa(*sub)
+}
+
+// joinAddress는 빈 값을 제외한 주소 구성요소를 공백으로 연결합니다.
+func joinAddress(parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, " ")
+}
+
+// SearchParamsBuilding은 건물명 검색 파라미터입니다.
+// @Description 건물명 검색 파라미터
+type SearchParamsBuilding struct {
+	BuildingName string `json:"building_name" form:"building_name" example:"래미안퍼스티지"` // 공백 무시 부분 매칭 (시군구용 건물명, 건축물대장 건물명)
+	ZipCode      string `json:"zip_code" form:"zip_code" example:"06506"`
+	SidoName     string `json:"sido_name" form:"sido_name" example:"서울특별시"`
+	SigunguName  string `json:"sigungu_name" form:"sigungu_name" example:"서초구"`
+	Page         int    `json:"page" form:"page" example:"1"`
+	Limit        int    `json:"limit" form:"limit" example:"10"`
+}
+
+// BuildingAddress는 건물명 검색 결과입니다. 건물 정보에 도로명주소와 지번주소를 함께 담습니다.
+// @Description 건물명 검색 결과 (건물 정보 + 도로명/지번 주소)
+type BuildingAddress struct {
+	PostalCodeBuilding
+	RoadAddress  string `json:"road_address" example:"서울특별시 서초구 반포대로 275 (반포동, 래미안퍼스티지)"`
+	JibunAddress string `json:"jibun_address" example:"서울특별시 서초구 반포동 20-43 래미안퍼스티지"`
+}
+
+// NewBuildingAddress는 건물 정보로 BuildingAddress를 생성합니다.
+func NewBuildingAddress(building PostalCodeBuilding) BuildingAddress {
+	return BuildingAddress{
+		PostalCodeBuilding: building,
+		RoadAddress:        building.RoadAddress(),
+		JibunAddress:       building.JibunAddress(),
+	}
+}
+
+// ============================================================
+// 사서함 / 다량배달처 (PO Box / Bulk Delivery)
+// ============================================================
+
+// PostalCodePOBox는 사서함 우편번호 정보를 나타냅니다.
+// 우체국 사서함은 도로명/지번 범위에 속하지 않는 별도의 우편번호를 가지며, 사서함 번호 범위 단위로 저장합니다.
+// @Description 사서함 우편번호 정보 (우정사업본부 사서함 우편번호 데이터)
+type PostalCodePOBox struct {
+	ID uint `json:"id" gorm:"primaryKey;autoIncrement" example:"1"`
+
+	// 우편번호 (주 조회 키)
+	ZipCode   string `json:"zip_code" gorm:"type:varchar(5);not null;index:idx_pobox_zipcode;uniqueIndex:idx_pobox_unique,priority:1" example:"03154"`
+	ZipPrefix string `json:"zip_prefix" gorm:"type:char(3);not null" example:"031"`
+
+	// 행정구역 (사서함이 설치된 우체국 소재지)
+	SidoName         string `json:"sido_name" gorm:"type:varchar(40);not null" example:"서울특별시"`
+	SigunguName      string `json:"sigungu_name" gorm:"type:varchar(40)" example:"종로구"`
+	EupmyeondongName string `json:"eupmyeondong_name" gorm:"type:varchar(40)" example:"세종로"`
+	RiName           string `json:"ri_name" gorm:"type:varchar(40)" example:""`
+
+	// 사서함명 (예: 광화문우체국사서함)
+	POBoxName string `json:"po_box_name" gorm:"column:po_box_name;type:varchar(100);not null;index:idx_pobox_name;uniqueIndex:idx_pobox_unique,priority:2" example:"광화문우체국사서함"`
+
+	// 사서함 번호 범위
+	StartNumberMain int  `json:"start_number_main" gorm:"type:int;not null;uniqueIndex:idx_pobox_unique,priority:3" example:"1"`
+	StartNumberSub  *int `json:"start_number_sub" gorm:"type:int" example:"0"`
+	EndNumberMain   *int `json:"end_number_main" gorm:"type:int" example:"100"`
+	EndNumberSub    *int `json:"end_number_sub" gorm:"type:int" example:"0"`
+
+	// 타임스탬프
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime" example:"2024-01-01T00:00:00Z"`
+}
+
+// TableName은 테이블 이름을 명시적으로 지정합니다.
+func (PostalCodePOBox) TableName() string {
+	return "postal_code_po_boxes"
+}
+
+// SearchParamsPOBox는 사서함 검색 파라미터입니다.
+// @Description 사서함 우편번호 검색 파라미터
+type SearchParamsPOBox struct {
+	ZipCode     string `json:"zip_code" form:"zip_code" example:"03154"`
+	SidoName    string `json:"sido_name" form:"sido_name" example:"서울특별시"`
+	SigunguName string `json:"sigungu_name" form:"sigungu_name" example:"종로구"`
+	POBoxName   string `json:"po_box_name" form:"po_box_name" example:"광화문"` // 부분 매칭
+	Page        int    `json:"page" form:"page" example:"1"`
+	Limit       int    `json:"limit" form:"limit" example:"10"`
+}
+
+// PostalCodeBulkDelivery는 다량배달처 우편번호 정보를 나타냅니다.
+// 우편물이 많은 기관/기업(다량배달처)은 건물 단위로 전용 우편번호를 가집니다.
+// @Description 다량배달처 우편번호 정보 (우정사업본부 다량배달처 우편번호 데이터)
+type PostalCodeBulkDelivery struct {
+	ID uint `json:"id" gorm:"primaryKey;autoIncrement" example:"1"`
+
+	// 우편번호 (주 조회 키)
+	ZipCode   string `json:"zip_code" gorm:"type:varchar(5);not null;index:idx_bulk_zipcode;uniqueIndex:idx_bulk_unique,priority:1" example:"03171"`
+	ZipPrefix string `json:"zip_prefix" gorm:"type:char(3);not null" example:"031"`
+
+	// 행정구역
+	SidoName         string `json:"sido_name" gorm:"type:varchar(40);not null" example:"서울특별시"`
+	SigunguName      string `json:"sigungu_name" gorm:"type:varchar(40)" example:"종로구"`
+	EupmyeondongName string `json:"eupmyeondong_name" gorm:"type:varchar(40)" example:"세종로"`
+
+	// 도로명주소
+	RoadName      string `json:"road_name" gorm:"type:varchar(80)" example:"세종대로"`
+	IsUnderground bool   `json:"is_underground" gorm:"default:false" example:"false"`
+	BuildingMain  int    `json:"building_main" gorm:"type:int" example:"209"`
+	BuildingSub   *int   `json:"building_sub" gorm:"type:int" example:"0"`
+
+	// 다량배달처명 (기관/기업명)
+	DeliveryName string `json:"delivery_name" gorm:"type:varchar(200);not null;index:idx_bulk_name;uniqueIndex:idx_bulk_unique,priority:2" example:"정부서울청사"`
+
+	// 타임스탬프
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime" example:"2024-01-01T00:00:00Z"`
+}
+
+// TableName은 테이블 이름을 명시적으로 지정합니다.
+func (PostalCodeBulkDelivery) TableName() string {
+	return "postal_code_bulk_deliveries"
+}
+
+// SearchParamsBulkDelivery는 다량배달처 검색 파라미터입니다.
+// @Description 다량배달처 우편번호 검색 파라미터
+type SearchParamsBulkDelivery struct {
+	ZipCode      string `json:"zip_code" form:"zip_code" example:"03171"`
+	SidoName     string `json:"sido_name" form:"sido_name" example:"서울특별시"`
+	SigunguName  string `json:"sigungu_name" form:"sigungu_name" example:"종로구"`
+	DeliveryName string `json:"delivery_name" form:"delivery_name" example:"정부서울청사"` // 공백 무시 부분 매칭
+	Page         int    `json:"page" form:"page" example:"1"`
+	Limit        int    `json:"limit" form:"limit" example:"10"`
+}
+
+// 우편번호 종류 (ZipCodeCheck.Kind)
+const (
+	ZipCodeKindAddress = "address" // 일반 배달구역 (도로명/지번 범위)
+	ZipCodeKindPOBox   = "pobox"   // 사서함
+	ZipCodeKindBulk    = "bulk"    // 다량배달처
+)
+
+// ZipCodeCheck는 우편번호 존재 여부 확인 결과입니다.
+// @Description 우편번호 존재 여부 및 종류
+type ZipCodeCheck struct {
+	ZipCode string `json:"zip_code" example:"03171"`
+	Exists  bool   `json:"exists" example:"true"`
+
+	// Kind는 우편번호 종류입니다. ("address", "pobox", "bulk", 없으면 빈 문자열)
+	Kind string `json:"kind,omitempty" example:"bulk"`
+
+	// Name은 사서함명 또는 다량배달처명입니다. (일반 배달구역은 빈 문자열)
+	Name string `json:"name,omitempty" example:"정부서울청사"`
+}
+
 // ============================================================
 // Import 관련 타입 (Import Types)
 // ============================================================
@@ -125,7 +389,566 @@ type ImportResult struct {
 	TotalCount int
 	ErrorCount int
 	Duration   string
+
+	// Changeset은 diff 모드에서 실제로 반영된 변경 내역입니다. (전체 교체 모드에서는 nil)
+	Changeset *ImportChangeset
+
+	// ResumedCount는 중단된 import를 재개할 때 이미 커밋되어 건너뛴 행 수입니다.
+	ResumedCount int
+
+	// DuplicateCount는 같은 배치 안에서 유니크 키가 겹쳐 마지막 행으로 합쳐진 행 수입니다. (TotalCount에 포함되지 않음)
+	DuplicateCount int
+}
+
+// DatasetImportResult는 여러 파일(ZIP 아카이브, 디렉토리, glob)을 하나의 데이터셋으로 import한 결과입니다.
+type DatasetImportResult struct {
+	RoadCount     int // 저장된 도로명주소 건수
+	LandCount     int // 저장된 지번주소 건수
+	BuildingCount int // 저장된 건물 건수
+	POBoxCount    int // 저장된 사서함 건수
+	BulkCount     int // 저장된 다량배달처 건수
+	ErrorCount    int
+	Duration      string
+
+	// Files는 파일별 결과입니다. (이름순)
+	Files []FileImportResult
+}
+
+// FileImportResult는 데이터셋 import에서 파일 하나의 결과입니다.
+type FileImportResult struct {
+	FileName   string
+	DataType   string // "road", "land", "building", "pobox" 또는 "bulk" (헤더로 감지)
+	Encoding   string
+	TotalCount int
+	ErrorCount int
+}
+
+// ImportChangeset는 diff import에서 반영된 변경 요약입니다.
+// 샘플 키는 유니크 인덱스(idx_postal_unique / idx_land_unique) 컬럼을 '|'로 연결한 값입니다.
+type ImportChangeset struct {
+	Added     int
+	Updated   int
+	Removed   int
+	Unchanged int
+
+	SampleAdded   []string
+	SampleUpdated []string
+	SampleRemoved []string
 }
 
 // ProgressFunc는 진행 상황을 보고하는 콜백 함수입니다.
+// 저장 단계에서 배치가 끝날 때마다 (처리한 행 수, 저장할 전체 행 수)로 호출됩니다.
 type ProgressFunc func(current, total int)
+
+// Handler는 ProgressFunc를 ProgressHandler로 변환합니다.
+// 저장 단계의 진행만 (RowsWritten, RowsTotal)로 전달하고 나머지 단계는 무시합니다.
+func (f ProgressFunc) Handler() ProgressHandler {
+	return func(progress ImportProgress) {
+		if progress.Phase == ImportPhaseWrite {
+			f(progress.RowsWritten, progress.RowsTotal)
+		}
+	}
+}
+
+// ImportPhase는 import 진행 단계입니다.
+type ImportPhase string
+
+// Import 진행 단계
+const (
+	ImportPhaseRead     ImportPhase = "read"     // 파일 읽기 및 파싱/검증
+	ImportPhaseTruncate ImportPhase = "truncate" // 기존 데이터 삭제
+	ImportPhaseCompare  ImportPhase = "compare"  // 기존 테이블과 비교 (diff 모드)
+	ImportPhaseWrite    ImportPhase = "write"    // 배치 저장 (diff 모드는 삭제 포함)
+	ImportPhaseDone     ImportPhase = "done"     // 완료
+)
+
+// ImportProgress는 import 진행 상황 스냅샷입니다.
+// 행 수는 import 전체(데이터셋은 모든 파일) 누적이며, 바이트 수는 현재 파일 기준입니다.
+type ImportProgress struct {
+	Phase    ImportPhase
+	DataType string // 현재 파일의 데이터 타입 ("road", "land" 또는 "building")
+	File     string // 현재 파일
+
+	BytesRead  int64 // 현재 파일에서 읽은 바이트 수
+	BytesTotal int64 // 현재 파일 전체 바이트 수 (모르면 0)
+
+	RowsRead     int // 읽은 데이터 라인 수 (거부 포함)
+	RowsRejected int // 파싱/검증 실패 또는 저장 실패로 거부된 라인 수
+	RowsWritten  int // 저장 처리한 행 수 (재개 시 건너뛴 행 포함, diff 모드는 삭제 포함)
+	RowsTotal    int // 저장할 전체 행 수 (읽기 단계에서는 0)
+
+	Elapsed       time.Duration // import 시작 후 경과 시간
+	RowsPerSecond float64       // 현재 단계 처리 속도 (읽기: 읽은 라인, 저장: 저장한 행)
+	ETA           time.Duration // 현재 단계 남은 예상 시간 (모르면 0)
+}
+
+// Percent는 현재 단계의 진행률(0~100)을 반환합니다. 알 수 없으면 -1을 반환합니다.
+func (p ImportProgress) Percent() float64 {
+	switch p.Phase {
+	case ImportPhaseRead:
+		if p.BytesTotal > 0 {
+			return float64(p.BytesRead) * 100 / float64(p.BytesTotal)
+		}
+	case ImportPhaseWrite:
+		if p.RowsTotal > 0 {
+			return float64(p.RowsWritten) * 100 / float64(p.RowsTotal)
+		}
+	case ImportPhaseDone:
+		return 100
+	}
+	return -1
+}
+
+// ProgressHandler는 단계, 건수, 처리 속도, 남은 시간을 포함한 상세 진행 상황을 받는 콜백 함수입니다.
+type ProgressHandler func(progress ImportProgress)
+
+// ValidationReport는 파일 검증(dry-run) 결과입니다.
+// DB에 접근하지 않고 파일 전체를 파싱/검증한 결과를 JSON으로 출력할 수 있습니다.
+type ValidationReport struct {
+	FilePath      string `json:"file_path"`
+	DataType      string `json:"data_type"`
+	Encoding      string `json:"encoding"` // 감지되었거나 지정된 파일 인코딩
+	TotalLines    int    `json:"total_lines"`
+	ValidCount    int    `json:"valid_count"`
+	RejectedCount int    `json:"rejected_count"`
+
+	// 통계
+	DuplicateKeys  int            `json:"duplicate_keys"`   // 유니크 키가 중복되어 upsert 시 병합될 행 수
+	UniqueZipCodes int            `json:"unique_zip_codes"` // 유효한 행의 고유 우편번호 수
+	SidoCounts     map[string]int `json:"sido_counts"`      // 시도별 유효 행 수
+	FieldErrors    map[string]int `json:"field_errors"`     // 필드별 거부 건수 (필드 무관 오류는 "_record")
+
+	Errors   []*ImportError `json:"errors"`
+	Duration string         `json:"duration"`
+}
+
+// ============================================================
+// Import 이력 (Import History)
+// ============================================================
+
+// Import 실행 결과
+const (
+	ImportOutcomeRunning = "running" // 실행 중 (또는 비정상 종료)
+	ImportOutcomeSuccess = "success"
+	ImportOutcomeFailed  = "failed"
+)
+
+// Import 대상 데이터 타입 (도로명/지번은 importer의 "road"/"land"와 동일)
+const (
+	ImportDataTypeRoad     = "road"
+	ImportDataTypeLand     = "land"
+	ImportDataTypeBuilding = "building"
+	ImportDataTypePOBox    = "pobox"   // 사서함
+	ImportDataTypeBulk     = "bulk"    // 다량배달처
+	ImportDataTypeDataset  = "dataset" // ZIP/디렉토리/glob 데이터셋
+)
+
+// Import 모드
+const (
+	ImportModeReplace = "replace" // 전체 교체 (truncate 후 저장)
+	ImportModeDiff    = "diff"    // 변경분만 반영
+	ImportModeAppend  = "append"  // 기존 데이터 유지, 파일의 행만 upsert (거부 라인 재처리 등)
+)
+
+// ImportRun은 import 실행 이력입니다.
+// 마지막으로 성공한 실행이 현재 서비스 중인 데이터셋 버전이 됩니다.
+// @Description Import 실행 이력
+type ImportRun struct {
+	ID uint `json:"id" gorm:"primaryKey;autoIncrement" example:"1"`
+
+	// 대상 데이터
+	DataType    string `json:"data_type" gorm:"type:varchar(10);not null" example:"road"` // road, land, building, pobox, bulk, dataset
+	Mode        string `json:"mode" gorm:"type:varchar(10);not null" example:"replace"`
+	FileName    string `json:"file_name" gorm:"type:varchar(255)" example:"range_road.zip"`
+	FileSHA256  string `json:"file_sha256" gorm:"column:file_sha256;type:varchar(64)" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	DatasetDate string `json:"dataset_date" gorm:"type:varchar(10)" example:"2024-05-01"` // 배포 기준일 (YYYY-MM-DD, 운영자 입력)
+
+	// 실행 정보
+	StartedAt    time.Time  `json:"started_at" gorm:"not null" example:"2024-05-02T03:00:00Z"`
+	FinishedAt   *time.Time `json:"finished_at" example:"2024-05-02T03:05:00Z"`
+	Operator     string     `json:"operator" gorm:"type:varchar(100)" example:"admin"`
+	Outcome      string     `json:"outcome" gorm:"type:varchar(10);not null;index:idx_import_runs_outcome" example:"success"`
+	ErrorMessage string     `json:"error_message,omitempty" gorm:"type:text" example:""`
+
+	// 건수
+	InsertedCount int `json:"inserted_count" example:"34000"`
+	UpdatedCount  int `json:"updated_count" example:"0"`
+	DeletedCount  int `json:"deleted_count" example:"0"`
+	RejectedCount int `json:"rejected_count" example:"0"`
+
+	// 체크포인트 (replace 모드, 중단된 import 재개용)
+	CheckpointRows int   `json:"checkpoint_rows" example:"34000"`                        // 커밋된 배치까지 처리한 유효 행 수 (파일 순서 기준)
+	Truncated      bool  `json:"truncated" gorm:"not null;default:false" example:"true"` // 대상 테이블을 비웠는지 여부 (truncate 전에 기록)
+	ResumedFromID  *uint `json:"resumed_from_id,omitempty" example:"1"`                  // 이어서 진행한 중단된 실행의 ID
+}
+
+// ImportReject는 import 중 거부된 라인입니다. (격리 테이블)
+// Record의 거부 라인을 수정한 뒤 append 모드로 다시 import할 수 있습니다.
+// @Description Import 거부 라인
+type ImportReject struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement" example:"1"`
+	RunID     uint      `json:"run_id" gorm:"not null;index:idx_import_rejects_run" example:"1"` // import_runs.id (이력 기록 실패 시 0)
+	DataType  string    `json:"data_type" gorm:"type:varchar(10);not null" example:"road"`
+	FileName  string    `json:"file_name" gorm:"type:varchar(255)" example:"range_road.txt"`
+	Line      int       `json:"line" example:"3"`
+	Field     string    `json:"field,omitempty" gorm:"type:varchar(40)" example:"zip_code"`
+	Value     string    `json:"value,omitempty" gorm:"type:varchar(255)" example:"0100"`
+	Reason    string    `json:"reason" gorm:"type:text" example:"zip code must be 5 digits"`
+	Record    string    `json:"record" gorm:"type:text" example:"0100|서울특별시|Seoul|강북구|..."` // 원본 라인 (파이프 구분)
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName은 테이블 이름을 명시적으로 지정합니다.
+func (ImportReject) TableName() string {
+	return "postal_code_import_rejects"
+}
+
+// Applied는 실행이 대상 테이블을 바꿨는지 확인합니다.
+// 성공했거나, 테이블을 비웠거나, 커밋된 배치가 있는 실행입니다.
+func (r *ImportRun) Applied() bool {
+	return r.Outcome == ImportOutcomeSuccess || r.Truncated || r.CheckpointRows > 0
+}
+
+// Resumable은 중단된 실행을 재개할 수 있는지 확인합니다.
+// 성공하지 않았고 테이블을 비웠거나 커밋된 배치가 있는 replace 모드 실행만 재개할 수 있습니다.
+// 첫 배치 전에 중단된 실행은 체크포인트 0부터(truncate 없이 처음부터) 재개합니다.
+func (r *ImportRun) Resumable() bool {
+	return r.Mode == ImportModeReplace && r.Outcome != ImportOutcomeSuccess && (r.Truncated || r.CheckpointRows > 0) && r.FileSHA256 != ""
+}
+
+// TableName은 테이블 이름을 명시적으로 지정합니다.
+func (ImportRun) TableName() string {
+	return "import_runs"
+}
+
+// DatasetVersion은 데이터셋 버전 문자열을 반환합니다.
+// 배포 기준일(없으면 import 완료일)과 파일 SHA-256 앞 8자리로 구성됩니다. (예: "2024-05-01+9f86d081")
+func (r *ImportRun) DatasetVersion() string {
+	version := r.DatasetDate
+	if version == "" {
+		at := r.StartedAt
+		if r.FinishedAt != nil {
+			at = *r.FinishedAt
+		}
+		version = at.Format("2006-01-02")
+	}
+	if len(r.FileSHA256) >= 8 {
+		version += "+" + r.FileSHA256[:8]
+	}
+	return version
+}
+
+// ============================================================
+// 데이터 품질 검사 (Audit)
+// ============================================================
+
+// 범위종류 (PostalCodeRoad.RangeType)
+const (
+	RangeTypeSingle = 0 // 해당주소 (시작 건물번호 하나)
+	RangeTypeOdd    = 1 // 홀수
+	RangeTypeEven   = 2 // 짝수
+	RangeTypeAll    = 3 // 전체 (홀수+짝수)
+)
+
+// 검사 규칙 심각도
+const (
+	AuditSeverityError   = "error"   // 데이터가 잘못되어 조회 결과가 틀릴 수 있음
+	AuditSeverityWarning = "warning" // 조회에는 문제가 없지만 보완이 필요함 (영문명 누락 등)
+)
+
+// AuditReport는 import된 테이블에 대한 데이터 품질 검사 결과입니다.
+type AuditReport struct {
+	RoadCount int `json:"road_count"` // 검사한 도로명주소 행 수
+	LandCount int `json:"land_count"` // 검사한 지번주소 행 수
+
+	ErrorCount   int `json:"error_count"`   // error 규칙 위반 건수 합계
+	WarningCount int `json:"warning_count"` // warning 규칙 위반 건수 합계
+
+	// Findings는 규칙별 결과입니다. (규칙 ID순, 위반이 없는 규칙 포함)
+	Findings []AuditFinding `json:"findings"`
+	Duration string         `json:"duration"`
+}
+
+// AuditFinding은 검사 규칙 하나의 결과입니다.
+// RowIDs는 위반한 행의 ID이며 최대 개수를 넘으면 Truncated가 true입니다.
+type AuditFinding struct {
+	RuleID      string `json:"rule_id"`
+	Table       string `json:"table"` // postal_code_roads 또는 postal_code_lands
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+	Count       int    `json:"count"`
+	RowIDs      []uint `json:"row_ids"`
+	Truncated   bool   `json:"truncated,omitempty"`
+}
+
+// ============================================================
+// 건물번호 범위 분석 (Coverage)
+// ============================================================
+
+// 범위 분석 대상 건물번호 구분 (홀수/짝수 범위는 서로 겹치지 않음)
+const (
+	CoverageSideOdd  = "odd"
+	CoverageSideEven = "even"
+)
+
+// CoverageParams는 도로별 건물번호 범위 분석 조건입니다.
+// RoadName은 정확히 일치하는 도로만, SidoName/SigunguName은 부분 매칭으로 조회합니다.
+// @Description 건물번호 범위 분석 조건
+type CoverageParams struct {
+	SidoName    string `json:"sido_name" form:"sido_name" example:"서울특별시"`
+	SigunguName string `json:"sigungu_name" form:"sigungu_name" example:"강북구"`
+	RoadName    string `json:"road_name" form:"road_name" example:"삼양로"`
+}
+
+// CoverageReport는 도로별 건물번호 범위 분석 결과입니다.
+// @Description 건물번호 범위 분석 결과
+type CoverageReport struct {
+	RoadCount    int `json:"road_count" example:"1"`    // 분석한 도로 그룹 수
+	RangeCount   int `json:"range_count" example:"12"`  // 분석한 범위(행) 수
+	OverlapCount int `json:"overlap_count" example:"1"` // 서로 다른 우편번호 범위가 겹치는 구간 수
+	GapCount     int `json:"gap_count" example:"0"`     // 어떤 범위에도 속하지 않는 건물번호 구간 수
+
+	// Roads는 (시도, 시군구, 도로명, 지하여부) 그룹별 결과입니다. (이름순)
+	Roads    []RoadCoverage `json:"roads"`
+	Duration string         `json:"duration" example:"1.2ms"`
+}
+
+// RoadCoverage는 (시도, 시군구, 도로명, 지하여부) 그룹 하나의 범위 분석 결과입니다.
+// @Description 도로 하나의 건물번호 범위 분석 결과
+type RoadCoverage struct {
+	SidoName      string         `json:"sido_name" example:"서울특별시"`
+	SigunguName   string         `json:"sigungu_name" example:"강북구"`
+	RoadName      string         `json:"road_name" example:"삼양로"`
+	IsUnderground bool           `json:"is_underground" example:"false"`
+	RangeCount    int            `json:"range_count" example:"12"`
+	ZipCodes      []string       `json:"zip_codes"`
+	Overlaps      []RangeOverlap `json:"overlaps"`
+	Gaps          []RangeGap     `json:"gaps"`
+}
+
+// HasIssues는 겹치는 범위나 빈 구간이 있는지 확인합니다.
+func (c *RoadCoverage) HasIssues() bool {
+	return len(c.Overlaps) > 0 || len(c.Gaps) > 0
+}
+
+// RangeOverlap은 서로 다른 우편번호의 두 범위가 겹치는 건물번호 구간입니다.
+// StartMain~EndMain은 Side(홀수/짝수)에 해당하는 건물번호 본번 기준 교집합입니다.
+// @Description 서로 다른 우편번호 범위가 겹치는 구간
+type RangeOverlap struct {
+	Side      string    `json:"side" example:"odd"`
+	StartMain int       `json:"start_main" example:"51"`
+	EndMain   int       `json:"end_main" example:"99"`
+	ZipCodes  [2]string `json:"zip_codes"`
+	RowIDs    [2]uint   `json:"row_ids"`
+}
+
+// RangeGap은 도로의 첫 범위와 마지막 범위 사이에서 어떤 범위에도 속하지 않는 건물번호 본번 구간입니다.
+// @Description 어떤 범위에도 속하지 않는 구간
+type RangeGap struct {
+	Side      string `json:"side" example:"even"`
+	StartMain int    `json:"start_main" example:"102"`
+	EndMain   int    `json:"end_main" example:"118"`
+}
+
+// ============================================================
+// 내보내기 (Export)
+// ============================================================
+
+// 내보내기 형식
+const (
+	ExportFormatPipe  = "pipe"  // 우체국 배포 파일과 같은 파이프('|') 구분 형식 (import로 다시 읽을 수 있음)
+	ExportFormatCSV   = "csv"   // 쉼표 구분 CSV (헤더는 영문 컬럼명)
+	ExportFormatJSONL = "jsonl" // 한 줄에 한 행씩 JSON 객체 (JSON Lines)
+	ExportFormatJSON  = "json"  // 시도 > 시군구 > 도로명/읍면동 계층 구조의 JSON 문서
+)
+
+// ExportResult는 내보내기 결과입니다.
+type ExportResult struct {
+	DataType string `json:"data_type"` // road 또는 land
+	Format   string `json:"format"`    // pipe, csv, jsonl 또는 json
+	RowCount int    `json:"row_count"` // 내보낸 행 수
+	Duration string `json:"duration"`
+}
+
+// ExportTree는 계층 구조 JSON(ExportFormatJSON) 내보내기 문서입니다.
+// 도로명주소는 시도 > 시군구 > 도로명 > 범위, 지번주소는 시도 > 시군구 > 읍면동 > 범위 순으로 묶이며
+// 각 단계는 이름순으로 정렬됩니다.
+type ExportTree struct {
+	DataType string       `json:"data_type"`
+	Count    int          `json:"count"`
+	Sido     []ExportSido `json:"sido"`
+}
+
+// ExportSido는 계층 구조 JSON의 시도 단계입니다.
+type ExportSido struct {
+	Name    string          `json:"name"`
+	NameEn  string          `json:"name_en,omitempty"`
+	Sigungu []ExportSigungu `json:"sigungu"`
+}
+
+// ExportSigungu는 계층 구조 JSON의 시군구 단계입니다.
+// 도로명주소 내보내기는 Roads, 지번주소 내보내기는 Eupmyeondong만 채워집니다.
+type ExportSigungu struct {
+	Name         string               `json:"name"`
+	NameEn       string               `json:"name_en,omitempty"`
+	Roads        []ExportRoad         `json:"roads,omitempty"`
+	Eupmyeondong []ExportEupmyeondong `json:"eupmyeondong,omitempty"`
+}
+
+// ExportRoad는 계층 구조 JSON의 도로명 단계입니다. (읍면, 도로명 기준)
+type ExportRoad struct {
+	Name           string            `json:"name"`
+	NameEn         string            `json:"name_en,omitempty"`
+	EupmyeonName   string            `json:"eupmyeon_name,omitempty"`
+	EupmyeonNameEn string            `json:"eupmyeon_name_en,omitempty"`
+	Ranges         []ExportRoadRange `json:"ranges"`
+}
+
+// ExportRoadRange는 도로명 단계 아래의 건물번호 범위입니다.
+type ExportRoadRange struct {
+	ZipCode           string `json:"zip_code"`
+	IsUnderground     bool   `json:"is_underground"`
+	StartBuildingMain int    `json:"start_building_main"`
+	StartBuildingSub  *int   `json:"start_building_sub"`
+	EndBuildingMain   *int   `json:"end_building_main"`
+	EndBuildingSub    *int   `json:"end_building_sub"`
+	RangeType         int8   `json:"range_type"`
+}
+
+// ExportEupmyeondong은 계층 구조 JSON의 읍면동 단계입니다.
+type ExportEupmyeondong struct {
+	Name   string            `json:"name"`
+	NameEn string            `json:"name_en,omitempty"`
+	Ranges []ExportLandRange `json:"ranges"`
+}
+
+// ExportLandRange는 읍면동 단계 아래의 지번 범위입니다.
+type ExportLandRange struct {
+	ZipCode            string `json:"zip_code"`
+	RiName             string `json:"ri_name,omitempty"`
+	HaengjeongdongName string `json:"haengjeongdong_name,omitempty"`
+	IsMountain         bool   `json:"is_mountain"`
+	StartJibunMain     int    `json:"start_jibun_main"`
+	StartJibunSub      *int   `json:"start_jibun_sub"`
+	EndJibunMain       *int   `json:"end_jibun_main"`
+	EndJibunSub        *int   `json:"end_jibun_sub"`
+}
+
+// ============================================================
+// 스냅샷 (Snapshot)
+// ============================================================
+
+// SnapshotInfo는 바이너리 스냅샷 파일의 요약 정보입니다.
+// 스냅샷은 도로명주소/지번주소 테이블을 읽기 전용 조회용으로 저장한 파일입니다.
+type SnapshotInfo struct {
+	FormatVersion  int        `json:"format_version"`
+	BuiltAt        time.Time  `json:"built_at"`
+	Dataset        *ImportRun `json:"dataset,omitempty"`         // 스냅샷을 만든 시점의 데이터셋 (import 이력이 없으면 nil)
+	DatasetVersion string     `json:"dataset_version,omitempty"` // Dataset.DatasetVersion()
+	RoadCount      int        `json:"road_count"`
+	LandCount      int        `json:"land_count"`
+	StringCount    int        `json:"string_count"` // 중복을 제거한 지역명/도로명 문자열 수
+	Size           int64      `json:"size"`
+	Checksum       string     `json:"checksum"` // 헤더 이후 전체 내용의 CRC-32C (16진수)
+}
+
+// ============================================================
+// SQLite 번들 (Bundle)
+// ============================================================
+
+// BundleInfo는 SQLite 번들 파일의 데이터셋 메타데이터입니다.
+// 번들은 스키마, 인덱스, 데이터를 모두 담은 배포용 SQLite 파일이며, 이 정보는 번들 안의 한 행짜리 테이블에 저장됩니다.
+type BundleInfo struct {
+	ID             uint      `json:"-" gorm:"primaryKey"`
+	FormatVersion  int       `json:"format_version" gorm:"not null"`
+	BuiltAt        time.Time `json:"built_at" gorm:"not null"`
+	Source         string    `json:"source" gorm:"type:varchar(255)"`                   // 원본 배포 파일 경로 (파일 이름)
+	DatasetVersion string    `json:"dataset_version,omitempty" gorm:"type:varchar(40)"` // ImportRun.DatasetVersion()
+	DatasetDate    string    `json:"dataset_date,omitempty" gorm:"type:varchar(10)"`
+
+	// 건수
+	RoadCount         int64 `json:"road_count"`
+	LandCount         int64 `json:"land_count"`
+	BuildingCount     int64 `json:"building_count"`
+	POBoxCount        int64 `json:"pobox_count" gorm:"column:pobox_count"`
+	BulkDeliveryCount int64 `json:"bulk_delivery_count"`
+
+	Size int64 `json:"size" gorm:"-"` // 파일 크기 (저장하지 않음)
+}
+
+// TableName은 테이블 이름을 명시적으로 지정합니다.
+func (BundleInfo) TableName() string {
+	return "postal_code_bundle_info"
+}
+
+// ============================================================
+// 스키마 마이그레이션 (Schema Migration)
+// ============================================================
+
+// SchemaMigration은 적용된 버전 SQL 마이그레이션 기록입니다.
+type SchemaMigration struct {
+	Version   int64     `json:"version" gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `json:"name" gorm:"type:varchar(255);not null"`
+	AppliedAt time.Time `json:"applied_at" gorm:"not null"`
+}
+
+// TableName은 테이블 이름을 명시적으로 지정합니다.
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// MigrationStatus는 마이그레이션 하나의 적용 상태입니다.
+type MigrationStatus struct {
+	Version   int64      `json:"version" example:"1"`
+	Name      string     `json:"name" example:"create_postal_code_roads"`
+	Applied   bool       `json:"applied" example:"true"`
+	AppliedAt *time.Time `json:"applied_at,omitempty" example:"2024-05-02T03:00:00Z"`
+	Missing   bool       `json:"missing,omitempty" example:"false"` // 적용 기록은 있지만 마이그레이션 파일이 없음
+}
+
+// 스키마 차이 종류
+const (
+	DriftMissingTable  = "missing_table"  // 모델의 테이블이 DB에 없음
+	DriftMissingColumn = "missing_column" // 모델의 컬럼이 DB에 없음
+	DriftExtraColumn   = "extra_column"   // DB에만 있는 컬럼
+	DriftColumnType    = "column_type"    // 컬럼 타입이 모델 필드 타입과 호환되지 않음
+	DriftNullable      = "nullable"       // 모델은 NOT NULL이지만 DB 컬럼은 NULL 허용
+	DriftMissingIndex  = "missing_index"  // 모델의 인덱스가 DB에 없음
+	DriftIndexMismatch = "index_mismatch" // 인덱스 컬럼 또는 유니크 여부가 다름
+)
+
+// SchemaDrift는 모델 정의와 실제 DB 스키마의 차이 하나입니다.
+type SchemaDrift struct {
+	Table  string `json:"table" example:"postal_code_roads"`
+	Kind   string `json:"kind" example:"missing_column"`
+	Name   string `json:"name,omitempty" example:"road_name_en"` // 컬럼 또는 인덱스 이름
+	Detail string `json:"detail" example:"column road_name_en not found"`
+}
+
+// ============================================================
+// 캐시 (Cache)
+// ============================================================
+
+// CacheStats는 캐싱 Repository의 통계입니다.
+type CacheStats struct {
+	Hits           uint64 `json:"hits"`                      // 캐시에서 반환한 조회 수
+	Misses         uint64 `json:"misses"`                    // 프로세스 캐시에 없어 공유 저장소나 원본 Repository를 조회한 수
+	Coalesced      uint64 `json:"coalesced"`                 // 진행 중인 같은 조회의 결과를 기다려 받은 수
+	Evictions      uint64 `json:"evictions"`                 // 용량 초과로 밀려난 항목 수
+	Expired        uint64 `json:"expired"`                   // TTL이 지나 버린 항목 수
+	Invalidations  uint64 `json:"invalidations"`             // 캐시를 모두 비운 횟수 (쓰기, import 종료, 데이터셋 버전 변경)
+	StoreHits      uint64 `json:"store_hits,omitempty"`      // Misses 중 공유 저장소(Redis 등)에서 찾은 수
+	StoreErrors    uint64 `json:"store_errors,omitempty"`    // 공유 저장소 읽기/쓰기 실패 수 (원본 조회로 대체)
+	Entries        int    `json:"entries"`                   // 현재 항목 수
+	Capacity       int    `json:"capacity"`                  // 최대 항목 수
+	DatasetVersion string `json:"dataset_version,omitempty"` // 마지막으로 확인한 데이터셋 버전
+}
+
+// HitRatio는 전체 조회 중 원본 Repository를 조회하지 않은 비율(0~1)입니다. 조회가 없으면 0입니다.
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses + s.Coalesced
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits+s.Coalesced+s.StoreHits) / float64(total)
+}
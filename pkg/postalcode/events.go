@@ -0,0 +1,137 @@
+package postalcode
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	postalcode "github.com/oursportsnation/korean-postalcode"
+)
+
+// ============================================================
+// 이벤트 핸들러 어댑터 (Event Handler Adapters)
+// ============================================================
+
+// StructuredLogger는 log/slog의 *slog.Logger와 같은 메서드를 가진 구조화 로거입니다.
+// *slog.Logger(Go 1.21+)와 같은 시그니처의 로거를 그대로 전달할 수 있습니다.
+type StructuredLogger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// NewSlogEventHandler는 이벤트를 구조화 로거로 전달하는 EventHandler를 생성합니다.
+//
+// 메시지는 이벤트 종류("batch_failed" 등)이며, 값이 있는 필드만 key-value로 전달합니다.
+// 배치 시작/완료와 읽기 진행은 Debug, 라인 거부와 경고는 Warn, 배치 실패는 Error,
+// 나머지는 Info 레벨로 기록합니다.
+//
+// 사용 예:
+//
+//	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+//	events := postalcode.NewSlogEventHandler(logger)
+//	service := postalcode.NewService(repo, postalcode.WithServiceEventHandler(events))
+//	importer := postalcode.NewImporter(service, postalcode.WithEventHandler(events))
+func NewSlogEventHandler(logger StructuredLogger) postalcode.EventHandler {
+	return postalcode.EventHandlerFunc(func(event postalcode.Event) {
+		msg, args := string(event.Type), eventAttrs(event)
+		switch event.Type {
+		case postalcode.EventBatchStarted, postalcode.EventBatchCompleted, postalcode.EventReadProgress:
+			logger.Debug(msg, args...)
+		case postalcode.EventRowRejected, postalcode.EventWarning:
+			logger.Warn(msg, args...)
+		case postalcode.EventBatchFailed:
+			logger.Error(msg, args...)
+		default:
+			logger.Info(msg, args...)
+		}
+	})
+}
+
+// eventAttrs는 이벤트에서 값이 있는 필드만 key-value 목록으로 만듭니다.
+func eventAttrs(event postalcode.Event) []any {
+	var args []any
+	add := func(key string, value any, ok bool) {
+		if ok {
+			args = append(args, key, value)
+		}
+	}
+	add("data_type", event.DataType, event.DataType != "")
+	add("file", event.File, event.File != "")
+	add("start", event.Start, event.End > 0)
+	add("end", event.End, event.End > 0)
+	add("line", event.Line, event.Line > 0)
+	add("field", event.Field, event.Field != "")
+	add("value", event.Value, event.Field != "")
+	add("count", event.Count, event.Count > 0)
+	add("total", event.Total, event.Total > 0)
+	add("run_id", event.RunID, event.RunID > 0)
+	add("message", event.Message, event.Message != "")
+	add("error", event.Err, event.Err != nil)
+	return args
+}
+
+// consoleRejectLimit는 콘솔에 출력할 거부 라인 최대 개수입니다. (파일마다)
+const consoleRejectLimit = 10
+
+// NewConsoleEventHandler는 이벤트를 사람이 읽기 쉬운 한 줄 메시지로 w에 출력하는 EventHandler를 생성합니다.
+// CLI 도구에서 사용하며, 거부 라인은 파일마다 최대 10개까지만 출력합니다.
+func NewConsoleEventHandler(w io.Writer) postalcode.EventHandler {
+	h := &consoleEventHandler{w: w}
+	return postalcode.EventHandlerFunc(h.handle)
+}
+
+// consoleEventHandler는 NewConsoleEventHandler 구현입니다.
+type consoleEventHandler struct {
+	mu      sync.Mutex
+	w       io.Writer
+	rejects int
+}
+
+func (h *consoleEventHandler) handle(event postalcode.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	switch event.Type {
+	case postalcode.EventReadProgress:
+		fmt.Fprintf(h.w, "📥 읽는 중: %d%% (%d / %d bytes)\n", event.Count*100/event.Total, event.Count, event.Total)
+	case postalcode.EventRowRejected:
+		h.rejects++
+		if h.rejects <= consoleRejectLimit {
+			fmt.Fprintf(h.w, "⚠️  라인 거부: %s\n", event.Message)
+		} else if h.rejects == consoleRejectLimit+1 {
+			fmt.Fprintln(h.w, "⚠️  ... 이후 거부 라인은 생략 (결과 요약과 거부 라인 파일 참고)")
+		}
+	case postalcode.EventTruncateStarted:
+		fmt.Fprintf(h.w, "🗑️  기존 %s 데이터 삭제 중...\n", dataTypeLabel(event.DataType))
+	case postalcode.EventTruncateCompleted:
+		fmt.Fprintf(h.w, "✅ 기존 %s 데이터 삭제 완료\n", dataTypeLabel(event.DataType))
+	case postalcode.EventBatchFailed:
+		fmt.Fprintf(h.w, "❌ 배치 %d-%d 저장 실패: %v\n", event.Start, event.End, event.Err)
+	case postalcode.EventFileStarted:
+		h.rejects = 0
+		fmt.Fprintf(h.w, "📄 %s (%s)\n", event.File, event.DataType)
+	case postalcode.EventImportResumed:
+		fmt.Fprintf(h.w, "⏩ 중단된 import #%d 재개: %d / %d건 이후부터 저장\n", event.RunID, event.Count, event.Total)
+	case postalcode.EventRejectsWritten:
+		fmt.Fprintf(h.w, "🧾 거부 라인 %d개 기록: %s\n", event.Count, event.File)
+	case postalcode.EventWarning:
+		fmt.Fprintf(h.w, "⚠️  %s\n", event.Message)
+	}
+}
+
+// dataTypeLabel은 데이터 타입의 한글 이름을 반환합니다.
+func dataTypeLabel(dataType string) string {
+	switch dataType {
+	case postalcode.ImportDataTypeLand:
+		return "지번주소"
+	case postalcode.ImportDataTypeBuilding:
+		return "건물"
+	case postalcode.ImportDataTypePOBox:
+		return "사서함"
+	case postalcode.ImportDataTypeBulk:
+		return "다량배달처"
+	}
+	return "도로명주소"
+}
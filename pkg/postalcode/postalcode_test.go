@@ -1,10 +1,18 @@
 package postalcode
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	postalcode "github.com/oursportsnation/korean-postalcode"
@@ -21,7 +29,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{})
+	err = db.AutoMigrate(&postalcode.PostalCodeRoad{}, &postalcode.PostalCodeLand{}, &postalcode.ImportRun{})
 	require.NoError(t, err)
 
 	return db
@@ -319,6 +327,158 @@ func TestPublicAPI_EndToEnd_WithImporter(t *testing.T) {
 	assert.Len(t, results, 2)
 }
 
+func TestPublicAPI_MemoryRepository(t *testing.T) {
+	dir := t.TempDir()
+	roadFile := filepath.Join(dir, "road.txt")
+	landFile := filepath.Join(dir, "land.txt")
+	require.NoError(t, os.WriteFile(roadFile, []byte(`우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류
+01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로177길|Samyang-ro 177-gil|0|93|0|126|0|3
+06000|서울특별시|Seoul|강남구|Gangnam-gu|||테헤란로|Teheran-ro|1|101|2|||0
+`), 0o644))
+	require.NoError(t, os.WriteFile(landFile, []byte(`우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면동명|읍면동명(영문)|리명|산여부|행정동명|지번본번(시작)|지번부번(시작)|지번본번(종료)|지번부번(종료)
+25627|강원특별자치도|Gangwon-do|강릉시|Gangneung-si|강동면|Gangdong-myeon|모전리|0||1|0|100|0
+`), 0o644))
+
+	// 단일 파일 (헤더로 타입 감지)
+	repo, err := NewMemoryRepositoryFromFile(roadFile)
+	require.NoError(t, err)
+	results, err := NewService(repo).GetByZipCode("06000")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "테헤란로", results[0].RoadName)
+
+	// 데이터셋 (디렉토리)
+	repo, err = NewMemoryRepositoryFromFile(dir)
+	require.NoError(t, err)
+	exists, err := repo.ExistsAddressZipCode("25627")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// DB에서 로드
+	db := setupTestDB(t)
+	require.NoError(t, NewService(NewRepository(db)).BatchUpsert([]postalcode.PostalCodeRoad{
+		{ZipCode: "01000", SidoName: "서울특별시", SigunguName: "강북구", RoadName: "삼양로1"},
+	}))
+	repo, err = NewMemoryRepositoryFromDB(db)
+	require.NoError(t, err)
+	_, total, err := repo.FindByZipPrefix("010", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	_, err = NewMemoryRepositoryFromFile(filepath.Join(dir, "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestPublicAPI_Snapshot(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, NewService(NewRepository(db)).BatchUpsert([]postalcode.PostalCodeRoad{
+		{ZipCode: "01000", SidoName: "서울특별시", SigunguName: "강북구", RoadName: "삼양로1"},
+		{ZipCode: "01001", SidoName: "서울특별시", SigunguName: "강북구", RoadName: "삼양로2"},
+	}))
+
+	path := filepath.Join(t.TempDir(), "postalcode.snap")
+	info, err := BuildSnapshot(path, NewService(NewRepository(db)))
+	require.NoError(t, err)
+	assert.Equal(t, 2, info.RoadCount)
+
+	read, err := ReadSnapshotInfo(path)
+	require.NoError(t, err)
+	assert.Equal(t, info.Checksum, read.Checksum)
+
+	repo, err := NewRepositoryFromSnapshot(path)
+	require.NoError(t, err)
+	defer repo.(io.Closer).Close()
+
+	results, total, err := NewService(repo).GetByZipPrefix("010", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, results, 2)
+	assert.ErrorIs(t, repo.TruncateRoad(), postalcode.ErrReadOnly)
+
+	_, err = NewRepositoryFromSnapshot(filepath.Join(t.TempDir(), "missing.snap"))
+	assert.Error(t, err)
+}
+
+func TestPublicAPI_Bundle(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "road.txt")
+	require.NoError(t, os.WriteFile(input, []byte("우편번호|시도명|시도명(영문)|시군구명|시군구명(영문)|읍면명|읍면명(영문)|도로명|도로명(영문)|지하여부|건물번호본번(시작)|건물번호부번(시작)|건물번호본번(종료)|건물번호부번(종료)|범위종류\n"+
+		"01000|서울특별시|Seoul|강북구|Gangbuk-gu|||삼양로177길|Samyang-ro 177-gil|0|93|0|126|0|3\n"), 0o644))
+
+	path := filepath.Join(dir, "postalcode.sqlite")
+	info, err := BuildBundle(path, input, 100, nil, WithDatasetDate("2024-05-01"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), info.RoadCount)
+
+	read, err := ReadBundleInfo(path)
+	require.NoError(t, err)
+	assert.Equal(t, info.DatasetVersion, read.DatasetVersion)
+
+	db, _, err := OpenBundle(path)
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	roads, err := NewService(NewRepository(db)).GetByZipCode("01000")
+	require.NoError(t, err)
+	assert.Len(t, roads, 1)
+
+	_, err = ReadBundleInfo(input)
+	assert.ErrorIs(t, err, postalcode.ErrInvalidBundle)
+}
+
+func TestPublicAPI_Migrator(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "migrate.db")), &gorm.Config{})
+	require.NoError(t, err)
+
+	managed, err := IsSchemaManaged(db)
+	require.NoError(t, err)
+	assert.False(t, managed)
+
+	migrator, err := NewMigrator(db)
+	require.NoError(t, err)
+	applied, err := migrator.Up()
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrator.Migrations()))
+
+	managed, err = IsSchemaManaged(db)
+	require.NoError(t, err)
+	assert.True(t, managed)
+
+	drifts, err := VerifySchema(db)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+
+	reverted, err := migrator.Down(1)
+	require.NoError(t, err)
+	assert.Len(t, reverted, 1)
+}
+
+func TestPublicAPI_CachedRepository(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewCachedRepository(NewRepository(db), WithCacheSize(100), WithCacheTTL(time.Hour), WithCacheVersionCheck(0))
+	svc := NewService(repo)
+
+	_, err := NewImporter(svc).ImportFromFile("../../tests/testdata/sample_road.txt", 100, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		roads, err := svc.GetByZipCode("01001")
+		require.NoError(t, err)
+		assert.NotEmpty(t, roads)
+	}
+
+	stats := repo.Stats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, 100, stats.Capacity)
+	assert.NotZero(t, stats.Invalidations) // import 중 쓰기와 종료로 비움
+
+	repo.Purge()
+	assert.Equal(t, 0, repo.Stats().Entries)
+}
+
 func TestPublicAPI_EndToEnd_GinWorkflow(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -395,3 +555,90 @@ func TestPublicAPI_TypeAliases(t *testing.T) {
 	assert.NotNil(t, svc)
 	assert.NotNil(t, imp)
 }
+
+// ============================================================
+// Event Handler Adapter Tests
+// ============================================================
+
+// fakeLogger는 StructuredLogger 호출을 "LEVEL msg k=v ..." 형태로 기록합니다.
+type fakeLogger struct {
+	lines []string
+}
+
+func (l *fakeLogger) log(level, msg string, args []any) {
+	line := level + " " + msg
+	for i := 0; i+1 < len(args); i += 2 {
+		line += fmt.Sprintf(" %v=%v", args[i], args[i+1])
+	}
+	l.lines = append(l.lines, line)
+}
+
+func (l *fakeLogger) Debug(msg string, args ...any) { l.log("DEBUG", msg, args) }
+func (l *fakeLogger) Info(msg string, args ...any)  { l.log("INFO", msg, args) }
+func (l *fakeLogger) Warn(msg string, args ...any)  { l.log("WARN", msg, args) }
+func (l *fakeLogger) Error(msg string, args ...any) { l.log("ERROR", msg, args) }
+
+func TestPublicAPI_SlogEventHandler(t *testing.T) {
+	logger := &fakeLogger{}
+	handler := NewSlogEventHandler(logger)
+
+	handler.HandleEvent(postalcode.Event{Type: postalcode.EventBatchStarted, DataType: "road", Start: 0, End: 100})
+	handler.HandleEvent(postalcode.Event{Type: postalcode.EventBatchFailed, DataType: "road", Start: 100, End: 200, Err: errors.New("deadlock")})
+	handler.HandleEvent(postalcode.Event{Type: postalcode.EventRowRejected, Line: 3, Field: "zip_code", Value: "0100", Message: "invalid"})
+	handler.HandleEvent(postalcode.Event{Type: postalcode.EventTruncateCompleted, DataType: "land"})
+
+	assert.Equal(t, []string{
+		"DEBUG batch_started data_type=road start=0 end=100",
+		"ERROR batch_failed data_type=road start=100 end=200 error=deadlock",
+		"WARN row_rejected line=3 field=zip_code value=0100 message=invalid",
+		"INFO truncate_completed data_type=land",
+	}, logger.lines)
+}
+
+func TestPublicAPI_ConsoleEventHandler(t *testing.T) {
+	var buf bytes.Buffer
+	handler := NewConsoleEventHandler(&buf)
+
+	handler.HandleEvent(postalcode.Event{Type: postalcode.EventTruncateStarted, DataType: "land"})
+	handler.HandleEvent(postalcode.Event{Type: postalcode.EventBatchStarted, Start: 0, End: 100})
+	handler.HandleEvent(postalcode.Event{Type: postalcode.EventBatchFailed, Start: 0, End: 100, Err: errors.New("deadlock")})
+	for i := 0; i < 12; i++ {
+		handler.HandleEvent(postalcode.Event{Type: postalcode.EventRowRejected, Message: fmt.Sprintf("line %d", i)})
+	}
+
+	out := buf.String()
+	assert.Contains(t, out, "🗑️  기존 지번주소 데이터 삭제 중...")
+	assert.Contains(t, out, "❌ 배치 0-100 저장 실패: deadlock")
+	assert.Contains(t, out, "라인 거부: line 9")
+	assert.NotContains(t, out, "line 10")
+	assert.Equal(t, 1, strings.Count(out, "이후 거부 라인은 생략"))
+	assert.Equal(t, 13, strings.Count(out, "\n")) // 배치 시작은 출력하지 않음
+}
+
+func TestPublicAPI_WithEventHandlers(t *testing.T) {
+	db := setupTestDB(t)
+
+	var events []postalcode.Event
+	handler := postalcode.EventHandlerFunc(func(event postalcode.Event) {
+		events = append(events, event)
+	})
+	svc := NewService(NewRepository(db), WithServiceEventHandler(handler))
+	imp := NewImporter(svc, WithEventHandler(handler))
+
+	_, err := imp.AppendFromFile("../../tests/testdata/sample_road.txt", 100, nil)
+	require.NoError(t, err)
+	assert.Error(t, svc.BatchUpsert([]postalcode.PostalCodeRoad{{ZipCode: "0100"}})) // 유효한 레코드 없음
+
+	var types []postalcode.EventType
+	for _, event := range events {
+		if event.Type != postalcode.EventReadProgress {
+			types = append(types, event.Type)
+		}
+	}
+	assert.Equal(t, []postalcode.EventType{
+		postalcode.EventBatchStarted,
+		postalcode.EventBatchCompleted,
+		postalcode.EventImportFinished,
+		postalcode.EventRowRejected,
+	}, types)
+}
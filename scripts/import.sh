@@ -1,10 +1,11 @@
 #!/bin/bash
 
 # PostalCode 데이터 Import 스크립트
-# Usage: ./import.sh -file <file_path> [-type road|land] [-dsn <dsn>] [-batch <size>]
+# Usage: ./import.sh -file <file_path> [-type road|land] [-dsn <dsn>] [-batch <size>] [-migrate]
 #
 # Example:
 #   ./import.sh -file data/postal_codes.txt -type road
+#   ./import.sh -file data/postal_codes.txt -type road -migrate   # 테이블/검색 인덱스 생성 후 import
 #   ./import.sh -file data/postal_codes.txt -type land -batch 2000
 #   ./import.sh -file data/postal_codes.txt -dsn "user:pass@tcp(localhost:3306)/dbname"
 
@@ -22,6 +23,7 @@ DSN=""
 FILE_PATH=""
 DATA_TYPE="road"
 BATCH_SIZE="1000"
+MIGRATE=""
 
 # 플래그 파싱 (공백 구분 및 = 구분 모두 지원)
 while [[ $# -gt 0 ]]; do
@@ -58,10 +60,14 @@ while [[ $# -gt 0 ]]; do
             BATCH_SIZE="${1#*=}"
             shift
             ;;
+        -migrate)
+            MIGRATE="-migrate"
+            shift
+            ;;
         *)
             echo -e "${RED}❌ 알 수 없는 옵션: $1${NC}"
             echo ""
-            echo -e "${YELLOW}Usage: $0 -file <file_path> [-type road|land] [-dsn <dsn>] [-batch <size>]${NC}"
+            echo -e "${YELLOW}Usage: $0 -file <file_path> [-type road|land] [-dsn <dsn>] [-batch <size>] [-migrate]${NC}"
             echo ""
             echo -e "${YELLOW}Example:${NC}"
             echo "  $0 -file data/postal_codes.txt -type road"
@@ -82,7 +88,7 @@ echo ""
 if [ -z "$FILE_PATH" ]; then
     echo -e "${RED}❌ 오류: -file 파라미터는 필수입니다${NC}"
     echo ""
-    echo -e "${YELLOW}Usage: $0 -file <file_path> [-type road|land] [-dsn <dsn>] [-batch <size>]${NC}"
+    echo -e "${YELLOW}Usage: $0 -file <file_path> [-type road|land] [-dsn <dsn>] [-batch <size>] [-migrate]${NC}"
     echo ""
     echo -e "${YELLOW}Example:${NC}"
     echo "  $0 -file data/postal_codes.txt -type road"
@@ -171,13 +177,15 @@ if [ -z "$DSN" ]; then
     "$PKG_DIR/bin/postalcode-import" \
         -file "$FILE_PATH" \
         -type "$DATA_TYPE" \
-        -batch "$BATCH_SIZE"
+        -batch "$BATCH_SIZE" \
+        $MIGRATE
 else
     "$PKG_DIR/bin/postalcode-import" \
         -dsn "$DSN" \
         -file "$FILE_PATH" \
         -type "$DATA_TYPE" \
-        -batch "$BATCH_SIZE"
+        -batch "$BATCH_SIZE" \
+        $MIGRATE
 fi
 
 if [ $? -eq 0 ]; then